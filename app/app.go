@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -103,6 +104,7 @@ import (
 	customparamskeeper "github.com/CoreumFoundation/coreum/x/customparams/keeper"
 	customparamstypes "github.com/CoreumFoundation/coreum/x/customparams/types"
 	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	deterministicgaskeeper "github.com/CoreumFoundation/coreum/x/deterministicgas/keeper"
 	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 	"github.com/CoreumFoundation/coreum/x/feemodel"
 	feemodelkeeper "github.com/CoreumFoundation/coreum/x/feemodel/keeper"
@@ -166,11 +168,12 @@ var (
 		assetft.AppModuleBasic{},
 		assetnft.AppModuleBasic{},
 		customparams.AppModuleBasic{},
+		deterministicgas.AppModuleBasic{},
 	)
 
 	// module account permissions.
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
+		authtypes.FeeCollectorName:     {authtypes.Burner},
 		distrtypes.ModuleName:          nil,
 		minttypes.ModuleName:           {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
@@ -230,12 +233,13 @@ type App struct {
 	FeeGrantKeeper   feegrantkeeper.Keeper
 	WASMKeeper       wasm.Keeper
 
-	AssetFTKeeper      assetftkeeper.Keeper
-	AssetNFTKeeper     assetnftkeeper.Keeper
-	FeeModelKeeper     feemodelkeeper.Keeper
-	BankKeeper         wbankkeeper.BaseKeeperWrapper
-	NFTKeeper          wnftkeeper.Wrapper
-	CustomParamsKeeper customparamskeeper.Keeper
+	AssetFTKeeper          assetftkeeper.Keeper
+	AssetNFTKeeper         assetnftkeeper.Keeper
+	FeeModelKeeper         feemodelkeeper.Keeper
+	BankKeeper             wbankkeeper.BaseKeeperWrapper
+	NFTKeeper              wnftkeeper.Wrapper
+	CustomParamsKeeper     customparamskeeper.Keeper
+	DeterministicGasKeeper deterministicgaskeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedWASMKeeper capabilitykeeper.ScopedKeeper
@@ -281,6 +285,7 @@ func New(
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
 		wasm.StoreKey, feemodeltypes.StoreKey, assetfttypes.StoreKey, assetnfttypes.StoreKey, nftkeeper.StoreKey,
+		deterministicgastypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, feemodeltypes.TransientStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -320,8 +325,11 @@ func New(
 		appCodec,
 		app.GetSubspace(assetfttypes.ModuleName).WithKeyTable(paramstypes.NewKeyTable().RegisterParamSet(&assetfttypes.Params{})),
 		keys[assetfttypes.StoreKey],
+		app.AccountKeeper,
 		// for the assetft we use the clear bank keeper without the assets integration to prevent cycling calls.
 		originalBankKeeper,
+		// the wasm keeper is created later, so the pointer is passed to call the extension contracts.
+		&app.WASMKeeper,
 	)
 
 	app.BankKeeper = wbankkeeper.NewKeeper(
@@ -359,6 +367,7 @@ func New(
 
 	app.FeeModelKeeper = feemodelkeeper.NewKeeper(
 		app.GetSubspace(feemodeltypes.ModuleName).WithKeyTable(paramstypes.NewKeyTable().RegisterParamSet(&feemodeltypes.Params{})),
+		assetFTKeeper,
 		keys[feemodeltypes.StoreKey],
 		tkeys[feemodeltypes.TransientStoreKey],
 	)
@@ -371,8 +380,8 @@ func New(
 		app.GetSubspace(assetnfttypes.ModuleName).WithKeyTable(paramstypes.NewKeyTable().RegisterParamSet(&assetnfttypes.Params{})),
 		keys[assetnfttypes.StoreKey],
 		nftKeeper,
-		// for the assetnft we use the clear bank keeper without the assets integration because it interacts only with native token.
-		originalBankKeeper,
+		// the assetnft uses the bank keeper with the assets integration, so the sale payments respect the asset ft rules.
+		app.BankKeeper,
 	)
 
 	app.NFTKeeper = wnftkeeper.NewWrappedNFTKeeper(nftKeeper, app.AssetNFTKeeper)
@@ -380,9 +389,12 @@ func New(
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, feemodel.NewParamChangeProposalHandler(
+			app.FeeModelKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper),
+		)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(feemodeltypes.RouterKey, feemodel.NewProposalHandler(app.FeeModelKeeper))
 
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -396,11 +408,12 @@ func New(
 	}
 
 	wasmOpts := []wasm.Option{
-		wasmkeeper.WithMessageEncoders(wasmcustomhandler.NewCoreumMsgHandler()),
+		wasmkeeper.WithMessageEncoders(wasmcustomhandler.NewCoreumMsgHandler(app.interfaceRegistry)),
 		wasmkeeper.WithQueryPlugins(wasmcustomhandler.NewCoreumQueryHandler(
 			assetftkeeper.NewQueryService(app.AssetFTKeeper),
 			assetnftkeeper.NewQueryService(app.AssetNFTKeeper),
 			app.NFTKeeper,
+			feemodelkeeper.NewQueryService(app.FeeModelKeeper),
 		)),
 	}
 	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
@@ -432,6 +445,16 @@ func New(
 
 	govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WASMKeeper, wasm.EnableAllProposals))
 
+	app.DeterministicGasKeeper = deterministicgaskeeper.NewKeeper(
+		app.GetSubspace(deterministicgastypes.ModuleName),
+		keys[deterministicgastypes.StoreKey],
+		deterministicGasConfig,
+		app.WASMKeeper,
+		app.AssetFTKeeper,
+	)
+
+	govRouter.AddRoute(deterministicgastypes.RouterKey, deterministicgas.NewProposalHandler(app.DeterministicGasKeeper))
+
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -450,6 +473,7 @@ func New(
 	wnftModule := wnft.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry)
 
 	customParamsModule := customparams.NewAppModule(app.CustomParamsKeeper)
+	deterministicGasModule := deterministicgas.NewAppModule(app.DeterministicGasKeeper)
 
 	wstakingModule := wstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.CustomParamsKeeper)
 
@@ -482,6 +506,7 @@ func New(
 		assetNFTModule,
 		wnftModule,
 		customParamsModule,
+		deterministicGasModule,
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -511,6 +536,7 @@ func New(
 		assetfttypes.ModuleName,
 		assetnfttypes.ModuleName,
 		nft.ModuleName,
+		deterministicgastypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -536,6 +562,7 @@ func New(
 		assetfttypes.ModuleName,
 		assetnfttypes.ModuleName,
 		nft.ModuleName,
+		deterministicgastypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -566,13 +593,14 @@ func New(
 		nft.ModuleName,
 		assetfttypes.ModuleName,
 		assetnfttypes.ModuleName,
+		deterministicgastypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 
 	app.configurator = module.NewConfigurator(app.appCodec,
-		deterministicgastypes.NewDeterministicMsgServer(app.MsgServiceRouter(), deterministicGasConfig), app.GRPCQueryRouter())
+		deterministicgastypes.NewDeterministicMsgServer(app.MsgServiceRouter(), app.DeterministicGasKeeper), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
@@ -595,6 +623,7 @@ func New(
 		assetNFTModule,
 		wnftModule,
 		customParamsModule,
+		deterministicGasModule,
 	)
 	app.sm.RegisterStoreDecoders()
 
@@ -623,6 +652,12 @@ func New(
 	}
 
 	app.SetAnteHandler(anteHandler)
+	deterministicgastypes.RegisterServiceServer(app.GRPCQueryRouter(), deterministicgaskeeper.NewEstimateGasService(
+		app.DeterministicGasKeeper,
+		encodingConfig.TxConfig.TxDecoder(),
+		anteHandler,
+		app.Simulate,
+	))
 	app.SetEndBlocker(app.EndBlocker)
 
 	// must be before Loading version
@@ -637,7 +672,7 @@ func New(
 
 	/**** Upgrades ****/
 	upgrades := []appupgrade.Upgrade{
-		appupgradev1.NewV1Upgrade(app.mm, app.configurator, ChosenNetwork, app.AssetNFTKeeper),
+		appupgradev1.NewV1Upgrade(app.mm, app.configurator, ChosenNetwork, app.AccountKeeper, app.AssetNFTKeeper),
 	}
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -682,6 +717,33 @@ func (app *App) Name() string { return app.BaseApp.Name() }
 // GetBaseApp returns the base app of the application.
 func (app *App) GetBaseApp() *baseapp.BaseApp { return app.BaseApp }
 
+// CheckTx implements the ABCI interface and sets the priority of the transaction computed by the fee model,
+// used by the prioritized mempool to order the transactions.
+func (app *App) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	res := app.BaseApp.CheckTx(req)
+	if res.IsOK() {
+		res.Priority = feemodeltypes.PriorityFromEvents(res.Events)
+	}
+	return res
+}
+
+// DeliverTx implements the ABCI interface and tracks the gas used by the transaction, including the failed one,
+// for the fee model.
+func (app *App) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+
+	gasUsed := res.GasUsed
+	// the gas consumed by the transaction running out of gas exceeds its limit
+	if res.GasWanted > 0 && gasUsed > res.GasWanted {
+		gasUsed = res.GasWanted
+	}
+	// the context is created on top of the state of the block being delivered, so the tracked gas is read
+	// by the fee model in the EndBlock of the same block
+	app.FeeModelKeeper.TrackGasUsed(app.BaseApp.NewContext(false, tmproto.Header{}), gasUsed)
+
+	return res
+}
+
 // BeginBlocker application updates every begin block.
 func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.mm.BeginBlock(ctx, req)
@@ -778,6 +840,12 @@ func (app *App) RegisterAPIRoutes(apiSvr *serverapi.Server, _ serverconfig.APICo
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new tendermint queries routes from grpc-gateway.
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register gas estimation routes from grpc-gateway.
+	if err := deterministicgastypes.RegisterServiceHandlerClient(
+		context.Background(), apiSvr.GRPCGatewayRouter, deterministicgastypes.NewServiceClient(clientCtx),
+	); err != nil {
+		panic(err)
+	}
 
 	// Register legacy and grpc-gateway routes for all modules.
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
@@ -815,6 +883,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(customparamstypes.CustomParamsStaking)
 	paramsKeeper.Subspace(assetfttypes.ModuleName)
 	paramsKeeper.Subspace(assetnfttypes.ModuleName)
+	paramsKeeper.Subspace(deterministicgastypes.ModuleName)
 
 	return paramsKeeper
 }
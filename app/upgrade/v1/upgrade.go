@@ -4,12 +4,16 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/pkg/errors"
 
 	"github.com/CoreumFoundation/coreum/app/upgrade"
 	"github.com/CoreumFoundation/coreum/pkg/config"
 	assetnftkeeper "github.com/CoreumFoundation/coreum/x/asset/nft/keeper"
 	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 	"github.com/CoreumFoundation/coreum/x/nft"
 )
 
@@ -17,11 +21,23 @@ import (
 const Name = "v1"
 
 // NewV1Upgrade makes an upgrade handler for v1 upgrade.
-func NewV1Upgrade(mm *module.Manager, configurator module.Configurator, chosenNetwork config.Network, assetNFTKeeper assetnftkeeper.Keeper) upgrade.Upgrade {
+// Apart from adding the stores of the new modules, the upgrade runs the migrations of the modules:
+// the feemodel migration sets the params introduced in version 2 to their default values,
+// the asset ft migration sets the admin of the existing tokens to their issuers,
+// the nft migration stores the number of NFTs of the class held by the owner. The last one runs only on the networks
+// started with the nft module in genesis, on the other ones the module is initialized in version 2 by the upgrade.
+// The fee collector account stored before gets the burner permission required to burn the tips.
+func NewV1Upgrade(
+	mm *module.Manager,
+	configurator module.Configurator,
+	chosenNetwork config.Network,
+	accountKeeper authkeeper.AccountKeeper,
+	assetNFTKeeper assetnftkeeper.Keeper,
+) upgrade.Upgrade {
 	return upgrade.Upgrade{
 		Name: Name,
 		StoreUpgrades: storetypes.StoreUpgrades{
-			Added: []string{assetnfttypes.ModuleName, nft.ModuleName},
+			Added: []string{assetnfttypes.ModuleName, nft.ModuleName, deterministicgastypes.StoreKey},
 		},
 		Upgrade: func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 			afterVM, err := mm.RunMigrations(ctx, configurator, vm)
@@ -33,6 +49,16 @@ func NewV1Upgrade(mm *module.Manager, configurator module.Configurator, chosenNe
 			params.MintFee = sdk.NewInt64Coin(chosenNetwork.Denom(), 0)
 			assetNFTKeeper.SetParams(ctx, params)
 
+			// the permissions of the existing module accounts are not updated by the account keeper
+			feeCollector, ok := accountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName).(*authtypes.ModuleAccount)
+			if !ok {
+				return nil, errors.Errorf("unexpected type of the %s module account", authtypes.FeeCollectorName)
+			}
+			if !feeCollector.HasPermission(authtypes.Burner) {
+				feeCollector.Permissions = append(feeCollector.Permissions, authtypes.Burner)
+				accountKeeper.SetModuleAccount(ctx, feeCollector)
+			}
+
 			return afterVM, nil
 		},
 	}
@@ -0,0 +1,118 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	v1 "github.com/CoreumFoundation/coreum/app/upgrade/v1"
+	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+	nftkeeper "github.com/CoreumFoundation/coreum/x/nft/keeper"
+)
+
+func TestV1Upgrade(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	// store the feemodel params the way they were stored in version 1
+	params := feemodeltypes.DefaultParams()
+	params.Model.MaxBlockGas = 123_456
+	testApp.FeeModelKeeper.SetParams(ctx, params)
+	feeModelParamsStore := prefix.NewStore(
+		ctx.KVStore(testApp.GetKey(paramstypes.StoreKey)),
+		append([]byte(feemodeltypes.ModuleName), '/'),
+	)
+	for _, key := range [][]byte{
+		feemodeltypes.KeyHistoryLength,
+		feemodeltypes.KeyAcceptedFeeDenoms,
+		feemodeltypes.KeyGasTrackingMode,
+		feemodeltypes.KeyTipBurnFraction,
+	} {
+		feeModelParamsStore.Delete(key)
+	}
+	requireT.Panics(func() {
+		testApp.FeeModelKeeper.GetParams(ctx)
+	})
+
+	// store the fee collector without the burner permission the way it was stored in version 1
+	feeCollector, ok := testApp.AccountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName).(*authtypes.ModuleAccount)
+	requireT.True(ok)
+	feeCollector.Permissions = nil
+	testApp.AccountKeeper.SetModuleAccount(ctx, feeCollector)
+	requireT.False(testApp.AccountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName).HasPermission(authtypes.Burner))
+
+	// store the token without the admin the way it was stored in version 1
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	testApp.AssetFTKeeper.SetParams(ctx, assetfttypes.Params{IssueFee: sdk.NewInt64Coin(constant.DenomDev, 0)})
+	denom, err := testApp.AssetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "UPGRADE",
+		Subunit:       "upgrade",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+	def, err := testApp.AssetFTKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	def.Admin = ""
+	testApp.AssetFTKeeper.SetDefinition(ctx, issuer, "upgrade", def)
+
+	// store the nft without the balance of the owner the way it was stored in version 1
+	nftKeeper := testApp.NFTKeeper.Keeper
+	requireT.NoError(nftKeeper.SaveClass(ctx, nft.Class{Id: "upgrade"}))
+	requireT.NoError(nftKeeper.Mint(ctx, nft.NFT{ClassId: "upgrade", Id: "upgrade1"}, issuer))
+	balanceStore := prefix.NewStore(ctx.KVStore(testApp.GetKey(nftkeeper.StoreKey)), nftkeeper.OwnerClassBalanceKey)
+	iterator := balanceStore.Iterator(nil, nil)
+	requireT.True(iterator.Valid())
+	balanceKey := iterator.Key()
+	requireT.NoError(iterator.Close())
+	balanceStore.Delete(balanceKey)
+	requireT.Zero(nftKeeper.GetBalance(ctx, "upgrade", issuer))
+
+	vm := testApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	vm[feemodeltypes.ModuleName] = 1
+	vm[assetfttypes.ModuleName] = 1
+	vm[nft.ModuleName] = 1
+	testApp.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+
+	testApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{
+		Name:   v1.Name,
+		Height: ctx.BlockHeight(),
+	})
+
+	// the model params are kept and the new ones are set to the defaults
+	migratedParams := testApp.FeeModelKeeper.GetParams(ctx)
+	requireT.Equal(params.String(), migratedParams.String())
+	requireT.EqualValues(2, testApp.UpgradeKeeper.GetModuleVersionMap(ctx)[feemodeltypes.ModuleName])
+
+	// the admin of the token is set to the issuer
+	def, err = testApp.AssetFTKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), def.Admin)
+	requireT.EqualValues(2, testApp.UpgradeKeeper.GetModuleVersionMap(ctx)[assetfttypes.ModuleName])
+
+	// the balance of the nft owner is computed
+	requireT.EqualValues(1, nftKeeper.GetBalance(ctx, "upgrade", issuer))
+	requireT.EqualValues(2, testApp.UpgradeKeeper.GetModuleVersionMap(ctx)[nft.ModuleName])
+
+	// the tips might be burnt
+	requireT.True(testApp.AccountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName).HasPermission(authtypes.Burner))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testApp.FeeModelKeeper.GetMinGasPrice(ctx).Denom, 100))
+	requireT.NoError(testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, coins))
+	requireT.NoError(testApp.BankKeeper.BurnCoins(ctx, authtypes.FeeCollectorName, coins))
+}
@@ -0,0 +1,235 @@
+package cosmoscmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CoreumFoundation/coreum/app"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+const (
+	flagFromHeight = "from-height"
+	flagToHeight   = "to-height"
+)
+
+// gasReportCmd returns the command replaying blocks from the local data to compare the real gas consumed by the
+// deterministic messages to the configured deterministic gas.
+func gasReportCmd(a appCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-report",
+		Short: "Compare the real gas consumed by deterministic messages to the configured values",
+		Long: `Replay the blocks of the range from the local data and report the deterministic gas and the real gas consumed
+by each deterministic message type. The messages of each block are executed on top of the state committed by the
+previous block, so that state must not be pruned. The transactions which failed in the block are skipped, so the ABCI
+responses must be persisted by the node. The node must be stopped while the command is running.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromHeight, err := cmd.Flags().GetInt64(flagFromHeight)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			toHeight, err := cmd.Flags().GetInt64(flagToHeight)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if fromHeight <= 1 || toHeight < fromHeight {
+				return errors.Errorf("invalid height range %d-%d", fromHeight, toHeight)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer blockStoreDB.Close()
+
+			stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer stateDB.Close()
+
+			appDB, err := dbm.NewDB("application", dbm.BackendType(cfg.DBBackend), cfg.DBDir())
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer appDB.Close()
+
+			coreumApp := a.buildApp(
+				log.NewNopLogger(),
+				appDB,
+				nil,
+				true,
+				map[int64]bool{},
+				cfg.RootDir,
+				uint(1),
+				a.encodingConfig,
+				serverCtx.Viper,
+			)
+
+			report := newGasReport()
+			if err := replayBlocks(
+				coreumApp,
+				a.encodingConfig.TxConfig.TxDecoder(),
+				tmstore.NewBlockStore(blockStoreDB),
+				sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: cfg.Storage.DiscardABCIResponses}),
+				fromHeight,
+				toHeight,
+				report,
+			); err != nil {
+				return err
+			}
+			return report.Print(cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().Int64(flagFromHeight, 0, "The first height of the replayed block range")
+	cmd.Flags().Int64(flagToHeight, 0, "The last height of the replayed block range")
+	cmd.MarkFlagRequired(flagFromHeight) //nolint:errcheck // the flag is defined above
+	cmd.MarkFlagRequired(flagToHeight)   //nolint:errcheck // the flag is defined above
+
+	return cmd
+}
+
+// replayBlocks executes the messages of the blocks and records the gas consumed by the deterministic ones.
+// The ante handler, begin and end blockers are not executed, because they don't affect the gas consumed by messages.
+// The transactions which failed when the block was executed are skipped.
+func replayBlocks(
+	coreumApp *app.App,
+	txDecoder sdk.TxDecoder,
+	blockStore *tmstore.BlockStore,
+	stateStore sm.Store,
+	fromHeight, toHeight int64,
+	recorder deterministicgastypes.GasRecorder,
+) error {
+	msgRouter := coreumApp.MsgServiceRouter()
+
+	for height := fromHeight; height <= toHeight; height++ {
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return errors.Errorf("block %d not found", height)
+		}
+		abciResponses, err := stateStore.LoadABCIResponses(height)
+		if err != nil {
+			return errors.Wrapf(err, "loading ABCI responses of the height %d failed", height)
+		}
+		if len(abciResponses.DeliverTxs) != len(block.Txs) {
+			return errors.Errorf(
+				"number of ABCI responses %d doesn't match the number of txs %d at the height %d",
+				len(abciResponses.DeliverTxs), len(block.Txs), height,
+			)
+		}
+
+		ms, err := coreumApp.CommitMultiStore().CacheMultiStoreWithVersion(height - 1)
+		if err != nil {
+			return errors.Wrapf(err, "loading state of the height %d failed", height-1)
+		}
+		ctx := sdk.NewContext(ms, *block.Header.ToProto(), false, log.NewNopLogger()).
+			WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+		ctx = deterministicgastypes.WithGasRecorder(ctx, recorder)
+
+		for i, txBytes := range block.Txs {
+			if abciResponses.DeliverTxs[i].Code != abci.CodeTypeOK {
+				continue
+			}
+
+			tx, err := txDecoder(txBytes)
+			if err != nil {
+				continue
+			}
+
+			txCtx, write := ctx.CacheContext()
+			txCtx = txCtx.WithTxBytes(txBytes).WithGasMeter(sdk.NewInfiniteGasMeter())
+			if executeMsgs(txCtx, msgRouter, tx.GetMsgs()) {
+				write()
+			}
+		}
+	}
+	return nil
+}
+
+func executeMsgs(ctx sdk.Context, msgRouter *baseapp.MsgServiceRouter, msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		handler := msgRouter.Handler(msg)
+		if handler == nil {
+			return false
+		}
+		if _, err := handler(ctx, msg); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+type gasReportEntry struct {
+	count            uint64
+	deterministicGas uint64
+	realGas          uint64
+	maxRealGas       uint64
+}
+
+type gasReport struct {
+	entries map[string]*gasReportEntry
+}
+
+func newGasReport() *gasReport {
+	return &gasReport{
+		entries: map[string]*gasReportEntry{},
+	}
+}
+
+// RecordGas records the gas consumed by the message.
+func (r *gasReport) RecordGas(msgType string, deterministicGas, realGas sdk.Gas) {
+	entry, ok := r.entries[msgType]
+	if !ok {
+		entry = &gasReportEntry{}
+		r.entries[msgType] = entry
+	}
+	entry.count++
+	entry.deterministicGas += deterministicGas
+	entry.realGas += realGas
+	if realGas > entry.maxRealGas {
+		entry.maxRealGas = realGas
+	}
+}
+
+// Print prints the report sorted by the message type.
+func (r *gasReport) Print(w io.Writer) error {
+	msgTypes := make([]string, 0, len(r.entries))
+	for msgType := range r.entries {
+		msgTypes = append(msgTypes, msgType)
+	}
+	sort.Strings(msgTypes)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MSG TYPE\tCOUNT\tAVG DETERMINISTIC GAS\tAVG REAL GAS\tMAX REAL GAS\tREAL/DETERMINISTIC")
+	for _, msgType := range msgTypes {
+		entry := r.entries[msgType]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%.2f\n",
+			msgType,
+			entry.count,
+			entry.deterministicGas/entry.count,
+			entry.realGas/entry.count,
+			entry.maxRealGas,
+			float64(entry.realGas)/float64(entry.deterministicGas),
+		)
+	}
+	return errors.WithStack(tw.Flush())
+}
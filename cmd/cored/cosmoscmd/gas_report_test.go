@@ -0,0 +1,98 @@
+package cosmoscmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CoreumFoundation/coreum/app"
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+)
+
+func TestGasReport(t *testing.T) {
+	requireT := require.New(t)
+
+	report := newGasReport()
+	report.RecordGas("/cosmos.bank.v1beta1.MsgSend", 50000, 30000)
+	report.RecordGas("/cosmos.bank.v1beta1.MsgSend", 50000, 40000)
+	report.RecordGas("/coreum.asset.ft.v1.MsgMint", 35000, 70000)
+
+	buf := &bytes.Buffer{}
+	requireT.NoError(report.Print(buf))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	requireT.Len(lines, 3)
+	requireT.Equal(
+		[]string{"MSG", "TYPE", "COUNT", "AVG", "DETERMINISTIC", "GAS", "AVG", "REAL", "GAS", "MAX", "REAL", "GAS", "REAL/DETERMINISTIC"},
+		strings.Fields(lines[0]),
+	)
+	requireT.Equal([]string{"/coreum.asset.ft.v1.MsgMint", "1", "35000", "70000", "70000", "2.00"}, strings.Fields(lines[1]))
+	requireT.Equal([]string{"/cosmos.bank.v1beta1.MsgSend", "2", "50000", "35000", "40000", "0.70"}, strings.Fields(lines[2]))
+}
+
+func TestReplayBlocks(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BeginNextBlock()
+	sender, _ := testApp.GenAccount(ctx)
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	testApp.EndBlockAndCommit(ctx)
+
+	txConfig := config.NewEncodingConfig(app.ModuleBasics).TxConfig
+	encodeTx := func(msg sdk.Msg) tmtypes.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		requireT.NoError(txBuilder.SetMsgs(msg))
+		txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		requireT.NoError(err)
+		return txBytes
+	}
+
+	height := ctx.BlockHeight() + 1
+	block := tmtypes.MakeBlock(height, []tmtypes.Tx{
+		encodeTx(banktypes.NewMsgSend(sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))),
+		// the tx failed in the block, so it is skipped
+		encodeTx(banktypes.NewMsgSend(sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 20)))),
+	}, &tmtypes.Commit{}, nil)
+	block.ProposerAddress = ed25519.GenPrivKey().PubKey().Address()
+	blockStore := tmstore.NewBlockStore(dbm.NewMemDB())
+	blockStore.SaveBlock(block, block.MakePartSet(tmtypes.BlockPartSizeBytes), &tmtypes.Commit{Height: height})
+
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	requireT.NoError(stateStore.SaveABCIResponses(height, &tmstate.ABCIResponses{
+		DeliverTxs: []*abci.ResponseDeliverTx{
+			{Code: abci.CodeTypeOK},
+			{Code: sdkerrors.ErrInsufficientFee.ABCICode()},
+		},
+		EndBlock:   &abci.ResponseEndBlock{},
+		BeginBlock: &abci.ResponseBeginBlock{},
+	}))
+
+	report := newGasReport()
+	requireT.NoError(replayBlocks(&testApp.App, txConfig.TxDecoder(), blockStore, stateStore, height, height, report))
+
+	requireT.Len(report.entries, 1)
+	entry := report.entries[sdk.MsgTypeURL(&banktypes.MsgSend{})]
+	requireT.NotNil(entry)
+	requireT.EqualValues(1, entry.count)
+	requireT.Positive(entry.realGas)
+
+	// the block which is not stored is reported
+	requireT.Error(replayBlocks(&testApp.App, txConfig.TxDecoder(), blockStore, stateStore, height+1, height+1, report))
+}
@@ -200,6 +200,7 @@ func initRootCmd(
 		queryCommand(moduleBasics),
 		txCommand(moduleBasics),
 		keys.Commands(defaultNodeHome),
+		gasReportCmd(a),
 	)
 
 	// add user given sub commands.
@@ -1,22 +1,30 @@
 package integrationtests
 
 import (
+	"context"
 	"reflect"
+	"time"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 	"google.golang.org/grpc"
 
 	"github.com/CoreumFoundation/coreum/app"
 	"github.com/CoreumFoundation/coreum/pkg/client"
 	"github.com/CoreumFoundation/coreum/pkg/config"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 )
 
+const requestTimeout = 10 * time.Second
+
 // ChainContext is a types used to store the components required for the test chains subcomponents.
 type ChainContext struct {
 	ClientContext          client.Context
@@ -88,35 +96,114 @@ func (c ChainContext) NewDecCoin(amount sdk.Dec) sdk.DecCoin {
 }
 
 // GasLimitByMsgs calculates sum of gas limits required for message types passed.
+// The gas of the messages transferring the tokens with the extension is estimated by the simulation.
 // It panics if unsupported message type specified.
 func (c ChainContext) GasLimitByMsgs(msgs ...sdk.Msg) uint64 {
+	if gas, simulated := c.simulateExtensionTransfers(msgs); simulated {
+		return gas
+	}
+
 	var totalGasRequired uint64
 	for _, msg := range msgs {
-		msgGas, exists := c.DeterministicGasConfig.GasRequiredByMessage(msg)
-		if !exists {
-			panic(errors.Errorf("unsuported message type for deterministic gas: %v", reflect.TypeOf(msg).String()))
-		}
-		totalGasRequired += msgGas + c.DeterministicGasConfig.FixedGas
+		totalGasRequired += c.gasRequiredByMessage(msg) + c.DeterministicGasConfig.FixedGas
 	}
 
 	return totalGasRequired
 }
 
 // GasLimitByMultiSendMsgs calculates sum of gas limits required for message types passed and includes the FixedGas once.
+// The gas of the messages transferring the tokens with the extension is estimated by the simulation.
 // It panics if unsupported message type specified.
 func (c ChainContext) GasLimitByMultiSendMsgs(msgs ...sdk.Msg) uint64 {
+	if gas, simulated := c.simulateExtensionTransfers(msgs); simulated {
+		return gas
+	}
+
 	var totalGasRequired uint64
 	for _, msg := range msgs {
-		msgGas, exists := c.DeterministicGasConfig.GasRequiredByMessage(msg)
-		if !exists {
-			panic(errors.Errorf("unsuported message type for deterministic gas: %v", reflect.TypeOf(msg).String()))
-		}
-		totalGasRequired += msgGas
+		totalGasRequired += c.gasRequiredByMessage(msg)
 	}
 
 	return totalGasRequired + c.DeterministicGasConfig.FixedGas
 }
 
+// simulateExtensionTransfers returns the gas estimated by the simulation and true if any of the messages transfers
+// the asset ft tokens with the extension feature, since the gas consumed by the extension contract is charged
+// on top of the deterministic gas.
+func (c ChainContext) simulateExtensionTransfers(msgs []sdk.Msg) (uint64, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	assetftClient := assetfttypes.NewQueryClient(c.ClientContext)
+	for _, msg := range msgs {
+		for _, coin := range c.DeterministicGasConfig.TransferredCoins(msg) {
+			if _, _, err := assetfttypes.DeconstructDenom(coin.Denom); err != nil {
+				continue
+			}
+			// the token might not be issued yet
+			tokenRes, err := assetftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: coin.Denom})
+			if err != nil {
+				continue
+			}
+			if !lo.Contains(tokenRes.Token.Features, assetfttypes.Feature_extension) {
+				continue
+			}
+
+			_, gas, err := client.CalculateGas(
+				ctx,
+				c.ClientContext.WithFromAddress(msgs[0].GetSigners()[0]),
+				c.TxFactory(),
+				msgs...,
+			)
+			if err != nil {
+				panic(errors.WithStack(err))
+			}
+			return gas, true
+		}
+	}
+
+	return 0, false
+}
+
+// gasRequiredByMessage returns the deterministic gas required by the message. The gas of the wasm execute messages
+// is taken from the schedule stored on chain, since it is not a part of the static config.
+func (c ChainContext) gasRequiredByMessage(msg sdk.Msg) uint64 {
+	if executeMsg, ok := msg.(*wasmtypes.MsgExecuteContract); ok {
+		if msgGas, exists := c.wasmExecuteGasRequired(executeMsg); exists {
+			return msgGas
+		}
+	}
+
+	msgGas, exists := c.DeterministicGasConfig.GasRequiredByMessage(msg)
+	if !exists {
+		panic(errors.Errorf("unsuported message type for deterministic gas: %v", reflect.TypeOf(msg).String()))
+	}
+	return msgGas
+}
+
+func (c ChainContext) wasmExecuteGasRequired(msg *wasmtypes.MsgExecuteContract) (uint64, bool) {
+	msgVariant, err := deterministicgastypes.WasmMsgVariant(msg.Msg)
+	if err != nil {
+		return 0, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	paramsRes, err := deterministicgastypes.NewQueryClient(c.ClientContext).Params(ctx, &deterministicgastypes.QueryParamsRequest{})
+	if err != nil {
+		panic(errors.WithStack(err))
+	}
+	contractInfoRes, err := wasmtypes.NewQueryClient(c.ClientContext).ContractInfo(ctx, &wasmtypes.QueryContractInfoRequest{
+		Address: msg.Contract,
+	})
+	if err != nil {
+		panic(errors.WithStack(err))
+	}
+
+	return paramsRes.Params.WasmExecuteGasRequired(contractInfoRes.CodeID, msg.Contract, msgVariant)
+}
+
 // BalancesOptions is the input type for the ComputeNeededBalanceFromOptions.
 type BalancesOptions struct {
 	Messages                    []sdk.Msg
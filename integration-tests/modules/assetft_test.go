@@ -4,6 +4,7 @@ package modules
 
 import (
 	"context"
+	"encoding/hex"
 	"testing"
 	"time"
 
@@ -72,6 +73,16 @@ func TestAssetFTIssue(t *testing.T) {
 
 	requireT.NoError(err)
 
+	// verify the denom of the issued token is returned in the response
+	txData, err := hex.DecodeString(res.Data)
+	requireT.NoError(err)
+	var txMsgData sdk.TxMsgData
+	requireT.NoError(txMsgData.Unmarshal(txData))
+	requireT.Len(txMsgData.Data, 1)
+	var issueRes assetfttypes.MsgIssueResponse
+	requireT.NoError(issueRes.Unmarshal(txMsgData.Data[0].Data))
+	requireT.Equal(assetfttypes.BuildDenom(issueMsg.Subunit, issuer), issueRes.Denom)
+
 	// verify issue fee was burnt
 	burntStr, err := event.FindStringEventAttribute(res.Events, banktypes.EventTypeCoinBurn, sdk.AttributeKeyAmount)
 	requireT.NoError(err)
@@ -209,6 +220,7 @@ func TestAssetIssueAndQueryTokens(t *testing.T) {
 		Description:        msg1.Description,
 		BurnRate:           msg1.BurnRate,
 		SendCommissionRate: msg1.SendCommissionRate,
+		MaxSupply:          sdk.ZeroInt(),
 	}, gotToken.Tokens[0])
 }
 
@@ -346,6 +358,132 @@ func TestAssetFTMint(t *testing.T) {
 	assertT.EqualValues(mintCoin, newSupply.GetAmount().Sub(oldSupply.GetAmount()))
 }
 
+// TestAssetFTTransferAndClearAdmin checks that admin role can be transferred and cleared.
+func TestAssetFTTransferAndClearAdmin(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	newAdmin := chain.GenAccount()
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgTransferAdmin{},
+				&assetfttypes.MsgMint{},
+			},
+			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, newAdmin, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgMint{},
+				&assetfttypes.MsgClearAdmin{},
+				&assetfttypes.MsgMint{},
+			},
+		}))
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ADMIN",
+		Subunit:       "uadmin",
+		Precision:     6,
+		Description:   "ADMIN Description",
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_minting},
+	}
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	fungibleTokenIssuedEvts, err := event.FindTypedEvents[*assetfttypes.EventIssued](res.Events)
+	requireT.NoError(err)
+	denom := fungibleTokenIssuedEvts[0].Denom
+
+	// transfer admin
+	transferAdminMsg := &assetfttypes.MsgTransferAdmin{
+		Sender:  issuer.String(),
+		Account: newAdmin.String(),
+		Denom:   denom,
+	}
+	res, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(transferAdminMsg)),
+		transferAdminMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(transferAdminMsg))
+	adminTransferredEvts, err := event.FindTypedEvents[*assetfttypes.EventAdminTransferred](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(assetfttypes.EventAdminTransferred{
+		Denom:         denom,
+		PreviousAdmin: issuer.String(),
+		CurrentAdmin:  newAdmin.String(),
+	}, *adminTransferredEvts[0])
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	tokenRes, err := ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(newAdmin.String(), tokenRes.Token.Admin)
+
+	// the issuer is not the admin anymore, so it can't mint
+	mintMsg := &assetfttypes.MsgMint{
+		Sender: issuer.String(),
+		Coin:   sdk.NewCoin(denom, sdk.NewInt(100)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the new admin can mint
+	mintMsg.Sender = newAdmin.String()
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(newAdmin),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	requireT.NoError(err)
+
+	// clear admin
+	clearAdminMsg := &assetfttypes.MsgClearAdmin{
+		Sender: newAdmin.String(),
+		Denom:  denom,
+	}
+	res, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(newAdmin),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(clearAdminMsg)),
+		clearAdminMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(clearAdminMsg))
+
+	tokenRes, err = ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Empty(tokenRes.Token.Admin)
+
+	// nobody can mint after the admin is cleared
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(newAdmin),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
 // TestAssetFTBurn tests burn functionality of fungible tokens.
 func TestAssetFTBurn(t *testing.T) {
 	t.Parallel()
@@ -2153,87 +2291,61 @@ func TestAssetFTWhitelistIssuerAccount(t *testing.T) {
 	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 }
 
-// TestBareToken checks non of the features will work if the flags are not set.
-func TestBareToken(t *testing.T) {
+// TestAssetFTClawback checks clawback functionality of fungible tokens.
+func TestAssetFTClawback(t *testing.T) {
 	t.Parallel()
 
 	ctx, chain := integrationtests.NewTestingContext(t)
 
 	requireT := require.New(t)
-	assertT := assert.New(t)
 	issuer := chain.GenAccount()
-	recipient := chain.GenAccount()
+	account := chain.GenAccount()
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
 	requireT.NoError(
 		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
 			Messages: []sdk.Msg{
 				&assetfttypes.MsgIssue{},
-				&assetfttypes.MsgMint{},
-				&assetfttypes.MsgBurn{},
 				&banktypes.MsgSend{},
 				&assetfttypes.MsgFreeze{},
-				&assetfttypes.MsgGloballyFreeze{},
-				&assetfttypes.MsgSetWhitelistedLimit{},
+				&assetfttypes.MsgClawback{},
 			},
 			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
 		}))
 	requireT.NoError(
-		chain.Faucet.FundAccountsWithOptions(ctx, recipient, integrationtests.BalancesOptions{
+		chain.Faucet.FundAccountsWithOptions(ctx, account, integrationtests.BalancesOptions{
 			Messages: []sdk.Msg{
-				&assetfttypes.MsgBurn{},
+				&assetfttypes.MsgClawback{},
 			},
 		}))
 
-	// Issue a bare token
-	amount := sdk.NewInt(1000)
-	msg := &assetfttypes.MsgIssue{
+	issueMsg := &assetfttypes.MsgIssue{
 		Issuer:        issuer.String(),
-		Symbol:        "baretoken",
-		Subunit:       "baretoken",
-		InitialAmount: amount,
-		Precision:     10,
+		Symbol:        "CLAWBACK",
+		Subunit:       "uclawback",
+		Precision:     6,
+		Description:   "CLAWBACK Description",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_freezing,
+			assetfttypes.Feature_clawback,
+		},
 	}
-	denom := assetfttypes.BuildDenom(msg.Subunit, issuer)
-
-	_, err := client.BroadcastTx(
+	res, err := client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(msg)),
-		msg,
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
 	)
-
 	requireT.NoError(err)
+	fungibleTokenIssuedEvts, err := event.FindTypedEvents[*assetfttypes.EventIssued](res.Events)
+	requireT.NoError(err)
+	denom := fungibleTokenIssuedEvts[0].Denom
 
-	// try to mint
-	mintMsg := &assetfttypes.MsgMint{
-		Sender: issuer.String(),
-		Coin:   sdk.NewCoin(denom, sdk.NewInt(1000)),
-	}
-	_, err = client.BroadcastTx(
-		ctx,
-		chain.ClientContext.WithFromAddress(issuer),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
-		mintMsg,
-	)
-	assertT.True(assetfttypes.ErrFeatureDisabled.Is(err))
-
-	// try to burn from issuer account (must succeed)
-	burnMsg := &assetfttypes.MsgBurn{
-		Sender: issuer.String(),
-		Coin:   sdk.NewCoin(denom, sdk.NewInt(10)),
-	}
-	_, err = client.BroadcastTx(
-		ctx,
-		chain.ClientContext.WithFromAddress(issuer),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
-		burnMsg,
-	)
-	assertT.NoError(err)
-
-	// try to burn from non-issuer account (must fail)
 	sendMsg := &banktypes.MsgSend{
 		FromAddress: issuer.String(),
-		ToAddress:   recipient.String(),
-		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10))),
+		ToAddress:   account.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
 	}
 	_, err = client.BroadcastTx(
 		ctx,
@@ -2241,300 +2353,258 @@ func TestBareToken(t *testing.T) {
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
 		sendMsg,
 	)
-	assertT.NoError(err)
-
-	burnMsg = &assetfttypes.MsgBurn{
-		Sender: recipient.String(),
-		Coin:   sdk.NewCoin(denom, sdk.NewInt(10)),
-	}
-	_, err = client.BroadcastTx(
-		ctx,
-		chain.ClientContext.WithFromAddress(recipient),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
-		burnMsg,
-	)
-	assertT.ErrorIs(err, assetfttypes.ErrFeatureDisabled)
+	requireT.NoError(err)
 
-	// try to whitelist
-	whitelistMsg := &assetfttypes.MsgSetWhitelistedLimit{
+	// freeze the whole balance, clawback must work anyway
+	freezeMsg := &assetfttypes.MsgFreeze{
 		Sender:  issuer.String(),
-		Account: recipient.String(),
-		Coin:    sdk.NewCoin(denom, sdk.NewInt(1000)),
+		Account: account.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(100)),
 	}
 	_, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(whitelistMsg)),
-		whitelistMsg,
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
+		freezeMsg,
 	)
-	assertT.ErrorIs(err, assetfttypes.ErrFeatureDisabled)
+	requireT.NoError(err)
 
-	// try to freeze
-	freezeMsg := &assetfttypes.MsgFreeze{
-		Sender:  issuer.String(),
-		Account: recipient.String(),
-		Coin:    sdk.NewCoin(denom, sdk.NewInt(1000)),
+	// try to clawback by non-admin
+	clawbackMsg := &assetfttypes.MsgClawback{
+		Sender:  account.String(),
+		Account: issuer.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(100)),
 	}
 	_, err = client.BroadcastTx(
 		ctx,
-		chain.ClientContext.WithFromAddress(issuer),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
-		freezeMsg,
+		chain.ClientContext.WithFromAddress(account),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(clawbackMsg)),
+		clawbackMsg,
 	)
-	assertT.ErrorIs(err, assetfttypes.ErrFeatureDisabled)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 
-	// try to globally freeze
-	globalFreezeMsg := &assetfttypes.MsgGloballyFreeze{
-		Sender: issuer.String(),
-		Denom:  denom,
+	// clawback by admin
+	clawbackMsg = &assetfttypes.MsgClawback{
+		Sender:  issuer.String(),
+		Account: account.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(60)),
 	}
-	_, err = client.BroadcastTx(
+	res, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(globalFreezeMsg)),
-		globalFreezeMsg,
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(clawbackMsg)),
+		clawbackMsg,
 	)
-	assertT.ErrorIs(err, assetfttypes.ErrFeatureDisabled)
+	requireT.NoError(err)
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(clawbackMsg))
+
+	clawbackEvts, err := event.FindTypedEvents[*assetfttypes.EventAmountClawedBack](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetfttypes.EventAmountClawedBack{
+		Account: account.String(),
+		Denom:   denom,
+		Amount:  sdk.NewInt(60),
+	}, clawbackEvts[0])
+
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: account.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(40).String(), balanceRes.Balance.Amount.String())
 }
 
-// TestAuthz tests the authz module works well with assetft module.
-func TestAuthzWithAssetFT(t *testing.T) {
+// TestAssetFTTimedFreeze checks that timed freeze limits the balance until the unlock time.
+func TestAssetFTTimedFreeze(t *testing.T) {
 	t.Parallel()
 
 	ctx, chain := integrationtests.NewTestingContext(t)
 
 	requireT := require.New(t)
-
-	assetftClient := assetfttypes.NewQueryClient(chain.ClientContext)
-	authzClient := authztypes.NewQueryClient(chain.ClientContext)
-
-	granter := chain.GenAccount()
-	grantee := chain.GenAccount()
+	issuer := chain.GenAccount()
 	recipient := chain.GenAccount()
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
 
-	require.NoError(t, chain.Faucet.FundAccountsWithOptions(ctx, granter, integrationtests.BalancesOptions{
-		Messages: []sdk.Msg{
-			&assetfttypes.MsgIssue{},
-			&authztypes.MsgGrant{},
-			&authztypes.MsgGrant{},
-		},
-		Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
-	}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgTimedFreeze{},
+			},
+			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, recipient, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+			},
+		}))
 
-	// mint and grant authorization
 	issueMsg := &assetfttypes.MsgIssue{
-		Issuer:        granter.String(),
-		Symbol:        "symbol",
-		Subunit:       "subunit",
-		Precision:     1,
+		Issuer:        issuer.String(),
+		Symbol:        "TIMEDFREEZE",
+		Subunit:       "utimedfreeze",
+		Precision:     6,
+		Description:   "TIMEDFREEZE Description",
 		InitialAmount: sdk.NewInt(1000),
 		Features: []assetfttypes.Feature{
 			assetfttypes.Feature_freezing,
-			assetfttypes.Feature_whitelisting,
 		},
 	}
-	denom := assetfttypes.BuildDenom(issueMsg.Subunit, granter)
-	grantFreezeMsg, err := authztypes.NewMsgGrant(
-		granter,
-		grantee,
-		authztypes.NewGenericAuthorization(sdk.MsgTypeURL(&assetfttypes.MsgFreeze{})),
-		time.Now().Add(time.Minute),
-	)
-	require.NoError(t, err)
-
-	grantWhitelistMsg, err := authztypes.NewMsgGrant(
-		granter,
-		grantee,
-		authztypes.NewGenericAuthorization(sdk.MsgTypeURL(&assetfttypes.MsgSetWhitelistedLimit{})),
-		time.Now().Add(time.Minute),
-	)
-	require.NoError(t, err)
-
-	_, err = client.BroadcastTx(
+	res, err := client.BroadcastTx(
 		ctx,
-		chain.ClientContext.WithFromAddress(granter),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(grantFreezeMsg, grantWhitelistMsg, issueMsg)),
-		grantFreezeMsg, grantWhitelistMsg, issueMsg,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
 	)
 	requireT.NoError(err)
-
-	// assert granted
-	gransRes, err := authzClient.Grants(ctx, &authztypes.QueryGrantsRequest{
-		Granter: granter.String(),
-		Grantee: grantee.String(),
-	})
+	fungibleTokenIssuedEvts, err := event.FindTypedEvents[*assetfttypes.EventIssued](res.Events)
 	requireT.NoError(err)
-	requireT.Equal(2, len(gransRes.Grants))
+	denom := fungibleTokenIssuedEvts[0].Denom
 
-	// try to whitelist and freeze using the authz
-	msgFreeze := &assetfttypes.MsgFreeze{
-		Sender:  granter.String(),
-		Account: recipient.String(),
-		Coin:    sdk.NewCoin(denom, sdk.NewInt(240)),
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
 	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
 
-	msgWhitelist := &assetfttypes.MsgSetWhitelistedLimit{
-		Sender:  granter.String(),
-		Account: recipient.String(),
-		Coin:    sdk.NewCoin(denom, sdk.NewInt(921)),
+	// freeze the whole balance for a short period
+	unlockTime := time.Now().UTC().Add(10 * time.Second).Truncate(time.Second)
+	timedFreezeMsg := &assetfttypes.MsgTimedFreeze{
+		Sender:     issuer.String(),
+		Account:    recipient.String(),
+		Coin:       sdk.NewCoin(denom, sdk.NewInt(100)),
+		UnlockTime: unlockTime,
 	}
-
-	execMsg := authztypes.NewMsgExec(grantee, []sdk.Msg{msgFreeze, msgWhitelist})
-	require.NoError(t, chain.Faucet.FundAccountsWithOptions(ctx, grantee, integrationtests.BalancesOptions{
-		Messages: []sdk.Msg{
-			&execMsg,
-		},
-	}))
-
-	_, err = client.BroadcastTx(
+	res, err = client.BroadcastTx(
 		ctx,
-		chain.ClientContext.WithFromAddress(grantee),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(&execMsg)),
-		&execMsg,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(timedFreezeMsg)),
+		timedFreezeMsg,
 	)
 	requireT.NoError(err)
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(timedFreezeMsg))
 
-	freezingRes, err := assetftClient.FrozenBalance(ctx, &assetfttypes.QueryFrozenBalanceRequest{
-		Account: recipient.String(),
-		Denom:   denom,
-	})
+	addedEvts, err := event.FindTypedEvents[*assetfttypes.EventTimedFreezeAdded](res.Events)
 	requireT.NoError(err)
-	requireT.EqualValues("240", freezingRes.GetBalance().Amount.String())
+	requireT.Equal(&assetfttypes.EventTimedFreezeAdded{
+		Account:    recipient.String(),
+		Denom:      denom,
+		Amount:     sdk.NewInt(100),
+		UnlockTime: unlockTime,
+	}, addedEvts[0])
 
-	whitelistingRes, err := assetftClient.WhitelistedBalance(ctx, &assetfttypes.QueryWhitelistedBalanceRequest{
+	frozenRes, err := ftClient.FrozenBalance(ctx, &assetfttypes.QueryFrozenBalanceRequest{
 		Account: recipient.String(),
 		Denom:   denom,
 	})
 	requireT.NoError(err)
-	requireT.EqualValues("921", whitelistingRes.GetBalance().Amount.String())
-}
-
-// TestAssetFTBurnRate_OnMinting verifies both burn rate and send commission rate are not applied on received minted tokens.
-func TestAssetFT_RatesAreNotApplied_OnMinting(t *testing.T) {
-	assertT := assert.New(t)
-	requireT := require.New(t)
-
-	ctx, chain := integrationtests.NewTestingContext(t)
-	issuer := chain.GenAccount()
-
-	bankClient := banktypes.NewQueryClient(chain.ClientContext)
-
-	requireT.NoError(
-		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
-			Messages: []sdk.Msg{
-				&assetfttypes.MsgIssue{},
-				&assetfttypes.MsgMint{},
-			},
-			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
-		}),
-	)
+	requireT.Equal(sdk.NewCoin(denom, sdk.ZeroInt()).String(), frozenRes.Balance.String())
+	requireT.Equal([]assetfttypes.TimedFreeze{{
+		Account:    recipient.String(),
+		Coin:       sdk.NewCoin(denom, sdk.NewInt(100)),
+		UnlockTime: unlockTime,
+	}}, frozenRes.TimedFreezes)
 
-	// Issue a fungible token
-	issueMsg := &assetfttypes.MsgIssue{
-		Issuer:             issuer.String(),
-		Symbol:             "ABC",
-		Subunit:            "abc",
-		Precision:          6,
-		InitialAmount:      sdk.NewInt(1000),
-		Description:        "ABC Description",
-		Features:           []assetfttypes.Feature{assetfttypes.Feature_minting},
-		BurnRate:           sdk.MustNewDecFromStr("0.10"),
-		SendCommissionRate: sdk.MustNewDecFromStr("0.10"),
+	// try to send the frozen tokens
+	sendMsg = &banktypes.MsgSend{
+		FromAddress: recipient.String(),
+		ToAddress:   issuer.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
 	}
-
-	_, err := client.BroadcastTx(
+	_, err = client.BroadcastTx(
 		ctx,
-		chain.ClientContext.WithFromAddress(issuer),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
-		issueMsg,
+		chain.ClientContext.WithFromAddress(recipient),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
 	)
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
 
-	requireT.NoError(err)
-	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+	// wait for the unlock time and send the released tokens
+	time.Sleep(time.Until(unlockTime) + 2*time.Second)
 
-	// mint tokens
+	frozenRes, err = ftClient.FrozenBalance(ctx, &assetfttypes.QueryFrozenBalanceRequest{
+		Account: recipient.String(),
+		Denom:   denom,
+	})
 	requireT.NoError(err)
-	mintCoin := sdk.NewCoin(denom, sdk.NewInt(500))
-	mintMsg := &assetfttypes.MsgMint{
-		Sender: issuer.String(),
-		Coin:   mintCoin,
-	}
+	requireT.Empty(frozenRes.TimedFreezes)
+
 	_, err = client.BroadcastTx(
 		ctx,
-		chain.ClientContext.WithFromAddress(issuer),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
-		mintMsg,
+		chain.ClientContext.WithFromAddress(recipient),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
 	)
 	requireT.NoError(err)
-
-	// verify balance of token was not affected by either burn rate or send commission rate
-	balance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: issuer.String(), Denom: denom})
-	requireT.NoError(err)
-	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(1500)).String(), balance.GetBalance().String())
 }
 
-// TestAssetFTBurnRate_OnBurning verifies that both burn rate and send commission rate are not applied when a token is burnt.
-func TestAssetFTBurnRate_SendCommissionRate_OnBurning(t *testing.T) {
+// TestAssetFTBlacklist checks blacklisting functionality of fungible tokens.
+func TestAssetFTBlacklist(t *testing.T) {
 	t.Parallel()
 
 	ctx, chain := integrationtests.NewTestingContext(t)
 
 	requireT := require.New(t)
-	assertT := assert.New(t)
 	issuer := chain.GenAccount()
 	recipient := chain.GenAccount()
-
-	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
 
 	requireT.NoError(
 		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
 			Messages: []sdk.Msg{
-				&banktypes.MsgSend{},
 				&assetfttypes.MsgIssue{},
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgAddToBlacklist{},
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgRemoveFromBlacklist{},
+				&banktypes.MsgSend{},
 			},
 			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
-		}),
-	)
-
+		}))
 	requireT.NoError(
 		chain.Faucet.FundAccountsWithOptions(ctx, recipient, integrationtests.BalancesOptions{
 			Messages: []sdk.Msg{
-				&assetfttypes.MsgBurn{},
+				&banktypes.MsgSend{},
 			},
-			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
-		}),
-	)
+		}))
 
-	// Issue a fungible token
 	issueMsg := &assetfttypes.MsgIssue{
-		Issuer:             issuer.String(),
-		Symbol:             "ABC",
-		Subunit:            "abc",
-		Precision:          6,
-		InitialAmount:      sdk.NewInt(1000),
-		Description:        "ABC Description",
-		Features:           []assetfttypes.Feature{assetfttypes.Feature_burning},
-		BurnRate:           sdk.MustNewDecFromStr("0.20"),
-		SendCommissionRate: sdk.MustNewDecFromStr("0.10"),
+		Issuer:        issuer.String(),
+		Symbol:        "BLACKLIST",
+		Subunit:       "ublacklist",
+		Precision:     6,
+		Description:   "BLACKLIST Description",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_blacklisting,
+		},
 	}
-
-	_, err := client.BroadcastTx(
+	res, err := client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
 		issueMsg,
 	)
-
 	requireT.NoError(err)
-	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+	fungibleTokenIssuedEvts, err := event.FindTypedEvents[*assetfttypes.EventIssued](res.Events)
+	requireT.NoError(err)
+	denom := fungibleTokenIssuedEvts[0].Denom
 
-	// send some coins to the recipient
 	sendMsg := &banktypes.MsgSend{
 		FromAddress: issuer.String(),
 		ToAddress:   recipient.String(),
-		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(200))),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
 	}
-
 	_, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
@@ -2543,320 +2613,1257 @@ func TestAssetFTBurnRate_SendCommissionRate_OnBurning(t *testing.T) {
 	)
 	requireT.NoError(err)
 
-	// recipient burns tokens. Then check recipient and issuer balance, as well as total supply
-	oldSupply, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	// blacklist the recipient
+	addToBlacklistMsg := &assetfttypes.MsgAddToBlacklist{
+		Sender:  issuer.String(),
+		Account: recipient.String(),
+		Denom:   denom,
+	}
+	res, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(addToBlacklistMsg)),
+		addToBlacklistMsg,
+	)
 	requireT.NoError(err)
-	burnCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(addToBlacklistMsg))
 
-	burnMsg := &assetfttypes.MsgBurn{
-		Sender: recipient.String(),
-		Coin:   burnCoin,
+	addedEvts, err := event.FindTypedEvents[*assetfttypes.EventAddedToBlacklist](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetfttypes.EventAddedToBlacklist{
+		Account: recipient.String(),
+		Denom:   denom,
+	}, addedEvts[0])
+
+	blacklistedRes, err := ftClient.Blacklisted(ctx, &assetfttypes.QueryBlacklistedRequest{
+		Account: recipient.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.True(blacklistedRes.Blacklisted)
+
+	accountsRes, err := ftClient.BlacklistedAccounts(ctx, &assetfttypes.QueryBlacklistedAccountsRequest{
+		Denom: denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal([]string{recipient.String()}, accountsRes.Accounts)
+
+	// try to send to the blacklisted account
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.ErrorIs(err, assetfttypes.ErrBlacklisted)
+
+	// try to send from the blacklisted account
+	sendBackMsg := &banktypes.MsgSend{
+		FromAddress: recipient.String(),
+		ToAddress:   issuer.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
 	}
 	_, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(recipient),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
-		burnMsg,
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendBackMsg)),
+		sendBackMsg,
 	)
-	requireT.NoError(err)
+	requireT.ErrorIs(err, assetfttypes.ErrBlacklisted)
 
-	issuerBalance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: issuer.String(), Denom: denom})
-	requireT.NoError(err)
-	recipientBalance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.String(), Denom: denom})
+	// remove from the blacklist and send
+	removeFromBlacklistMsg := &assetfttypes.MsgRemoveFromBlacklist{
+		Sender:  issuer.String(),
+		Account: recipient.String(),
+		Denom:   denom,
+	}
+	res, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(removeFromBlacklistMsg)),
+		removeFromBlacklistMsg,
+	)
 	requireT.NoError(err)
-	// verify issuer balance after burning was not affected by the send commission rate
-	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(800)).String(), issuerBalance.GetBalance().String())
-	// verify recipient balance after burning was not affected by the burn rate
-	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(100)).String(), recipientBalance.GetBalance().String())
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(removeFromBlacklistMsg))
 
-	newSupply, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
 	requireT.NoError(err)
-	// verify the total supply
-	assertT.EqualValues(burnCoin, oldSupply.GetAmount().Sub(newSupply.GetAmount()))
 }
 
-// TestAssetFTFreezeAndBurn verifies that it is not possible to burn more tokens - outside of freezing limit.
-func TestAssetFTFreezeAndBurn(t *testing.T) {
+// TestAssetFTUpdateMetadata checks metadata updating functionality of fungible tokens.
+func TestAssetFTUpdateMetadata(t *testing.T) {
 	t.Parallel()
 
 	ctx, chain := integrationtests.NewTestingContext(t)
 
 	requireT := require.New(t)
-	assertT := assert.New(t)
 	issuer := chain.GenAccount()
-	recipient := chain.GenAccount()
-
-	bankClient := banktypes.NewQueryClient(chain.ClientContext)
 
 	requireT.NoError(
 		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
 			Messages: []sdk.Msg{
-				&banktypes.MsgSend{},
 				&assetfttypes.MsgIssue{},
-				&assetfttypes.MsgFreeze{},
+				&assetfttypes.MsgUpdateMetadata{},
 			},
 			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
-		}),
-	)
+		}))
 
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "META",
+		Subunit:       "umeta",
+		Precision:     6,
+		Description:   "META Description",
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_metadata_updating},
+		URI:           "https://my-token-meta.invalid/1",
+		URIHash:       "content-hash",
+	}
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	fungibleTokenIssuedEvts, err := event.FindTypedEvents[*assetfttypes.EventIssued](res.Events)
+	requireT.NoError(err)
+	denom := fungibleTokenIssuedEvts[0].Denom
+
+	updateMetadataMsg := &assetfttypes.MsgUpdateMetadata{
+		Sender:      issuer.String(),
+		Denom:       denom,
+		Description: "META New Description",
+		URI:         "https://my-token-meta.invalid/2",
+		URIHash:     "new-content-hash",
+	}
+	res, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(updateMetadataMsg)),
+		updateMetadataMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(updateMetadataMsg))
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	tokenRes, err := ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(updateMetadataMsg.Description, tokenRes.Token.Description)
+	requireT.Equal(updateMetadataMsg.URI, tokenRes.Token.URI)
+	requireT.Equal(updateMetadataMsg.URIHash, tokenRes.Token.URIHash)
+}
+
+// TestBareToken checks non of the features will work if the flags are not set.
+func TestBareToken(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
 	requireT.NoError(
-		chain.Faucet.FundAccountsWithOptions(ctx, recipient, integrationtests.BalancesOptions{
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
 			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgMint{},
 				&assetfttypes.MsgBurn{},
-				&assetfttypes.MsgBurn{},
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgFreeze{},
+				&assetfttypes.MsgGloballyFreeze{},
+				&assetfttypes.MsgSetWhitelistedLimit{},
 			},
 			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
-		}),
-	)
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, recipient, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgBurn{},
+			},
+		}))
 
-	// Issue a fungible token
-	issueMsg := &assetfttypes.MsgIssue{
-		Issuer:             issuer.String(),
-		Symbol:             "ABC",
-		Subunit:            "abc",
-		Precision:          6,
-		InitialAmount:      sdk.NewInt(1000),
-		Description:        "ABC Description",
-		Features:           []assetfttypes.Feature{assetfttypes.Feature_burning, assetfttypes.Feature_freezing},
-		BurnRate:           sdk.NewDec(0),
-		SendCommissionRate: sdk.NewDec(0),
+	// Issue a bare token
+	amount := sdk.NewInt(1000)
+	msg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "baretoken",
+		Subunit:       "baretoken",
+		InitialAmount: amount,
+		Precision:     10,
 	}
+	denom := assetfttypes.BuildDenom(msg.Subunit, issuer)
 
 	_, err := client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
-		issueMsg,
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(msg)),
+		msg,
 	)
 
 	requireT.NoError(err)
-	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
 
-	// send some coins to the recipient
+	// try to mint
+	mintMsg := &assetfttypes.MsgMint{
+		Sender: issuer.String(),
+		Coin:   sdk.NewCoin(denom, sdk.NewInt(1000)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	assertT.True(assetfttypes.ErrFeatureDisabled.Is(err))
+
+	// try to burn from issuer account (must succeed)
+	burnMsg := &assetfttypes.MsgBurn{
+		Sender: issuer.String(),
+		Coin:   sdk.NewCoin(denom, sdk.NewInt(10)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
+		burnMsg,
+	)
+	assertT.NoError(err)
+
+	// try to burn from non-issuer account (must fail)
 	sendMsg := &banktypes.MsgSend{
 		FromAddress: issuer.String(),
 		ToAddress:   recipient.String(),
-		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(550))),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10))),
 	}
-
 	_, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
 		sendMsg,
 	)
-	requireT.NoError(err)
+	assertT.NoError(err)
 
-	// freeze 300 tokens
-	freezeMsg := &assetfttypes.MsgFreeze{
+	burnMsg = &assetfttypes.MsgBurn{
+		Sender: recipient.String(),
+		Coin:   sdk.NewCoin(denom, sdk.NewInt(10)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(recipient),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
+		burnMsg,
+	)
+	assertT.ErrorIs(err, assetfttypes.ErrFeatureDisabled)
+
+	// try to whitelist
+	whitelistMsg := &assetfttypes.MsgSetWhitelistedLimit{
 		Sender:  issuer.String(),
 		Account: recipient.String(),
-		Coin:    sdk.NewCoin(denom, sdk.NewInt(300)),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(1000)),
 	}
 	_, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
-		freezeMsg,
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(whitelistMsg)),
+		whitelistMsg,
 	)
-	requireT.NoError(err)
-
-	// recipient burns tokens within allowed unfrozen limit
-	burnCoin := sdk.NewCoin(denom, sdk.NewInt(200))
+	assertT.ErrorIs(err, assetfttypes.ErrFeatureDisabled)
 
-	burnMsg := &assetfttypes.MsgBurn{
-		Sender: recipient.String(),
-		Coin:   burnCoin,
+	// try to freeze
+	freezeMsg := &assetfttypes.MsgFreeze{
+		Sender:  issuer.String(),
+		Account: recipient.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(1000)),
 	}
 	_, err = client.BroadcastTx(
 		ctx,
-		chain.ClientContext.WithFromAddress(recipient),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
-		burnMsg,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
+		freezeMsg,
 	)
-	requireT.NoError(err)
-
-	recipientBalance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.String(), Denom: denom})
-	requireT.NoError(err)
-	// verify recipient balance after burning
-	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(350)).String(), recipientBalance.GetBalance().String())
+	assertT.ErrorIs(err, assetfttypes.ErrFeatureDisabled)
 
-	// recipient tries to burn more token than allowed (left from unfrozen balance). Tx should fail
+	// try to globally freeze
+	globalFreezeMsg := &assetfttypes.MsgGloballyFreeze{
+		Sender: issuer.String(),
+		Denom:  denom,
+	}
 	_, err = client.BroadcastTx(
 		ctx,
-		chain.ClientContext.WithFromAddress(recipient),
-		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
-		burnMsg,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(globalFreezeMsg)),
+		globalFreezeMsg,
 	)
-	requireT.Error(err)
-	assertT.True(sdkerrors.ErrInsufficientFunds.Is(err))
-	// verify recipient balance did not change
-	recipientBalance, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.String(), Denom: denom})
-	requireT.NoError(err)
-	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(350)).String(), recipientBalance.GetBalance().String())
+	assertT.ErrorIs(err, assetfttypes.ErrFeatureDisabled)
 }
 
-// TestAssetFTFreeze_WithRates verifies freezing with both burn and send commission rates applied
-// and when one of the rates goes outside of unfrozen balance.
-func TestAssetFTFreeze_WithRates(t *testing.T) {
+// TestAuthz tests the authz module works well with assetft module.
+func TestAuthzWithAssetFT(t *testing.T) {
 	t.Parallel()
 
-	testData := []struct {
-		description              string
-		burnRate                 sdk.Dec
-		sendCommissionRate       sdk.Dec
-		expectedCoinDistribution []int
-	}{
-		{"WithBurnRateOutOfLimit", sdk.MustNewDecFromStr("0.50"), sdk.MustNewDecFromStr("0.10"), []int{510, 340, 100}},
-		{"WithSendCommissionRateOutOfLimit", sdk.MustNewDecFromStr("0.10"), sdk.MustNewDecFromStr("0.50"), []int{550, 340, 100}},
-	}
-
-	for _, tc := range testData {
-		tc := tc
-		t.Run(tc.description, func(t *testing.T) {
-			t.Parallel()
-
-			ctx, chain := integrationtests.NewTestingContext(t)
+	ctx, chain := integrationtests.NewTestingContext(t)
 
-			requireT := require.New(t)
-			assertT := assert.New(t)
-			issuer := chain.GenAccount()
-			recipient1 := chain.GenAccount()
-			recipient2 := chain.GenAccount()
+	requireT := require.New(t)
 
-			requireT.NoError(
-				chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
-					Messages: []sdk.Msg{
-						&banktypes.MsgSend{},
-						&assetfttypes.MsgIssue{},
-						&assetfttypes.MsgFreeze{},
-					},
-					Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
-				}),
-			)
+	assetftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	authzClient := authztypes.NewQueryClient(chain.ClientContext)
 
-			requireT.NoError(
-				chain.Faucet.FundAccountsWithOptions(ctx, recipient1, integrationtests.BalancesOptions{
-					Messages: []sdk.Msg{
-						&banktypes.MsgSend{},
-						&banktypes.MsgSend{},
-					},
-					Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
-				}),
-			)
+	granter := chain.GenAccount()
+	grantee := chain.GenAccount()
+	recipient := chain.GenAccount()
 
-			// Issue a fungible token
-			issueMsg := &assetfttypes.MsgIssue{
-				Issuer:             issuer.String(),
-				Symbol:             "ABC",
-				Subunit:            "abc",
-				Precision:          6,
-				InitialAmount:      sdk.NewInt(1000),
-				Description:        "ABC Description",
-				Features:           []assetfttypes.Feature{assetfttypes.Feature_freezing},
-				BurnRate:           tc.burnRate,           // set burn rate
-				SendCommissionRate: tc.sendCommissionRate, // set commission rate
-			}
+	require.NoError(t, chain.Faucet.FundAccountsWithOptions(ctx, granter, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgIssue{},
+			&authztypes.MsgGrant{},
+			&authztypes.MsgGrant{},
+		},
+		Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+	}))
 
-			_, err := client.BroadcastTx(
-				ctx,
-				chain.ClientContext.WithFromAddress(issuer),
-				chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
-				issueMsg,
+	// mint and grant authorization
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        granter.String(),
+		Symbol:        "symbol",
+		Subunit:       "subunit",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_freezing,
+			assetfttypes.Feature_whitelisting,
+		},
+	}
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, granter)
+	grantFreezeMsg, err := authztypes.NewMsgGrant(
+		granter,
+		grantee,
+		authztypes.NewGenericAuthorization(sdk.MsgTypeURL(&assetfttypes.MsgFreeze{})),
+		time.Now().Add(time.Minute),
+	)
+	require.NoError(t, err)
+
+	grantWhitelistMsg, err := authztypes.NewMsgGrant(
+		granter,
+		grantee,
+		authztypes.NewGenericAuthorization(sdk.MsgTypeURL(&assetfttypes.MsgSetWhitelistedLimit{})),
+		time.Now().Add(time.Minute),
+	)
+	require.NoError(t, err)
+
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(granter),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(grantFreezeMsg, grantWhitelistMsg, issueMsg)),
+		grantFreezeMsg, grantWhitelistMsg, issueMsg,
+	)
+	requireT.NoError(err)
+
+	// assert granted
+	gransRes, err := authzClient.Grants(ctx, &authztypes.QueryGrantsRequest{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+	})
+	requireT.NoError(err)
+	requireT.Equal(2, len(gransRes.Grants))
+
+	// try to whitelist and freeze using the authz
+	msgFreeze := &assetfttypes.MsgFreeze{
+		Sender:  granter.String(),
+		Account: recipient.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(240)),
+	}
+
+	msgWhitelist := &assetfttypes.MsgSetWhitelistedLimit{
+		Sender:  granter.String(),
+		Account: recipient.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(921)),
+	}
+
+	execMsg := authztypes.NewMsgExec(grantee, []sdk.Msg{msgFreeze, msgWhitelist})
+	require.NoError(t, chain.Faucet.FundAccountsWithOptions(ctx, grantee, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&execMsg,
+		},
+	}))
+
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(grantee),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(&execMsg)),
+		&execMsg,
+	)
+	requireT.NoError(err)
+
+	freezingRes, err := assetftClient.FrozenBalance(ctx, &assetfttypes.QueryFrozenBalanceRequest{
+		Account: recipient.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.EqualValues("240", freezingRes.GetBalance().Amount.String())
+
+	whitelistingRes, err := assetftClient.WhitelistedBalance(ctx, &assetfttypes.QueryWhitelistedBalanceRequest{
+		Account: recipient.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.EqualValues("921", whitelistingRes.GetBalance().Amount.String())
+}
+
+// TestAssetFTBurnRate_OnMinting verifies both burn rate and send commission rate are not applied on received minted tokens.
+func TestAssetFT_RatesAreNotApplied_OnMinting(t *testing.T) {
+	assertT := assert.New(t)
+	requireT := require.New(t)
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+	issuer := chain.GenAccount()
+
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgMint{},
+			},
+			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+		}),
+	)
+
+	// Issue a fungible token
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:             issuer.String(),
+		Symbol:             "ABC",
+		Subunit:            "abc",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		Description:        "ABC Description",
+		Features:           []assetfttypes.Feature{assetfttypes.Feature_minting},
+		BurnRate:           sdk.MustNewDecFromStr("0.10"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.10"),
+	}
+
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	// mint tokens
+	requireT.NoError(err)
+	mintCoin := sdk.NewCoin(denom, sdk.NewInt(500))
+	mintMsg := &assetfttypes.MsgMint{
+		Sender: issuer.String(),
+		Coin:   mintCoin,
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	requireT.NoError(err)
+
+	// verify balance of token was not affected by either burn rate or send commission rate
+	balance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: issuer.String(), Denom: denom})
+	requireT.NoError(err)
+	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(1500)).String(), balance.GetBalance().String())
+}
+
+// TestAssetFTBurnRate_OnBurning verifies that both burn rate and send commission rate are not applied when a token is burnt.
+func TestAssetFTBurnRate_SendCommissionRate_OnBurning(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgIssue{},
+			},
+			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+		}),
+	)
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, recipient, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgBurn{},
+			},
+			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+		}),
+	)
+
+	// Issue a fungible token
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:             issuer.String(),
+		Symbol:             "ABC",
+		Subunit:            "abc",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		Description:        "ABC Description",
+		Features:           []assetfttypes.Feature{assetfttypes.Feature_burning},
+		BurnRate:           sdk.MustNewDecFromStr("0.20"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.10"),
+	}
+
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	// send some coins to the recipient
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(200))),
+	}
+
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// recipient burns tokens. Then check recipient and issuer balance, as well as total supply
+	oldSupply, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	requireT.NoError(err)
+	burnCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+
+	burnMsg := &assetfttypes.MsgBurn{
+		Sender: recipient.String(),
+		Coin:   burnCoin,
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(recipient),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
+		burnMsg,
+	)
+	requireT.NoError(err)
+
+	issuerBalance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: issuer.String(), Denom: denom})
+	requireT.NoError(err)
+	recipientBalance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.String(), Denom: denom})
+	requireT.NoError(err)
+	// verify issuer balance after burning was not affected by the send commission rate
+	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(800)).String(), issuerBalance.GetBalance().String())
+	// verify recipient balance after burning was not affected by the burn rate
+	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(100)).String(), recipientBalance.GetBalance().String())
+
+	newSupply, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	requireT.NoError(err)
+	// verify the total supply
+	assertT.EqualValues(burnCoin, oldSupply.GetAmount().Sub(newSupply.GetAmount()))
+}
+
+// TestAssetFTFreezeAndBurn verifies that it is not possible to burn more tokens - outside of freezing limit.
+func TestAssetFTFreezeAndBurn(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgFreeze{},
+			},
+			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+		}),
+	)
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, recipient, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgBurn{},
+				&assetfttypes.MsgBurn{},
+			},
+			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+		}),
+	)
+
+	// Issue a fungible token
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:             issuer.String(),
+		Symbol:             "ABC",
+		Subunit:            "abc",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		Description:        "ABC Description",
+		Features:           []assetfttypes.Feature{assetfttypes.Feature_burning, assetfttypes.Feature_freezing},
+		BurnRate:           sdk.NewDec(0),
+		SendCommissionRate: sdk.NewDec(0),
+	}
+
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	// send some coins to the recipient
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(550))),
+	}
+
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// freeze 300 tokens
+	freezeMsg := &assetfttypes.MsgFreeze{
+		Sender:  issuer.String(),
+		Account: recipient.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(300)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
+		freezeMsg,
+	)
+	requireT.NoError(err)
+
+	// recipient burns tokens within allowed unfrozen limit
+	burnCoin := sdk.NewCoin(denom, sdk.NewInt(200))
+
+	burnMsg := &assetfttypes.MsgBurn{
+		Sender: recipient.String(),
+		Coin:   burnCoin,
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(recipient),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
+		burnMsg,
+	)
+	requireT.NoError(err)
+
+	recipientBalance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.String(), Denom: denom})
+	requireT.NoError(err)
+	// verify recipient balance after burning
+	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(350)).String(), recipientBalance.GetBalance().String())
+
+	// recipient tries to burn more token than allowed (left from unfrozen balance). Tx should fail
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(recipient),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
+		burnMsg,
+	)
+	requireT.Error(err)
+	assertT.True(sdkerrors.ErrInsufficientFunds.Is(err))
+	// verify recipient balance did not change
+	recipientBalance, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.String(), Denom: denom})
+	requireT.NoError(err)
+	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(350)).String(), recipientBalance.GetBalance().String())
+}
+
+// TestAssetFTFreeze_WithRates verifies freezing with both burn and send commission rates applied
+// and when one of the rates goes outside of unfrozen balance.
+func TestAssetFTFreeze_WithRates(t *testing.T) {
+	t.Parallel()
+
+	testData := []struct {
+		description              string
+		burnRate                 sdk.Dec
+		sendCommissionRate       sdk.Dec
+		expectedCoinDistribution []int
+	}{
+		{"WithBurnRateOutOfLimit", sdk.MustNewDecFromStr("0.50"), sdk.MustNewDecFromStr("0.10"), []int{510, 340, 100}},
+		{"WithSendCommissionRateOutOfLimit", sdk.MustNewDecFromStr("0.10"), sdk.MustNewDecFromStr("0.50"), []int{550, 340, 100}},
+	}
+
+	for _, tc := range testData {
+		tc := tc
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, chain := integrationtests.NewTestingContext(t)
+
+			requireT := require.New(t)
+			assertT := assert.New(t)
+			issuer := chain.GenAccount()
+			recipient1 := chain.GenAccount()
+			recipient2 := chain.GenAccount()
+
+			requireT.NoError(
+				chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+					Messages: []sdk.Msg{
+						&banktypes.MsgSend{},
+						&assetfttypes.MsgIssue{},
+						&assetfttypes.MsgFreeze{},
+					},
+					Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+				}),
+			)
+
+			requireT.NoError(
+				chain.Faucet.FundAccountsWithOptions(ctx, recipient1, integrationtests.BalancesOptions{
+					Messages: []sdk.Msg{
+						&banktypes.MsgSend{},
+						&banktypes.MsgSend{},
+					},
+					Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+				}),
+			)
+
+			// Issue a fungible token
+			issueMsg := &assetfttypes.MsgIssue{
+				Issuer:             issuer.String(),
+				Symbol:             "ABC",
+				Subunit:            "abc",
+				Precision:          6,
+				InitialAmount:      sdk.NewInt(1000),
+				Description:        "ABC Description",
+				Features:           []assetfttypes.Feature{assetfttypes.Feature_freezing},
+				BurnRate:           tc.burnRate,           // set burn rate
+				SendCommissionRate: tc.sendCommissionRate, // set commission rate
+			}
+
+			_, err := client.BroadcastTx(
+				ctx,
+				chain.ClientContext.WithFromAddress(issuer),
+				chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+				issueMsg,
+			)
+
+			requireT.NoError(err)
+			denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+			// send some coins to the recipient
+			sendMsg := &banktypes.MsgSend{
+				FromAddress: issuer.String(),
+				ToAddress:   recipient1.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500))),
+			}
+
+			_, err = client.BroadcastTx(
+				ctx,
+				chain.ClientContext.WithFromAddress(issuer),
+				chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+				sendMsg,
+			)
+			requireT.NoError(err)
+
+			// freeze 200 tokens
+			freezeMsg := &assetfttypes.MsgFreeze{
+				Sender:  issuer.String(),
+				Account: recipient1.String(),
+				Coin:    sdk.NewCoin(denom, sdk.NewInt(200)),
+			}
+			_, err = client.BroadcastTx(
+				ctx,
+				chain.ClientContext.WithFromAddress(issuer),
+				chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
+				freezeMsg,
+			)
+			requireT.NoError(err)
+
+			// send from recipient1 to recipient2 (burn and commission rate must apply) - within unfrozen balance limit
+			sendMsg = &banktypes.MsgSend{
+				FromAddress: recipient1.String(),
+				ToAddress:   recipient2.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
+			}
+
+			_, err = client.BroadcastTx(
+				ctx,
+				chain.ClientContext.WithFromAddress(recipient1),
+				chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+				sendMsg,
 			)
+			requireT.NoError(err)
+
+			assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
+				&issuer:     int64(tc.expectedCoinDistribution[0]),
+				&recipient1: int64(tc.expectedCoinDistribution[1]),
+				&recipient2: int64(tc.expectedCoinDistribution[2]),
+			})
+
+			// try to send from recipient1 to recipient2. Tx should fail because one of the rates
+			// (in the 1st case - burn rate, in the 2nd case - send commission rate) exceeds unfrozen balance limit
+			sendMsg = &banktypes.MsgSend{
+				FromAddress: recipient1.String(),
+				ToAddress:   recipient2.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
+			}
+
+			_, err = client.BroadcastTx(
+				ctx,
+				chain.ClientContext.WithFromAddress(recipient1),
+				chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+				sendMsg,
+			)
+			requireT.Error(err)
+			assertT.True(sdkerrors.ErrInsufficientFunds.Is(err))
+			// verify balances did not change
+			assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
+				&issuer:     int64(tc.expectedCoinDistribution[0]),
+				&recipient1: int64(tc.expectedCoinDistribution[1]),
+				&recipient2: int64(tc.expectedCoinDistribution[2]),
+			})
+		})
+	}
+}
+
+func assertCoinDistribution(ctx context.Context, clientCtx client.Context, t *testing.T, denom string, dist map[*sdk.AccAddress]int64) {
+	bankClient := banktypes.NewQueryClient(clientCtx)
+	requireT := require.New(t)
+
+	total := int64(0)
+	for acc, expectedBalance := range dist {
+		total += expectedBalance
+		getBalance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: acc.String(), Denom: denom})
+		requireT.NoError(err)
+		requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(expectedBalance)).String(), getBalance.Balance.String())
+	}
+
+	supply, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(total)).String(), supply.Amount.String())
+}
+
+// TestAssetFTMintAllowance tests the max supply and the mint allowances of fungible tokens.
+func TestAssetFTMintAllowance(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	minter := chain.GenAccount()
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgGrantMintAllowance{},
+				&assetfttypes.MsgRevokeMintAllowance{},
+			},
+			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, minter, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgMint{},
+				&assetfttypes.MsgMint{},
+				&assetfttypes.MsgMint{},
+			},
+		}))
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ALLOWANCE",
+		Subunit:       "uallowance",
+		Precision:     6,
+		Description:   "ALLOWANCE Description",
+		InitialAmount: sdk.NewInt(1000),
+		MaxSupply:     sdk.NewInt(1500),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_minting,
+		},
+	}
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	fungibleTokenIssuedEvts, err := event.FindTypedEvents[*assetfttypes.EventIssued](res.Events)
+	requireT.NoError(err)
+	denom := fungibleTokenIssuedEvts[0].Denom
+
+	tokenRes, err := ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(issueMsg.MaxSupply.String(), tokenRes.Token.MaxSupply.String())
+
+	// try to mint without the allowance
+	mintMsg := &assetfttypes.MsgMint{
+		Sender: minter.String(),
+		Coin:   sdk.NewCoin(denom, sdk.NewInt(300)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(minter),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// grant the allowance
+	grantMsg := &assetfttypes.MsgGrantMintAllowance{
+		Sender: issuer.String(),
+		Minter: minter.String(),
+		Coin:   sdk.NewCoin(denom, sdk.NewInt(700)),
+	}
+	res, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(grantMsg)),
+		grantMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(grantMsg))
+
+	// mint within the allowance
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(minter),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	requireT.NoError(err)
+
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: minter.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(mintMsg.Coin.String(), balanceRes.Balance.String())
+
+	allowanceRes, err := ftClient.MintAllowance(ctx, &assetfttypes.QueryMintAllowanceRequest{
+		Account: minter.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(400)).String(), allowanceRes.Allowance.String())
+
+	// try to mint above the max supply
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(minter),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	requireT.ErrorIs(err, assetfttypes.ErrMaxSupplyExceeded)
 
-			requireT.NoError(err)
-			denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+	// revoke the allowance
+	revokeMsg := &assetfttypes.MsgRevokeMintAllowance{
+		Sender: issuer.String(),
+		Minter: minter.String(),
+		Denom:  denom,
+	}
+	res, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(revokeMsg)),
+		revokeMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(revokeMsg))
 
-			// send some coins to the recipient
-			sendMsg := &banktypes.MsgSend{
-				FromAddress: issuer.String(),
-				ToAddress:   recipient1.String(),
-				Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500))),
-			}
+	allowancesRes, err := ftClient.MintAllowances(ctx, &assetfttypes.QueryMintAllowancesRequest{
+		Account: minter.String(),
+	})
+	requireT.NoError(err)
+	requireT.Empty(allowancesRes.Allowances)
+}
 
-			_, err = client.BroadcastTx(
-				ctx,
-				chain.ClientContext.WithFromAddress(issuer),
-				chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
-				sendMsg,
-			)
-			requireT.NoError(err)
+// TestAssetFTRateExemption checks that burn rate and send commission rate are not applied to exempt accounts.
+func TestAssetFTRateExemption(t *testing.T) {
+	t.Parallel()
 
-			// freeze 200 tokens
-			freezeMsg := &assetfttypes.MsgFreeze{
-				Sender:  issuer.String(),
-				Account: recipient1.String(),
-				Coin:    sdk.NewCoin(denom, sdk.NewInt(200)),
-			}
-			_, err = client.BroadcastTx(
-				ctx,
-				chain.ClientContext.WithFromAddress(issuer),
-				chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
-				freezeMsg,
-			)
-			requireT.NoError(err)
+	ctx, chain := integrationtests.NewTestingContext(t)
 
-			// send from recipient1 to recipient2 (burn and commission rate must apply) - within unfrozen balance limit
-			sendMsg = &banktypes.MsgSend{
-				FromAddress: recipient1.String(),
-				ToAddress:   recipient2.String(),
-				Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
-			}
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	exempt := chain.GenAccount()
+	recipient := chain.GenAccount()
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
 
-			_, err = client.BroadcastTx(
-				ctx,
-				chain.ClientContext.WithFromAddress(recipient1),
-				chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
-				sendMsg,
-			)
-			requireT.NoError(err)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgAddRateExemption{},
+				&assetfttypes.MsgRemoveRateExemption{},
+			},
+			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, exempt, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+			},
+		}))
 
-			assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
-				&issuer:     int64(tc.expectedCoinDistribution[0]),
-				&recipient1: int64(tc.expectedCoinDistribution[1]),
-				&recipient2: int64(tc.expectedCoinDistribution[2]),
-			})
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:             issuer.String(),
+		Symbol:             "EXEMPT",
+		Subunit:            "uexempt",
+		Precision:          6,
+		Description:        "EXEMPT Description",
+		InitialAmount:      sdk.NewInt(1000),
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+	}
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	fungibleTokenIssuedEvts, err := event.FindTypedEvents[*assetfttypes.EventIssued](res.Events)
+	requireT.NoError(err)
+	denom := fungibleTokenIssuedEvts[0].Denom
 
-			// try to send from recipient1 to recipient2. Tx should fail because one of the rates
-			// (in the 1st case - burn rate, in the 2nd case - send commission rate) exceeds unfrozen balance limit
-			sendMsg = &banktypes.MsgSend{
-				FromAddress: recipient1.String(),
-				ToAddress:   recipient2.String(),
-				Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
-			}
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   exempt.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
 
-			_, err = client.BroadcastTx(
-				ctx,
-				chain.ClientContext.WithFromAddress(recipient1),
-				chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
-				sendMsg,
-			)
-			requireT.Error(err)
-			assertT.True(sdkerrors.ErrInsufficientFunds.Is(err))
-			// verify balances did not change
-			assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
-				&issuer:     int64(tc.expectedCoinDistribution[0]),
-				&recipient1: int64(tc.expectedCoinDistribution[1]),
-				&recipient2: int64(tc.expectedCoinDistribution[2]),
-			})
-		})
+	// exempt the account
+	addMsg := &assetfttypes.MsgAddRateExemption{
+		Sender:  issuer.String(),
+		Account: exempt.String(),
+		Denom:   denom,
+	}
+	res, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(addMsg)),
+		addMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(addMsg))
+	addedEvts, err := event.FindTypedEvents[*assetfttypes.EventRateExemptionAdded](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetfttypes.EventRateExemptionAdded{
+		Account: exempt.String(),
+		Denom:   denom,
+	}, addedEvts[0])
+
+	exemptRes, err := ftClient.RateExempt(ctx, &assetfttypes.QueryRateExemptRequest{
+		Account: exempt.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.True(exemptRes.Exempt)
+
+	// send from the exempt account, rates must not be applied
+	sendMsg = &banktypes.MsgSend{
+		FromAddress: exempt.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(exempt),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: exempt.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(400)).String(), balanceRes.Balance.String())
+
+	// remove the exemption
+	removeMsg := &assetfttypes.MsgRemoveRateExemption{
+		Sender:  issuer.String(),
+		Account: exempt.String(),
+		Denom:   denom,
 	}
+	res, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(removeMsg)),
+		removeMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(removeMsg))
+
+	// send from the account again, rates must be applied
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(exempt),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	balanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: exempt.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(270)).String(), balanceRes.Balance.String())
 }
 
-func assertCoinDistribution(ctx context.Context, clientCtx client.Context, t *testing.T, denom string, dist map[*sdk.AccAddress]int64) {
-	bankClient := banktypes.NewQueryClient(clientCtx)
+// TestAssetFTCommissionRecipients checks that send commission is split between the commission recipients.
+func TestAssetFTCommissionRecipients(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
 	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	holder := chain.GenAccount()
+	receiver := chain.GenAccount()
+	treasury := chain.GenAccount()
+	pool := chain.GenAccount()
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
 
-	total := int64(0)
-	for acc, expectedBalance := range dist {
-		total += expectedBalance
-		getBalance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: acc.String(), Denom: denom})
-		requireT.NoError(err)
-		requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(expectedBalance)).String(), getBalance.Balance.String())
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgUpdateCommissionRecipients{},
+			},
+			Amount: chain.NetworkConfig.AssetFTConfig.IssueFee,
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, holder, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&banktypes.MsgSend{},
+			},
+		}))
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:             issuer.String(),
+		Symbol:             "COMMISSION",
+		Subunit:            "ucommission",
+		Precision:          6,
+		Description:        "COMMISSION Description",
+		InitialAmount:      sdk.NewInt(1000),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.25"),
 	}
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	fungibleTokenIssuedEvts, err := event.FindTypedEvents[*assetfttypes.EventIssued](res.Events)
+	requireT.NoError(err)
+	denom := fungibleTokenIssuedEvts[0].Denom
 
-	supply, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   holder.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(400))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
 	requireT.NoError(err)
-	requireT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(total)).String(), supply.Amount.String())
+
+	// set the commission recipients
+	updateMsg := &assetfttypes.MsgUpdateCommissionRecipients{
+		Sender: issuer.String(),
+		Denom:  denom,
+		Recipients: []assetfttypes.CommissionRecipient{
+			{Address: treasury.String(), Weight: 3},
+			{Address: pool.String(), Weight: 1},
+		},
+	}
+	res, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(updateMsg)),
+		updateMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(updateMsg))
+
+	tokenRes, err := ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(updateMsg.Recipients, tokenRes.Token.CommissionRecipients)
+
+	// send, the commission of 25 is split 3:1
+	sendMsg = &banktypes.MsgSend{
+		FromAddress: holder.String(),
+		ToAddress:   receiver.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	for account, expected := range map[string]int64{
+		issuer.String():   600,
+		holder.String():   275,
+		receiver.String(): 100,
+		treasury.String(): 19,
+		pool.String():     6,
+	} {
+		balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+			Address: account,
+			Denom:   denom,
+		})
+		requireT.NoError(err)
+		requireT.Equal(sdk.NewInt64Coin(denom, expected).String(), balanceRes.Balance.String())
+	}
 }
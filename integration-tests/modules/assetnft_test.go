@@ -4,6 +4,7 @@ package modules
 
 import (
 	"bytes"
+	"encoding/hex"
 	"testing"
 	"time"
 
@@ -144,6 +145,17 @@ func TestAssetNFTIssueClass(t *testing.T) {
 	issuedEvent := tokenIssuedEvents[0]
 
 	classID := assetnfttypes.BuildClassID(issueMsg.Symbol, issuer)
+
+	// verify the id of the issued class is returned in the response
+	txData, err := hex.DecodeString(res.Data)
+	requireT.NoError(err)
+	var txMsgData sdk.TxMsgData
+	requireT.NoError(txMsgData.Unmarshal(txData))
+	requireT.Len(txMsgData.Data, 1)
+	var issueClassRes assetnfttypes.MsgIssueClassResponse
+	requireT.NoError(issueClassRes.Unmarshal(txMsgData.Data[0].Data))
+	requireT.Equal(classID, issueClassRes.ID)
+
 	requireT.Equal(&assetnfttypes.EventClassIssued{
 		ID:          classID,
 		Issuer:      issuer.String(),
@@ -375,6 +387,156 @@ func TestAssetNFTMint(t *testing.T) {
 	requireT.Equal(chain.NewCoin(sdk.ZeroInt()).String(), resp.Balance.String())
 }
 
+// TestAssetNFTMintBatch tests batch minting and batch sending of non-fungible tokens.
+func TestAssetNFTMintBatch(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	recipient1 := chain.GenAccount()
+	recipient2 := chain.GenAccount()
+
+	nftClient := nft.NewQueryClient(chain.ClientContext)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer: issuer.String(),
+		Symbol: "NFTClassSymbol",
+	}
+	classID := assetnfttypes.BuildClassID(issueMsg.Symbol, issuer)
+
+	jsonData := []byte(`{"name": "Name", "description": "Description"}`)
+	data, err := codectypes.NewAnyWithValue(&assetnfttypes.DataBytes{Data: jsonData})
+	requireT.NoError(err)
+
+	mintBatchMsg := &assetnfttypes.MsgMintBatch{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		Items: []assetnfttypes.MintBatchItem{
+			{
+				ID:      "id-1",
+				URI:     "https://my-class-meta.invalid/1",
+				URIHash: "content-hash",
+				Data:    data,
+			},
+			{
+				ID: "id-2",
+			},
+			{
+				ID: "id-3",
+			},
+		},
+	}
+	sendBatchMsg := &nft.MsgSendBatch{
+		Sender: issuer.String(),
+		Items: []*nft.SendBatchItem{
+			{ClassId: classID, Id: "id-1", Receiver: recipient1.String()},
+			{ClassId: classID, Id: "id-2", Receiver: recipient2.String()},
+		},
+	}
+	failingSendBatchMsg := &nft.MsgSendBatch{
+		Sender: issuer.String(),
+		Items: []*nft.SendBatchItem{
+			{ClassId: classID, Id: "id-3", Receiver: recipient1.String()},
+			{ClassId: classID, Id: "id-1", Receiver: recipient2.String()},
+		},
+	}
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				issueMsg,
+				mintBatchMsg,
+				sendBatchMsg,
+				failingSendBatchMsg,
+			},
+			Amount: chain.NetworkConfig.AssetNFTConfig.MintFee.MulRaw(int64(len(mintBatchMsg.Items))),
+		}),
+	)
+
+	// issue new NFT class
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+
+	// mint the batch
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintBatchMsg)),
+		mintBatchMsg,
+	)
+	requireT.NoError(err)
+	requireT.Equal(chain.GasLimitByMsgs(mintBatchMsg), uint64(res.GasUsed))
+
+	nftMintedEvents, err := event.FindTypedEvents[*nft.EventMint](res.Events)
+	requireT.NoError(err)
+	requireT.Len(nftMintedEvents, len(mintBatchMsg.Items))
+
+	for _, item := range mintBatchMsg.Items {
+		nftRes, err := nftClient.NFT(ctx, &nft.QueryNFTRequest{
+			ClassId: classID,
+			Id:      item.ID,
+		})
+		requireT.NoError(err)
+		requireT.Equal(item.URI, nftRes.Nft.Uri)
+		requireT.Equal(item.URIHash, nftRes.Nft.UriHash)
+	}
+
+	// send the batch
+	res, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendBatchMsg)),
+		sendBatchMsg,
+	)
+	requireT.NoError(err)
+	requireT.Equal(chain.GasLimitByMsgs(sendBatchMsg), uint64(res.GasUsed))
+
+	nftSentEvents, err := event.FindTypedEvents[*nft.EventSend](res.Events)
+	requireT.NoError(err)
+	requireT.Len(nftSentEvents, len(sendBatchMsg.Items))
+
+	for _, item := range sendBatchMsg.Items {
+		ownerRes, err := nftClient.Owner(ctx, &nft.QueryOwnerRequest{
+			ClassId: classID,
+			Id:      item.Id,
+		})
+		requireT.NoError(err)
+		requireT.Equal(item.Receiver, ownerRes.Owner)
+	}
+
+	// send the batch containing the token which is not owned by the sender, nothing should be sent
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(failingSendBatchMsg)),
+		failingSendBatchMsg,
+	)
+	requireT.Error(err)
+
+	ownerRes, err := nftClient.Owner(ctx, &nft.QueryOwnerRequest{
+		ClassId: classID,
+		Id:      "id-3",
+	})
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), ownerRes.Owner)
+
+	// check that balance is 0 meaning mint fee was taken for each token
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+	resp, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: issuer.String(),
+		Denom:   chain.NetworkConfig.Denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(chain.NewCoin(sdk.ZeroInt()).String(), resp.Balance.String())
+}
+
 // TestAssetNFTMintFeeProposal tests proposal upgrading mint fee.
 func TestAssetNFTMintFeeProposal(t *testing.T) {
 	// This test can't be run together with other tests because it affects balances due to unexpected issue fee.
@@ -383,13 +383,14 @@ func TestBankSendGasEstimation(t *testing.T) {
 
 	clientCtx := chain.ClientContext.WithFromAddress(sender)
 	bankSendGas := chain.GasLimitByMsgs(&banktypes.MsgSend{})
-	_, estimatedGas, err := client.CalculateGas(
+	estimateRes, estimatedGas, err := client.EstimateGas(
 		ctx,
 		clientCtx,
 		chain.TxFactory().
 			WithGas(bankSendGas),
 		msg)
 	require.NoError(t, err)
+	assert.True(t, estimateRes.Deterministic)
 	assert.Equal(t, bankSendGas, estimatedGas)
 }
 
@@ -0,0 +1,83 @@
+//go:build integrationtests
+
+package modules
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
+	"github.com/CoreumFoundation/coreum/pkg/client"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// TestDeterministicGasUpdateGasTableProposal checks that the deterministic gas of the message types might be updated
+// by the governance.
+func TestDeterministicGasUpdateGasTableProposal(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	deterministicGasClient := deterministicgastypes.NewQueryClient(chain.ClientContext)
+
+	proposer := chain.GenAccount()
+	proposerBalance, err := chain.Governance.ComputeProposerBalance(ctx)
+	requireT.NoError(err)
+	// The proposal is submitted three times, the invalid one, the update and the revert of the update.
+	proposerBalance.Amount = proposerBalance.Amount.MulRaw(3)
+	requireT.NoError(chain.Faucet.FundAccounts(ctx, integrationtests.NewFundedAccount(proposer, proposerBalance)))
+
+	// the message type nobody else uses in the tests is updated, so the tests running in parallel are not affected
+	msgUnjailType := deterministicgas.MsgType(&slashingtypes.MsgUnjail{})
+	defaultGas, ok := chain.DeterministicGasConfig.GasByMsgType(msgUnjailType)
+	requireT.True(ok)
+
+	// the nondeterministic message types are rejected when the proposal is submitted
+	proposalMsg, err := chain.Governance.NewMsgSubmitProposal(ctx, proposer, deterministicgastypes.NewUpdateGasTableProposal(
+		"Invalid proposal", "-", []deterministicgastypes.MsgGas{
+			{MsgType: deterministicgas.MsgType(&govtypes.MsgSubmitProposal{}), Gas: 1000},
+		},
+	))
+	requireT.NoError(err)
+	_, err = chain.Governance.Propose(ctx, proposalMsg)
+	requireT.True(govtypes.ErrInvalidProposalContent.Is(err))
+
+	newGas := defaultGas + 1000
+	requireT.NoError(chain.Governance.ProposeAndVote(ctx, proposer, deterministicgastypes.NewUpdateGasTableProposal(
+		"Update gas of MsgUnjail", "-", []deterministicgastypes.MsgGas{
+			{MsgType: msgUnjailType, Gas: newGas},
+		},
+	), govtypes.OptionYes))
+
+	gasTableRes, err := deterministicGasClient.DeterministicGasTable(ctx, &deterministicgastypes.QueryDeterministicGasTableRequest{})
+	requireT.NoError(err)
+	requireT.Contains(gasTableRes.GasTable, deterministicgastypes.MsgGas{MsgType: msgUnjailType, Gas: newGas})
+
+	// the gas is estimated using the updated table
+	estimateRes, estimatedGas, err := client.EstimateGas(
+		ctx,
+		chain.ClientContext.WithFromAddress(proposer),
+		chain.TxFactory().WithGasAdjustment(1),
+		&slashingtypes.MsgUnjail{ValidatorAddr: sdk.ValAddress(proposer).String()},
+	)
+	requireT.NoError(err)
+	requireT.True(estimateRes.Deterministic)
+	requireT.EqualValues(chain.DeterministicGasConfig.FixedGas+newGas, estimatedGas)
+
+	// revert the gas to the default one, since the static config is used by other tests
+	requireT.NoError(chain.Governance.ProposeAndVote(ctx, proposer, deterministicgastypes.NewUpdateGasTableProposal(
+		"Revert gas of MsgUnjail", "-", []deterministicgastypes.MsgGas{
+			{MsgType: msgUnjailType, Gas: defaultGas},
+		},
+	), govtypes.OptionYes))
+
+	gasTableRes, err = deterministicGasClient.DeterministicGasTable(ctx, &deterministicgastypes.QueryDeterministicGasTableRequest{})
+	requireT.NoError(err)
+	requireT.Contains(gasTableRes.GasTable, deterministicgastypes.MsgGas{MsgType: msgUnjailType, Gas: defaultGas})
+}
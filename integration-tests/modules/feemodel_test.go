@@ -6,6 +6,9 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +18,9 @@ import (
 
 	"github.com/CoreumFoundation/coreum-tools/pkg/logger"
 	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
+	"github.com/CoreumFoundation/coreum/pkg/client"
+	"github.com/CoreumFoundation/coreum/testutil/event"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
@@ -39,6 +45,149 @@ func TestFeeModelQueryingMinGasPrice(t *testing.T) {
 	assert.Equal(t, chain.NetworkConfig.Denom, res.MinGasPrice.Denom)
 }
 
+// TestFeeModelQueryingRecentGasPrices checks that the gas price records of the recent blocks are queryable.
+func TestFeeModelQueryingRecentGasPrices(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	feemodelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+	paramsRes, err := feemodelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+
+	res, err := feemodelClient.RecentGasPrices(ctx, &feemodeltypes.QueryRecentGasPricesRequest{
+		Pagination: &query.PageRequest{
+			Limit:      5,
+			Reverse:    true,
+			CountTotal: true,
+		},
+	})
+	requireT.NoError(err)
+	requireT.NotEmpty(res.Records)
+	requireT.LessOrEqual(res.Pagination.Total, uint64(paramsRes.Params.HistoryLength))
+
+	model := feemodeltypes.NewModel(chain.NetworkConfig.Fee.FeeModel.Params())
+	for i, record := range res.Records {
+		if i > 0 {
+			// records are returned in the reverse order
+			assert.Less(t, record.Height, res.Records[i-1].Height)
+		}
+		assert.Equal(t, chain.NetworkConfig.Denom, record.MinGasPrice.Denom)
+		assert.True(t, record.MinGasPrice.Amount.GTE(model.CalculateGasPriceWithMaxDiscount()))
+		assert.True(t, record.MinGasPrice.Amount.LTE(model.CalculateMaxGasPrice()))
+	}
+}
+
+// TestFeeModelQueryingForecastMinGasPrice checks that the forecast of minimum gas price is queryable.
+func TestFeeModelQueryingForecastMinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	feemodelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+
+	model := feemodeltypes.NewModel(chain.NetworkConfig.Fee.FeeModel.Params())
+	res, err := feemodelClient.ForecastMinGasPrice(ctx, &feemodeltypes.QueryForecastMinGasPriceRequest{
+		AfterBlocks: 1000,
+	})
+	requireT.NoError(err)
+	assert.Equal(t, chain.NetworkConfig.Denom, res.MinGasPrice.Denom)
+	// full blocks escalate the price
+	assert.True(t, res.MinGasPrice.Amount.GT(model.CalculateGasPriceWithMaxDiscount()))
+	assert.True(t, res.MinGasPrice.Amount.LTE(model.CalculateMaxGasPrice()))
+
+	_, err = feemodelClient.ForecastMinGasPrice(ctx, &feemodeltypes.QueryForecastMinGasPriceRequest{
+		AfterBlocks: feemodeltypes.MaxForecastBlocks + 1,
+	})
+	requireT.Error(err)
+}
+
+// TestFeeModelQueryingSimulateParams checks that the gas prices of the proposed model params are queryable.
+func TestFeeModelQueryingSimulateParams(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	feemodelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+
+	modelParams := chain.NetworkConfig.Fee.FeeModel.Params()
+	modelParams.InitialGasPrice = modelParams.InitialGasPrice.MulInt64(2)
+	res, err := feemodelClient.SimulateParams(ctx, &feemodeltypes.QuerySimulateParamsRequest{
+		Model: modelParams,
+	})
+	requireT.NoError(err)
+	requireT.NotEmpty(res.Records)
+
+	model := feemodeltypes.NewModel(modelParams)
+	for _, record := range res.Records {
+		assert.Equal(t, chain.NetworkConfig.Denom, record.MinGasPrice.Denom)
+		assert.True(t, record.MinGasPrice.Amount.GTE(model.CalculateGasPriceWithMaxDiscount()))
+		assert.True(t, record.MinGasPrice.Amount.LTE(model.CalculateMaxGasPrice()))
+	}
+
+	modelParams.MaxDiscount = sdk.OneDec()
+	_, err = feemodelClient.SimulateParams(ctx, &feemodeltypes.QuerySimulateParamsRequest{
+		Model: modelParams,
+	})
+	requireT.Error(err)
+}
+
+// TestFeeModelUpdateParamsProposal checks that the feemodel params might be updated by the feemodel proposal.
+func TestFeeModelUpdateParamsProposal(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	feeModelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+
+	proposer := chain.GenAccount()
+	proposerBalance, err := chain.Governance.ComputeProposerBalance(ctx)
+	requireT.NoError(err)
+	// The proposal is submitted three times, the invalid one, the update and the revert of the update.
+	proposerBalance.Amount = proposerBalance.Amount.MulRaw(3)
+	requireT.NoError(chain.Faucet.FundAccounts(ctx, integrationtests.NewFundedAccount(proposer, proposerBalance)))
+
+	paramsRes, err := feeModelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	initialParams := paramsRes.Params
+
+	// the native denom can't be the accepted fee denom, it is rejected when the proposal is submitted
+	invalidParams := initialParams
+	invalidParams.AcceptedFeeDenoms = []feemodeltypes.FeeDenom{
+		{Denom: chain.NetworkConfig.Denom, ExchangeRate: sdk.OneDec()},
+	}
+	proposalMsg, err := chain.Governance.NewMsgSubmitProposal(ctx, proposer, feemodeltypes.NewUpdateParamsProposal(
+		"Invalid proposal", "-", invalidParams,
+	))
+	requireT.NoError(err)
+	_, err = chain.Governance.Propose(ctx, proposalMsg)
+	requireT.True(govtypes.ErrInvalidProposalContent.Is(err))
+
+	// the history length is changed since it doesn't affect the gas price used by the tests running in parallel
+	newParams := initialParams
+	newParams.HistoryLength = initialParams.HistoryLength + 1
+	requireT.NoError(chain.Governance.ProposeAndVote(ctx, proposer, feemodeltypes.NewUpdateParamsProposal(
+		"Update history length", "-", newParams,
+	), govtypes.OptionYes))
+
+	paramsRes, err = feeModelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	requireT.Equal(newParams.String(), paramsRes.Params.String())
+
+	// revert the params
+	requireT.NoError(chain.Governance.ProposeAndVote(ctx, proposer, feemodeltypes.NewUpdateParamsProposal(
+		"Revert history length", "-", initialParams,
+	), govtypes.OptionYes))
+
+	paramsRes, err = feeModelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	requireT.Equal(initialParams.String(), paramsRes.Params.String())
+}
+
 // TestFeeModelProposalParamChange checks that feemodel param change proposal works correctly.
 func TestFeeModelProposalParamChange(t *testing.T) {
 	t.Parallel()
@@ -116,8 +265,265 @@ func TestFeeModelProposalParamChange(t *testing.T) {
 	requireT.Equal(feeModelParams.String(), feeModelParamsRes.Params.Model.String())
 }
 
-func marshalParamChangeProposal(requireT *require.Assertions, modelParams feemodeltypes.ModelParams) string {
-	str, err := tmjson.Marshal(modelParams)
+// TestFeeModelAcceptedFeeDenoms checks that fees might be paid in the accepted fee denoms.
+func TestFeeModelAcceptedFeeDenoms(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	feeModelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "FEE",
+		Subunit:       "ufee",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1_000_000_000),
+	}
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)),
+	}
+
+	requireT.NoError(chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{issueMsg},
+		Amount:   chain.NetworkConfig.AssetFTConfig.IssueFee,
+	}))
+
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+
+	// the fee paid in the denom is rejected before it is accepted
+	exchangeRate := sdk.NewDec(2)
+	gasPrice := sdk.NewDecCoinFromDec(denom, chain.NetworkConfig.Fee.FeeModel.Params().InitialGasPrice.Mul(exchangeRate))
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)).WithGasPrices(gasPrice.String()),
+		sendMsg,
+	)
+	requireT.True(sdkerrors.ErrInvalidCoins.Is(err))
+
+	// accept the denom
+	proposer := chain.GenAccount()
+	proposerBalance, err := chain.Governance.ComputeProposerBalance(ctx)
+	requireT.NoError(err)
+	requireT.NoError(chain.Faucet.FundAccounts(ctx, integrationtests.NewFundedAccount(proposer, proposerBalance)))
+
+	feeModelParamsRes, err := feeModelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	acceptedFeeDenoms := append(feeModelParamsRes.Params.AcceptedFeeDenoms, feemodeltypes.FeeDenom{
+		Denom:        denom,
+		ExchangeRate: exchangeRate,
+	})
+	proposalMsg, err := chain.Governance.NewMsgSubmitProposal(ctx, proposer, paramproposal.NewParameterChangeProposal("Accept fee denom", "-",
+		[]paramproposal.ParamChange{
+			paramproposal.NewParamChange(
+				feemodeltypes.ModuleName, string(feemodeltypes.KeyAcceptedFeeDenoms), marshalParamChangeProposal(requireT, acceptedFeeDenoms),
+			),
+		},
+	))
+	requireT.NoError(err)
+	proposalID, err := chain.Governance.Propose(ctx, proposalMsg)
+	requireT.NoError(err)
+	requireT.NoError(chain.Governance.VoteAll(ctx, govtypes.OptionYes, proposalID))
+	finalStatus, err := chain.Governance.WaitForVotingToFinalize(ctx, proposalID)
+	requireT.NoError(err)
+	requireT.Equal(govtypes.StatusPassed, finalStatus)
+
+	// check that the min gas price in the denom is returned
+	minGasPriceRes, err := feeModelClient.MinGasPrice(ctx, &feemodeltypes.QueryMinGasPriceRequest{})
+	requireT.NoError(err)
+	requireT.Equal(
+		minGasPriceRes.MinGasPrice.Amount.Mul(exchangeRate).String(),
+		minGasPriceRes.MinGasPrices.AmountOf(denom).String(),
+	)
+	requireT.Equal(minGasPriceRes.MinGasPrice.Amount.String(), minGasPriceRes.MinGasPrices.AmountOf(chain.NetworkConfig.Denom).String())
+
+	// pay the fee in the denom, the issuer has no native coins, so the tx succeeds only if the fee is taken in the denom
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)).WithGasPrices(gasPrice.String()),
+		sendMsg,
+	)
+	requireT.NoError(err)
+	requireT.Equal(chain.GasLimitByMsgs(sendMsg), uint64(res.GasUsed))
+
+	fee := gasPrice.Amount.MulInt64(int64(chain.GasLimitByMsgs(sendMsg))).Ceil().TruncateInt()
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: issuer.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(issueMsg.InitialAmount.Sub(sendMsg.Amount.AmountOf(denom)).Sub(fee).String(), balanceRes.Balance.Amount.String())
+
+	// fee lower than required is rejected
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)).WithGasPrices(sdk.NewDecCoinFromDec(denom, minGasPriceRes.MinGasPrice.Amount).String()),
+		sendMsg,
+	)
+	requireT.True(sdkerrors.ErrInsufficientFee.Is(err))
+}
+
+// TestFeeModelTip checks that the fee offered above the required one is reported as the tip.
+func TestFeeModelTip(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	feeModelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+
+	sender := chain.GenAccount()
+	recipient := chain.GenAccount()
+	requireT.NoError(chain.Faucet.FundAccounts(ctx, integrationtests.NewFundedAccount(sender, chain.NewCoin(sdk.NewInt(1_000_000)))))
+
+	minGasPriceRes, err := feeModelClient.MinGasPrice(ctx, &feemodeltypes.QueryMinGasPriceRequest{})
+	requireT.NoError(err)
+
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: sender.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(chain.NewCoin(sdk.NewInt(10))),
+	}
+	gas := chain.GasLimitByMsgs(sendMsg)
+	gasPrice := chain.NewDecCoin(minGasPriceRes.MinGasPrice.Amount.MulInt64(2))
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(sender),
+		chain.TxFactory().WithGas(gas).WithGasPrices(gasPrice.String()),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	feeEvents, err := event.FindTypedEvents[*feemodeltypes.EventFee](res.Events)
+	requireT.NoError(err)
+	requireT.Len(feeEvents, 1)
+	feeEvent := feeEvents[0]
+
+	fee := gasPrice.Amount.MulInt64(int64(gas)).Ceil().TruncateInt()
+	requireT.Equal(fee.String(), feeEvent.BaseFee.Add(feeEvent.Tip).Amount.String())
+	requireT.True(feeEvent.Tip.IsPositive())
+	requireT.True(feeEvent.BurntTip.Amount.LTE(feeEvent.Tip.Amount))
+	requireT.Positive(feeEvent.Priority)
+}
+
+// TestFeeModelProposalParamChangeAcceptedFeeDenomsAndTipBurnFraction checks that the params introduced in the version 2
+// of the fee model are changed by the param change proposal and the accepted fee denoms are validated against the
+// chain state.
+func TestFeeModelProposalParamChangeAcceptedFeeDenomsAndTipBurnFraction(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	feeModelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "FREEZE",
+		Subunit:       "ufreeze",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1_000_000_000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_freezing},
+	}
+	requireT.NoError(chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{issueMsg},
+		Amount:   chain.NetworkConfig.AssetFTConfig.IssueFee,
+	}))
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	freezableDenom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	proposer := chain.GenAccount()
+	proposerBalance, err := chain.Governance.ComputeProposerBalance(ctx)
+	requireT.NoError(err)
+	// For the test we need to submit the proposal twice.
+	proposerBalance = proposerBalance.Add(proposerBalance)
+	requireT.NoError(chain.Faucet.FundAccounts(ctx, integrationtests.NewFundedAccount(proposer, proposerBalance)))
+
+	// the native denom and the token with the restricted transfers can't be accepted
+	for _, denom := range []string{chain.NetworkConfig.Denom, freezableDenom} {
+		proposalMsg, err := chain.Governance.NewMsgSubmitProposal(ctx, proposer, paramproposal.NewParameterChangeProposal("Invalid proposal", "-",
+			[]paramproposal.ParamChange{
+				paramproposal.NewParamChange(
+					feemodeltypes.ModuleName, string(feemodeltypes.KeyAcceptedFeeDenoms), marshalParamChangeProposal(requireT, []feemodeltypes.FeeDenom{
+						{Denom: denom, ExchangeRate: sdk.OneDec()},
+					}),
+				),
+			},
+		))
+		requireT.NoError(err)
+		_, err = chain.Governance.Propose(ctx, proposalMsg)
+		requireT.True(govtypes.ErrInvalidProposalContent.Is(err))
+	}
+
+	// change the tip burn fraction
+	tipBurnFraction := sdk.MustNewDecFromStr("0.5")
+	requireT.NoError(chain.Governance.UpdateParams(ctx, "Propose changing TipBurnFraction in the feemodel module",
+		[]paramproposal.ParamChange{
+			paramproposal.NewParamChange(
+				feemodeltypes.ModuleName, string(feemodeltypes.KeyTipBurnFraction), marshalParamChangeProposal(requireT, tipBurnFraction),
+			),
+		}))
+
+	feeModelParamsRes, err := feeModelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	requireT.Equal(tipBurnFraction.String(), feeModelParamsRes.Params.TipBurnFraction.String())
+
+	// check that the half of the tip is burnt
+	sender := chain.GenAccount()
+	requireT.NoError(chain.Faucet.FundAccounts(ctx, integrationtests.NewFundedAccount(sender, chain.NewCoin(sdk.NewInt(1_000_000)))))
+	minGasPriceRes, err := feeModelClient.MinGasPrice(ctx, &feemodeltypes.QueryMinGasPriceRequest{})
+	requireT.NoError(err)
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: sender.String(),
+		ToAddress:   issuer.String(),
+		Amount:      sdk.NewCoins(chain.NewCoin(sdk.NewInt(10))),
+	}
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(sender),
+		chain.TxFactory().
+			WithGas(chain.GasLimitByMsgs(sendMsg)).
+			WithGasPrices(chain.NewDecCoin(minGasPriceRes.MinGasPrice.Amount.MulInt64(2)).String()),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	feeEvents, err := event.FindTypedEvents[*feemodeltypes.EventFee](res.Events)
+	requireT.NoError(err)
+	requireT.Len(feeEvents, 1)
+	requireT.True(feeEvents[0].Tip.IsPositive())
+	requireT.Equal(
+		tipBurnFraction.MulInt(feeEvents[0].Tip.Amount).TruncateInt().String(),
+		feeEvents[0].BurntTip.Amount.String(),
+	)
+}
+
+func marshalParamChangeProposal(requireT *require.Assertions, value interface{}) string {
+	str, err := tmjson.Marshal(value)
 	requireT.NoError(err)
 	return string(str)
 }
@@ -0,0 +1,20 @@
+#!/bin/bash
+
+# Builds the contract using llc and wasm-ld (or rust-lld, set WASM_LD=rust-lld to use it).
+set -e
+
+cd "$(dirname "$0")"
+WASM_LD=${WASM_LD:-wasm-ld}
+if [ "$WASM_LD" == "rust-lld" ]; then
+  WASM_LD="rust-lld -flavor wasm"
+fi
+
+TARGET_DIR=$(mktemp -d)
+trap 'rm -rf "$TARGET_DIR"' EXIT
+
+mkdir -p artifacts
+# the mvp cpu is used since the sign extension and other post-mvp operators are not supported by the wasm vm
+llc -O2 -mtriple=wasm32-unknown-unknown -mcpu=mvp -filetype=obj src/contract.ll -o "$TARGET_DIR/contract.o"
+$WASM_LD --no-entry --strip-all \
+  --export=interface_version_8 --export=allocate --export=deallocate --export=instantiate --export=sudo \
+  "$TARGET_DIR/contract.o" -o artifacts/ft_extension.wasm
@@ -0,0 +1,203 @@
+; The extension contract of the asset ft tokens used by the integration tests.
+;
+; The contract implements the raw CosmWasm 1.x contract interface (interface_version_8) without any dependencies,
+; so it can be built with llc and wasm-ld only, see build.sh.
+;
+; The contract accepts any instantiate message. On the extension_transfer sudo call it:
+;   * rejects the transfer of amount 7 with an error,
+;   * runs out of gas on the transfer of amount 13 by writing a large value to the contract storage,
+;   * accepts any other transfer.
+
+target datalayout = "e-m:e-p:32:32-i64:64-n32:64-S128"
+target triple = "wasm32-unknown-unknown"
+
+; the memory the regions are allocated from, every contract call gets a new instance with a fresh memory
+@arena = internal global [65536 x i8] zeroinitializer, align 8
+@arena_next = internal global i32 0
+
+@ok_response = private constant [62 x i8] c"{\22ok\22:{\22messages\22:[],\22attributes\22:[],\22events\22:[],\22data\22:null}}"
+@rejected_response = private constant [40 x i8] c"{\22error\22:\22transfer of 7 is not allowed\22}"
+@amount_pattern = private constant [10 x i8] c"\22amount\22:\22"
+@rejected_amount = private constant [2 x i8] c"7\22"
+@out_of_gas_amount = private constant [3 x i8] c"13\22"
+@storage_key = private constant [3 x i8] c"gas"
+
+; the size of the value written to the storage to exceed the extension gas limit
+@out_of_gas_value_size = private constant i32 20000
+
+declare void @db_write(i32, i32) #0
+declare void @llvm.trap() #1
+
+define void @interface_version_8() {
+  ret void
+}
+
+; allocate allocates the region with the requested capacity.
+define i32 @allocate(i32 %capacity) {
+  %region = call i32 @new_region(i32 %capacity)
+  ret i32 %region
+}
+
+; deallocate does nothing, the memory is released together with the instance.
+define void @deallocate(i32 %region) {
+  ret void
+}
+
+define i32 @instantiate(i32 %env, i32 %info, i32 %msg) {
+  %response = call i32 @ok()
+  ret i32 %response
+}
+
+define i32 @sudo(i32 %env, i32 %msg) {
+entry:
+  %msg_ptr = inttoptr i32 %msg to i32*
+  %data = load i32, i32* %msg_ptr
+  %length_ptr = getelementptr i32, i32* %msg_ptr, i32 2
+  %length = load i32, i32* %length_ptr
+  %amount_pattern = ptrtoint [10 x i8]* @amount_pattern to i32
+  %amount_pos = call i32 @find(i32 %data, i32 %length, i32 %amount_pattern, i32 10)
+  %not_found = icmp slt i32 %amount_pos, 0
+  br i1 %not_found, label %accept, label %check_rejected
+
+check_rejected:
+  %amount = add i32 %data, %amount_pos
+  %amount_length = sub i32 %length, %amount_pos
+  %rejected_amount = ptrtoint [2 x i8]* @rejected_amount to i32
+  %is_rejected = call i1 @has_prefix(i32 %amount, i32 %amount_length, i32 %rejected_amount, i32 2)
+  br i1 %is_rejected, label %reject, label %check_out_of_gas
+
+check_out_of_gas:
+  %out_of_gas_amount = ptrtoint [3 x i8]* @out_of_gas_amount to i32
+  %is_out_of_gas = call i1 @has_prefix(i32 %amount, i32 %amount_length, i32 %out_of_gas_amount, i32 3)
+  br i1 %is_out_of_gas, label %out_of_gas, label %accept
+
+out_of_gas:
+  %storage_key = ptrtoint [3 x i8]* @storage_key to i32
+  %key = call i32 @const_region(i32 %storage_key, i32 3)
+  %value_size = load i32, i32* @out_of_gas_value_size
+  %value = call i32 @new_region(i32 %value_size)
+  %value_ptr = inttoptr i32 %value to i32*
+  %value_length_ptr = getelementptr i32, i32* %value_ptr, i32 2
+  store i32 %value_size, i32* %value_length_ptr
+  call void @db_write(i32 %key, i32 %value)
+  br label %accept
+
+reject:
+  %rejected_response = ptrtoint [40 x i8]* @rejected_response to i32
+  %rejected = call i32 @const_region(i32 %rejected_response, i32 40)
+  ret i32 %rejected
+
+accept:
+  %response = call i32 @ok()
+  ret i32 %response
+}
+
+; ok returns the region with the successful response.
+define internal i32 @ok() {
+  %ok_response = ptrtoint [62 x i8]* @ok_response to i32
+  %region = call i32 @const_region(i32 %ok_response, i32 62)
+  ret i32 %region
+}
+
+; new_region allocates the region with the requested capacity and zero length from the arena.
+define internal i32 @new_region(i32 %capacity) {
+entry:
+  %next = load i32, i32* @arena_next
+  %size = add i32 %capacity, 19
+  %aligned_size = and i32 %size, -8
+  %new_next = add i32 %next, %aligned_size
+  %overflow = icmp ugt i32 %new_next, 65536
+  br i1 %overflow, label %trap, label %allocate
+
+trap:
+  call void @llvm.trap()
+  unreachable
+
+allocate:
+  store i32 %new_next, i32* @arena_next
+  %arena = ptrtoint [65536 x i8]* @arena to i32
+  %region = add i32 %arena, %next
+  %data = add i32 %region, 12
+  %region_ptr = inttoptr i32 %region to i32*
+  store i32 %data, i32* %region_ptr
+  %capacity_ptr = getelementptr i32, i32* %region_ptr, i32 1
+  store i32 %capacity, i32* %capacity_ptr
+  %length_ptr = getelementptr i32, i32* %region_ptr, i32 2
+  store i32 0, i32* %length_ptr
+  ret i32 %region
+}
+
+; const_region returns the region pointing to the constant data.
+define internal i32 @const_region(i32 %data, i32 %length) {
+  %region = call i32 @new_region(i32 0)
+  %region_ptr = inttoptr i32 %region to i32*
+  store i32 %data, i32* %region_ptr
+  %capacity_ptr = getelementptr i32, i32* %region_ptr, i32 1
+  store i32 %length, i32* %capacity_ptr
+  %length_ptr = getelementptr i32, i32* %region_ptr, i32 2
+  store i32 %length, i32* %length_ptr
+  ret i32 %region
+}
+
+; has_prefix checks if the data starts with the prefix.
+define internal i1 @has_prefix(i32 %data, i32 %length, i32 %prefix, i32 %prefix_length) {
+entry:
+  %too_short = icmp ult i32 %length, %prefix_length
+  br i1 %too_short, label %mismatch, label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %next_i, %next ]
+  %done = icmp eq i32 %i, %prefix_length
+  br i1 %done, label %match, label %compare
+
+compare:
+  %data_byte_addr = add i32 %data, %i
+  %data_byte_ptr = inttoptr i32 %data_byte_addr to i8*
+  %data_byte = load i8, i8* %data_byte_ptr
+  %prefix_byte_addr = add i32 %prefix, %i
+  %prefix_byte_ptr = inttoptr i32 %prefix_byte_addr to i8*
+  %prefix_byte = load i8, i8* %prefix_byte_ptr
+  %equal = icmp eq i8 %data_byte, %prefix_byte
+  br i1 %equal, label %next, label %mismatch
+
+next:
+  %next_i = add i32 %i, 1
+  br label %loop
+
+match:
+  ret i1 true
+
+mismatch:
+  ret i1 false
+}
+
+; find returns the position right after the first occurrence of the pattern in the data or -1 if it is not found.
+define internal i32 @find(i32 %data, i32 %length, i32 %pattern, i32 %pattern_length) {
+entry:
+  br label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %next_i, %next ]
+  %done = icmp uge i32 %i, %length
+  br i1 %done, label %not_found, label %compare
+
+compare:
+  %position = add i32 %data, %i
+  %remaining = sub i32 %length, %i
+  %found = call i1 @has_prefix(i32 %position, i32 %remaining, i32 %pattern, i32 %pattern_length)
+  br i1 %found, label %found_pattern, label %next
+
+next:
+  %next_i = add i32 %i, 1
+  br label %loop
+
+found_pattern:
+  %end = add i32 %i, %pattern_length
+  ret i32 %end
+
+not_found:
+  ret i32 -1
+}
+
+attributes #0 = { "wasm-import-module"="env" "wasm-import-name"="db_write" }
+attributes #1 = { cold noreturn nounwind }
@@ -1,7 +1,9 @@
 use coreum_wasm_sdk::assetft;
 use coreum_wasm_sdk::core::{CoreumMsg, CoreumQueries};
+use coreum_wasm_sdk::feemodel;
+use coreum_wasm_sdk::pagination::PageRequest;
 use cosmwasm_std::{entry_point, to_binary, Binary, Deps, QueryRequest, StdResult};
-use cosmwasm_std::{Coin, DepsMut, Env, MessageInfo, Response, StdError, SubMsg, Uint128};
+use cosmwasm_std::{Coin, DepsMut, Env, MessageInfo, Reply, Response, StdError, SubMsg, Uint128};
 use cw2::set_contract_version;
 use cw_storage_plus::Item;
 use schemars::JsonSchema;
@@ -12,6 +14,8 @@ use thiserror::Error;
 const CONTRACT_NAME: &str = "creates.io:ft";
 const CONTRACT_VERSION: &str = env!("CARGO_PKG_VERSION");
 
+const ISSUE_REPLY_ID: u64 = 1;
+
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 #[serde(rename_all = "snake_case")]
 pub struct InstantiateMsg {
@@ -86,17 +90,80 @@ pub fn execute(
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 #[serde(rename_all = "snake_case")]
 pub enum QueryMsg {
+    Params {},
     Token {},
-    FrozenBalance { account: String },
-    WhitelistedBalance { account: String },
+    Tokens {
+        issuer: String,
+        pagination: Option<PageRequest>,
+    },
+    FrozenBalance {
+        account: String,
+    },
+    FrozenBalances {
+        account: String,
+        pagination: Option<PageRequest>,
+    },
+    WhitelistedBalance {
+        account: String,
+    },
+    WhitelistedBalances {
+        account: String,
+        pagination: Option<PageRequest>,
+    },
+    Blacklisted {
+        account: String,
+    },
+    BlacklistedAccounts {
+        pagination: Option<PageRequest>,
+    },
+    MintAllowance {
+        account: String,
+    },
+    MintAllowances {
+        account: String,
+        pagination: Option<PageRequest>,
+    },
+    RateExempt {
+        account: String,
+    },
+    RateExemptAccounts {
+        pagination: Option<PageRequest>,
+    },
+    MinGasPrice {},
+    FeeModelParams {},
 }
 
 #[cfg_attr(not(feature = "library"), entry_point)]
 pub fn query(deps: Deps<CoreumQueries>, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
     match msg {
+        QueryMsg::Params {} => to_binary(&params(deps)?),
         QueryMsg::Token {} => to_binary(&token(deps)?),
+        QueryMsg::Tokens { issuer, pagination } => to_binary(&tokens(deps, issuer, pagination)?),
         QueryMsg::FrozenBalance { account } => to_binary(&frozen_balance(deps, account)?),
+        QueryMsg::FrozenBalances {
+            account,
+            pagination,
+        } => to_binary(&frozen_balances(deps, account, pagination)?),
         QueryMsg::WhitelistedBalance { account } => to_binary(&whitelisted_balance(deps, account)?),
+        QueryMsg::WhitelistedBalances {
+            account,
+            pagination,
+        } => to_binary(&whitelisted_balances(deps, account, pagination)?),
+        QueryMsg::Blacklisted { account } => to_binary(&blacklisted(deps, account)?),
+        QueryMsg::BlacklistedAccounts { pagination } => {
+            to_binary(&blacklisted_accounts(deps, pagination)?)
+        }
+        QueryMsg::MintAllowance { account } => to_binary(&mint_allowance(deps, account)?),
+        QueryMsg::MintAllowances {
+            account,
+            pagination,
+        } => to_binary(&mint_allowances(deps, account, pagination)?),
+        QueryMsg::RateExempt { account } => to_binary(&rate_exempt(deps, account)?),
+        QueryMsg::RateExemptAccounts { pagination } => {
+            to_binary(&rate_exempt_accounts(deps, pagination)?)
+        }
+        QueryMsg::MinGasPrice {} => to_binary(&min_gas_price(deps)?),
+        QueryMsg::FeeModelParams {} => to_binary(&fee_model_params(deps)?),
     }
 }
 
@@ -105,7 +172,7 @@ pub fn query(deps: Deps<CoreumQueries>, _env: Env, msg: QueryMsg) -> StdResult<B
 #[cfg_attr(not(feature = "library"), entry_point)]
 pub fn instantiate(
     deps: DepsMut,
-    env: Env,
+    _env: Env,
     info: MessageInfo,
     msg: InstantiateMsg,
 ) -> Result<Response<CoreumMsg>, ContractError> {
@@ -119,20 +186,41 @@ pub fn instantiate(
         features: msg.features,
         burn_rate: msg.burn_rate,
         send_commission_rate: msg.send_commission_rate,
+        extension_contract: None,
     });
 
-    let denom = format!("{}-{}", msg.subunit, env.contract.address).to_lowercase();
-
+    // the denom is taken from the issue response received in the reply
     let state = State {
         owner: info.sender.into(),
-        denom,
+        denom: String::new(),
     };
     STATE.save(deps.storage, &state)?;
 
     Ok(Response::new()
         .add_attribute("owner", state.owner)
-        .add_attribute("denom", state.denom)
-        .add_message(issue_msg))
+        .add_submessage(SubMsg::reply_on_success(issue_msg, ISSUE_REPLY_ID)))
+}
+
+// ********** Reply **********
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn reply(deps: DepsMut, _env: Env, msg: Reply) -> Result<Response<CoreumMsg>, ContractError> {
+    if msg.id != ISSUE_REPLY_ID {
+        return Err(ContractError::InvalidInput(format!("unknown reply id {}", msg.id)));
+    }
+    let data = msg
+        .result
+        .into_result()
+        .map_err(StdError::generic_err)?
+        .data
+        .ok_or_else(|| StdError::generic_err("issue response data is missing"))?;
+    let res = assetft::MsgIssueResponse::decode(data.as_slice())?;
+
+    let mut state = STATE.load(deps.storage)?;
+    state.denom = res.denom;
+    STATE.save(deps.storage, &state)?;
+
+    Ok(Response::new().add_attribute("denom", state.denom))
 }
 
 // ********** Transactions **********
@@ -315,6 +403,13 @@ fn mint_and_send(
 
 // ********** Queries **********
 
+fn params(deps: Deps<CoreumQueries>) -> StdResult<assetft::ParamsResponse> {
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetFT(assetft::Query::Params {}).into();
+    let res: assetft::ParamsResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
 fn token(deps: Deps<CoreumQueries>) -> StdResult<assetft::TokenResponse> {
     let state = STATE.load(deps.storage)?;
     let request: QueryRequest<CoreumQueries> =
@@ -323,6 +418,17 @@ fn token(deps: Deps<CoreumQueries>) -> StdResult<assetft::TokenResponse> {
     Ok(res)
 }
 
+fn tokens(
+    deps: Deps<CoreumQueries>,
+    issuer: String,
+    pagination: Option<PageRequest>,
+) -> StdResult<assetft::TokensResponse> {
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetFT(assetft::Query::Tokens { issuer, pagination }).into();
+    let res: assetft::TokensResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
 fn frozen_balance(
     deps: Deps<CoreumQueries>,
     account: String,
@@ -352,3 +458,139 @@ fn whitelisted_balance(
     let res: assetft::WhitelistedBalanceResponse = deps.querier.query(&request)?;
     Ok(res)
 }
+
+fn frozen_balances(
+    deps: Deps<CoreumQueries>,
+    account: String,
+    pagination: Option<PageRequest>,
+) -> StdResult<assetft::FrozenBalancesResponse> {
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetFT(assetft::Query::FrozenBalances {
+            account,
+            pagination,
+        })
+        .into();
+    let res: assetft::FrozenBalancesResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
+fn whitelisted_balances(
+    deps: Deps<CoreumQueries>,
+    account: String,
+    pagination: Option<PageRequest>,
+) -> StdResult<assetft::WhitelistedBalancesResponse> {
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetFT(assetft::Query::WhitelistedBalances {
+            account,
+            pagination,
+        })
+        .into();
+    let res: assetft::WhitelistedBalancesResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
+fn blacklisted(
+    deps: Deps<CoreumQueries>,
+    account: String,
+) -> StdResult<assetft::BlacklistedResponse> {
+    let state = STATE.load(deps.storage)?;
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetFT(assetft::Query::Blacklisted {
+            account,
+            denom: state.denom,
+        })
+        .into();
+    let res: assetft::BlacklistedResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
+fn blacklisted_accounts(
+    deps: Deps<CoreumQueries>,
+    pagination: Option<PageRequest>,
+) -> StdResult<assetft::BlacklistedAccountsResponse> {
+    let state = STATE.load(deps.storage)?;
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetFT(assetft::Query::BlacklistedAccounts {
+            denom: state.denom,
+            pagination,
+        })
+        .into();
+    let res: assetft::BlacklistedAccountsResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
+fn mint_allowance(
+    deps: Deps<CoreumQueries>,
+    account: String,
+) -> StdResult<assetft::MintAllowanceResponse> {
+    let state = STATE.load(deps.storage)?;
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetFT(assetft::Query::MintAllowance {
+            account,
+            denom: state.denom,
+        })
+        .into();
+    let res: assetft::MintAllowanceResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
+fn mint_allowances(
+    deps: Deps<CoreumQueries>,
+    account: String,
+    pagination: Option<PageRequest>,
+) -> StdResult<assetft::MintAllowancesResponse> {
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetFT(assetft::Query::MintAllowances {
+            account,
+            pagination,
+        })
+        .into();
+    let res: assetft::MintAllowancesResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
+fn rate_exempt(
+    deps: Deps<CoreumQueries>,
+    account: String,
+) -> StdResult<assetft::RateExemptResponse> {
+    let state = STATE.load(deps.storage)?;
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetFT(assetft::Query::RateExempt {
+            account,
+            denom: state.denom,
+        })
+        .into();
+    let res: assetft::RateExemptResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
+fn rate_exempt_accounts(
+    deps: Deps<CoreumQueries>,
+    pagination: Option<PageRequest>,
+) -> StdResult<assetft::RateExemptAccountsResponse> {
+    let state = STATE.load(deps.storage)?;
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetFT(assetft::Query::RateExemptAccounts {
+            denom: state.denom,
+            pagination,
+        })
+        .into();
+    let res: assetft::RateExemptAccountsResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
+// ********** FeeModel **********
+
+fn min_gas_price(deps: Deps<CoreumQueries>) -> StdResult<feemodel::MinGasPriceResponse> {
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::FeeModel(feemodel::Query::MinGasPrice {}).into();
+    let res: feemodel::MinGasPriceResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
+fn fee_model_params(deps: Deps<CoreumQueries>) -> StdResult<feemodel::ParamsResponse> {
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::FeeModel(feemodel::Query::Params {}).into();
+    let res: feemodel::ParamsResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
@@ -1,9 +1,10 @@
 use coreum_wasm_sdk::assetnft;
 use coreum_wasm_sdk::core::{CoreumMsg, CoreumQueries};
 use coreum_wasm_sdk::nft;
+use coreum_wasm_sdk::pagination::PageRequest;
 use cosmwasm_std::{
-    entry_point, to_binary, Binary, Deps, DepsMut, Env, MessageInfo, QueryRequest, Response,
-    StdError, StdResult,
+    entry_point, to_binary, Binary, Deps, DepsMut, Env, MessageInfo, QueryRequest, Reply,
+    Response, StdError, StdResult, SubMsg,
 };
 use cw2::set_contract_version;
 use cw_storage_plus::Item;
@@ -15,6 +16,8 @@ use thiserror::Error;
 const CONTRACT_NAME: &str = "creates.io:ft";
 const CONTRACT_VERSION: &str = env!("CARGO_PKG_VERSION");
 
+const ISSUE_CLASS_REPLY_ID: u64 = 1;
+
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 #[serde(rename_all = "snake_case")]
 pub struct InstantiateMsg {
@@ -111,25 +114,56 @@ pub fn execute(
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 #[serde(rename_all = "snake_case")]
 pub enum QueryMsg {
+    Params {},
     Class {},
-    Frozen { id: String },
-    Whitelisted { id: String, account: String },
-    Balance { owner: String },
-    Owner { id: String },
+    Frozen {
+        id: String,
+    },
+    Whitelisted {
+        id: String,
+        account: String,
+    },
+    WhitelistedAccountsForNft {
+        id: String,
+        pagination: Option<PageRequest>,
+    },
+    Balance {
+        owner: String,
+    },
+    Owner {
+        id: String,
+    },
     Supply {},
-    Nft { id: String }, // we use Nft not NFT since NFT is decoded as n_f_t
+    Nft {
+        id: String,
+    }, // we use Nft not NFT since NFT is decoded as n_f_t
+    Nfts {
+        owner: Option<String>,
+        pagination: Option<PageRequest>,
+    },
+    ClassNft {},
+    ClassesNft {
+        pagination: Option<PageRequest>,
+    },
 }
 
 #[cfg_attr(not(feature = "library"), entry_point)]
 pub fn query(deps: Deps<CoreumQueries>, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
     match msg {
+        QueryMsg::Params {} => to_binary(&params(deps)?),
         QueryMsg::Class {} => to_binary(&class(deps)?),
         QueryMsg::Frozen { id } => to_binary(&frozen(deps, id)?),
         QueryMsg::Whitelisted { id, account } => to_binary(&whitelisted(deps, id, account)?),
+        QueryMsg::WhitelistedAccountsForNft { id, pagination } => {
+            to_binary(&whitelisted_accounts_for_nft(deps, id, pagination)?)
+        }
         QueryMsg::Balance { owner } => to_binary(&balance(deps, owner)?),
         QueryMsg::Owner { id } => to_binary(&owner(deps, id)?),
         QueryMsg::Supply {} => to_binary(&supply(deps)?),
         QueryMsg::Nft { id } => to_binary(&nft(deps, id)?),
+        QueryMsg::Nfts { owner, pagination } => to_binary(&nfts(deps, owner, pagination)?),
+        QueryMsg::ClassNft {} => to_binary(&class_nft(deps)?),
+        QueryMsg::ClassesNft { pagination } => to_binary(&classes_nft(deps, pagination)?),
     }
 }
 
@@ -138,7 +172,7 @@ pub fn query(deps: Deps<CoreumQueries>, _env: Env, msg: QueryMsg) -> StdResult<B
 #[cfg_attr(not(feature = "library"), entry_point)]
 pub fn instantiate(
     deps: DepsMut,
-    env: Env,
+    _env: Env,
     info: MessageInfo,
     msg: InstantiateMsg,
 ) -> Result<Response<CoreumMsg>, ContractError> {
@@ -154,18 +188,38 @@ pub fn instantiate(
         royalty_rate: msg.royalty_rate,
     });
 
-    let class_id = format!("{}-{}", msg.symbol, env.contract.address).to_lowercase();
-
+    // the class id is taken from the issue class response received in the reply
     let state = State {
         owner: info.sender.into(),
-        class_id,
+        class_id: String::new(),
     };
     STATE.save(deps.storage, &state)?;
 
     Ok(Response::new()
         .add_attribute("owner", state.owner)
-        .add_attribute("class_id", state.class_id)
-        .add_message(issue_msg))
+        .add_submessage(SubMsg::reply_on_success(issue_msg, ISSUE_CLASS_REPLY_ID)))
+}
+
+// ********** Reply **********
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn reply(deps: DepsMut, _env: Env, msg: Reply) -> Result<Response<CoreumMsg>, ContractError> {
+    if msg.id != ISSUE_CLASS_REPLY_ID {
+        return Err(ContractError::InvalidInput(format!("unknown reply id {}", msg.id)));
+    }
+    let data = msg
+        .result
+        .into_result()
+        .map_err(StdError::generic_err)?
+        .data
+        .ok_or_else(|| StdError::generic_err("issue class response data is missing"))?;
+    let res = assetnft::MsgIssueClassResponse::decode(data.as_slice())?;
+
+    let mut state = STATE.load(deps.storage)?;
+    state.class_id = res.id;
+    STATE.save(deps.storage, &state)?;
+
+    Ok(Response::new().add_attribute("class_id", state.class_id))
 }
 
 // ********** Transactions **********
@@ -340,6 +394,13 @@ fn send(
 
 // ********** AssetNFT **********
 
+fn params(deps: Deps<CoreumQueries>) -> StdResult<assetnft::ParamsResponse> {
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetNFT(assetnft::Query::Params {}).into();
+    let res: assetnft::ParamsResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
 fn class(deps: Deps<CoreumQueries>) -> StdResult<assetnft::ClassResponse> {
     let state = STATE.load(deps.storage)?;
     let request: QueryRequest<CoreumQueries> =
@@ -376,6 +437,23 @@ fn whitelisted(
     Ok(res)
 }
 
+fn whitelisted_accounts_for_nft(
+    deps: Deps<CoreumQueries>,
+    id: String,
+    pagination: Option<PageRequest>,
+) -> StdResult<assetnft::WhitelistedAccountsForNFTResponse> {
+    let state = STATE.load(deps.storage)?;
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::AssetNFT(assetnft::Query::WhitelistedAccountsForNFT {
+            id,
+            class_id: state.class_id,
+            pagination,
+        })
+        .into();
+    let res: assetnft::WhitelistedAccountsForNFTResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
 // ********** NFT **********
 
 fn balance(deps: Deps<CoreumQueries>, owner: String) -> StdResult<nft::BalanceResponse> {
@@ -420,3 +498,39 @@ fn nft(deps: Deps<CoreumQueries>, id: String) -> StdResult<nft::NFTResponse> {
     let res: nft::NFTResponse = deps.querier.query(&request)?;
     Ok(res)
 }
+
+fn nfts(
+    deps: Deps<CoreumQueries>,
+    owner: Option<String>,
+    pagination: Option<PageRequest>,
+) -> StdResult<nft::NFTsResponse> {
+    let state = STATE.load(deps.storage)?;
+    let request: QueryRequest<CoreumQueries> = CoreumQueries::NFT(nft::Query::NFTs {
+        class_id: Some(state.class_id),
+        owner,
+        pagination,
+    })
+    .into();
+    let res: nft::NFTsResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
+fn class_nft(deps: Deps<CoreumQueries>) -> StdResult<nft::ClassResponse> {
+    let state = STATE.load(deps.storage)?;
+    let request: QueryRequest<CoreumQueries> = CoreumQueries::NFT(nft::Query::Class {
+        class_id: state.class_id,
+    })
+    .into();
+    let res: nft::ClassResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
+
+fn classes_nft(
+    deps: Deps<CoreumQueries>,
+    pagination: Option<PageRequest>,
+) -> StdResult<nft::ClassesResponse> {
+    let request: QueryRequest<CoreumQueries> =
+        CoreumQueries::NFT(nft::Query::Classes { pagination }).into();
+    let res: nft::ClassesResponse = deps.querier.query(&request)?;
+    Ok(res)
+}
@@ -1,4 +1,6 @@
-use cosmwasm_std::{Coin, Uint128};
+use crate::pagination::{PageRequest, PageResponse};
+use crate::proto::decode_string_field;
+use cosmwasm_std::{Coin, StdResult, Uint128};
 use schemars::JsonSchema;
 use serde::{Deserialize, Serialize};
 
@@ -14,6 +16,7 @@ pub struct Token {
     pub features: Option<Vec<u32>>,
     pub burn_rate: String,
     pub send_commission_rate: String,
+    pub extension_contract: Option<String>,
 }
 
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
@@ -22,18 +25,116 @@ pub struct TokenResponse {
     pub token: Token,
 }
 
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct TokensResponse {
+    pub pagination: Option<PageResponse>,
+    pub tokens: Vec<Token>,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct Params {
+    pub issue_fee: Coin,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct ParamsResponse {
+    pub params: Params,
+}
+
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 #[serde(rename_all = "snake_case")]
 pub struct FrozenBalanceResponse {
     pub balance: Coin,
 }
 
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct FrozenBalancesResponse {
+    pub pagination: Option<PageResponse>,
+    pub balances: Vec<Coin>,
+}
+
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 #[serde(rename_all = "snake_case")]
 pub struct WhitelistedBalanceResponse {
     pub balance: Coin,
 }
 
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct WhitelistedBalancesResponse {
+    pub pagination: Option<PageResponse>,
+    pub balances: Vec<Coin>,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct BlacklistedResponse {
+    #[serde(default)]
+    pub blacklisted: bool,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct BlacklistedAccountsResponse {
+    pub pagination: Option<PageResponse>,
+    #[serde(default)]
+    pub accounts: Vec<String>,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct MintAllowanceResponse {
+    pub allowance: Coin,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct MintAllowancesResponse {
+    pub pagination: Option<PageResponse>,
+    pub allowances: Vec<Coin>,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct RateExemptResponse {
+    #[serde(default)]
+    pub exempt: bool,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct RateExemptAccountsResponse {
+    pub pagination: Option<PageResponse>,
+    #[serde(default)]
+    pub accounts: Vec<String>,
+}
+
+// MsgIssueResponse is the response of the Issue message, returned as the data of the submessage reply.
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct MsgIssueResponse {
+    pub denom: String,
+}
+
+impl MsgIssueResponse {
+    pub fn decode(data: &[u8]) -> StdResult<Self> {
+        Ok(Self {
+            denom: decode_string_field(data, 1)?,
+        })
+    }
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct CommissionRecipient {
+    pub address: String,
+    pub weight: u32,
+}
+
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 pub enum Msg {
     Issue {
@@ -45,6 +146,7 @@ pub enum Msg {
         features: Option<Vec<u32>>,
         burn_rate: Option<String>,
         send_commission_rate: Option<String>,
+        extension_contract: Option<String>,
     },
     Mint {
         coin: Coin,
@@ -70,11 +172,107 @@ pub enum Msg {
         account: String,
         coin: Coin,
     },
+    TimedFreeze {
+        account: String,
+        coin: Coin,
+        // unlock_time is the RFC 3339 formatted time
+        unlock_time: String,
+    },
+    TransferAdmin {
+        account: String,
+        denom: String,
+    },
+    ClearAdmin {
+        denom: String,
+    },
+    Clawback {
+        account: String,
+        coin: Coin,
+    },
+    UpdateMetadata {
+        denom: String,
+        description: Option<String>,
+        uri: Option<String>,
+        uri_hash: Option<String>,
+    },
+    AddToBlacklist {
+        account: String,
+        denom: String,
+    },
+    RemoveFromBlacklist {
+        account: String,
+        denom: String,
+    },
+    GrantMintAllowance {
+        minter: String,
+        coin: Coin,
+    },
+    RevokeMintAllowance {
+        minter: String,
+        denom: String,
+    },
+    AddRateExemption {
+        account: String,
+        denom: String,
+    },
+    RemoveRateExemption {
+        account: String,
+        denom: String,
+    },
+    UpdateCommissionRecipients {
+        denom: String,
+        recipients: Vec<CommissionRecipient>,
+    },
 }
 
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 pub enum Query {
-    Token { denom: String },
-    FrozenBalance { account: String, denom: String },
-    WhitelistedBalance { account: String, denom: String },
+    Params {},
+    Token {
+        denom: String,
+    },
+    Tokens {
+        issuer: String,
+        pagination: Option<PageRequest>,
+    },
+    FrozenBalance {
+        account: String,
+        denom: String,
+    },
+    FrozenBalances {
+        account: String,
+        pagination: Option<PageRequest>,
+    },
+    WhitelistedBalance {
+        account: String,
+        denom: String,
+    },
+    WhitelistedBalances {
+        account: String,
+        pagination: Option<PageRequest>,
+    },
+    Blacklisted {
+        account: String,
+        denom: String,
+    },
+    BlacklistedAccounts {
+        denom: String,
+        pagination: Option<PageRequest>,
+    },
+    MintAllowance {
+        account: String,
+        denom: String,
+    },
+    MintAllowances {
+        account: String,
+        pagination: Option<PageRequest>,
+    },
+    RateExempt {
+        account: String,
+        denom: String,
+    },
+    RateExemptAccounts {
+        denom: String,
+        pagination: Option<PageRequest>,
+    },
 }
@@ -1,4 +1,6 @@
-use cosmwasm_std::Binary;
+use crate::pagination::{PageRequest, PageResponse};
+use crate::proto::decode_string_field;
+use cosmwasm_std::{Binary, Coin, StdResult};
 use schemars::JsonSchema;
 use serde::{Deserialize, Serialize};
 
@@ -23,6 +25,18 @@ pub struct ClassResponse {
     pub class: Class,
 }
 
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct Params {
+    pub mint_fee: Coin,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct ParamsResponse {
+    pub params: Params,
+}
+
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 #[serde(rename_all = "snake_case")]
 pub struct FrozenResponse {
@@ -35,6 +49,37 @@ pub struct WhitelistedResponse {
     pub whitelisted: bool,
 }
 
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct WhitelistedAccountsForNFTResponse {
+    pub pagination: Option<PageResponse>,
+    #[serde(default)]
+    pub accounts: Vec<String>,
+}
+
+// MsgIssueClassResponse is the response of the IssueClass message, returned as the data of the submessage reply.
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct MsgIssueClassResponse {
+    pub id: String,
+}
+
+impl MsgIssueClassResponse {
+    pub fn decode(data: &[u8]) -> StdResult<Self> {
+        Ok(Self {
+            id: decode_string_field(data, 1)?,
+        })
+    }
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct MintBatchItem {
+    pub id: String,
+    pub uri: Option<String>,
+    pub uri_hash: Option<String>,
+}
+
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 pub enum Msg {
     IssueClass {
@@ -76,10 +121,15 @@ pub enum Msg {
         id: String,
         account: String,
     },
+    MintBatch {
+        class_id: String,
+        items: Vec<MintBatchItem>,
+    },
 }
 
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 pub enum Query {
+    Params {},
     Class {
         id: String,
     },
@@ -92,4 +142,9 @@ pub enum Query {
         class_id: String,
         account: String,
     },
+    WhitelistedAccountsForNFT {
+        id: String,
+        class_id: String,
+        pagination: Option<PageRequest>,
+    },
 }
@@ -1,4 +1,4 @@
-use crate::{assetft, assetnft, nft};
+use crate::{assetft, assetnft, feemodel, nft};
 use cosmwasm_std::{CosmosMsg, CustomMsg, CustomQuery};
 use schemars::JsonSchema;
 use serde::{Deserialize, Serialize};
@@ -23,6 +23,7 @@ pub enum CoreumQueries {
     AssetFT(assetft::Query),
     AssetNFT(assetnft::Query),
     NFT(nft::Query),
+    FeeModel(feemodel::Query),
 }
 
 impl CustomQuery for CoreumQueries {}
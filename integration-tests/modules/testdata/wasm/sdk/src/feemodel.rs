@@ -0,0 +1,50 @@
+use schemars::JsonSchema;
+use serde::{Deserialize, Serialize};
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct DecCoin {
+    pub denom: String,
+    pub amount: String,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct MinGasPriceResponse {
+    pub min_gas_price: DecCoin,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct ModelParams {
+    pub initial_gas_price: String,
+    pub max_gas_price_multiplier: String,
+    pub max_discount: String,
+    pub escalation_start_fraction: String,
+    #[serde(default)]
+    pub max_block_gas: i64,
+    #[serde(default)]
+    pub short_ema_block_length: u32,
+    #[serde(default)]
+    pub long_ema_block_length: u32,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct Params {
+    pub model: ModelParams,
+    #[serde(default)]
+    pub history_length: u32,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct ParamsResponse {
+    pub params: Params,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub enum Query {
+    MinGasPrice {},
+    Params {},
+}
@@ -1,4 +1,7 @@
 pub mod assetft;
 pub mod assetnft;
 pub mod core;
+pub mod feemodel;
 pub mod nft;
+pub mod pagination;
+mod proto;
@@ -1,3 +1,4 @@
+use crate::pagination::{PageRequest, PageResponse};
 use cosmwasm_std::Binary;
 use schemars::JsonSchema;
 use serde::{Deserialize, Serialize};
@@ -12,6 +13,18 @@ pub struct NFT {
     pub data: Option<Binary>,
 }
 
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct Class {
+    pub id: String,
+    pub name: Option<String>,
+    pub symbol: Option<String>,
+    pub description: Option<String>,
+    pub uri: Option<String>,
+    pub uri_hash: Option<String>,
+    pub data: Option<Binary>,
+}
+
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 #[serde(rename_all = "snake_case")]
 pub struct BalanceResponse {
@@ -36,6 +49,34 @@ pub struct NFTResponse {
     pub nft: NFT,
 }
 
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct NFTsResponse {
+    pub nfts: Vec<NFT>,
+    pub pagination: Option<PageResponse>,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct ClassResponse {
+    pub class: Class,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct ClassesResponse {
+    pub classes: Vec<Class>,
+    pub pagination: Option<PageResponse>,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct SendBatchItem {
+    pub class_id: String,
+    pub id: String,
+    pub receiver: String,
+}
+
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
 pub enum Msg {
     Send {
@@ -43,6 +84,9 @@ pub enum Msg {
         id: String,
         receiver: String,
     },
+    SendBatch {
+        items: Vec<SendBatchItem>,
+    },
 }
 
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
@@ -51,4 +95,11 @@ pub enum Query {
     Owner { class_id: String, id: String },
     Supply { class_id: String },
     NFT { class_id: String, id: String },
+    NFTs {
+        class_id: Option<String>,
+        owner: Option<String>,
+        pagination: Option<PageRequest>,
+    },
+    Class { class_id: String },
+    Classes { pagination: Option<PageRequest> },
 }
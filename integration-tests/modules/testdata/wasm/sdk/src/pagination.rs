@@ -0,0 +1,20 @@
+use cosmwasm_std::Binary;
+use schemars::JsonSchema;
+use serde::{Deserialize, Serialize};
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema, Default)]
+#[serde(rename_all = "snake_case")]
+pub struct PageRequest {
+    pub key: Option<Binary>,
+    pub offset: Option<u64>,
+    pub limit: Option<u64>,
+    pub count_total: Option<bool>,
+    pub reverse: Option<bool>,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct PageResponse {
+    pub next_key: Option<Binary>,
+    pub total: Option<u64>,
+}
@@ -0,0 +1,53 @@
+use cosmwasm_std::{StdError, StdResult};
+
+const WIRE_TYPE_VARINT: u64 = 0;
+const WIRE_TYPE_FIXED64: u64 = 1;
+const WIRE_TYPE_LEN: u64 = 2;
+const WIRE_TYPE_FIXED32: u64 = 5;
+
+// decode_string_field returns the value of the string field from the protobuf encoded message.
+// The message responses returned as submessage reply data are protobuf encoded, so this is used
+// to decode the responses containing a single string field.
+pub(crate) fn decode_string_field(data: &[u8], field_number: u64) -> StdResult<String> {
+    let mut value = String::new();
+    let mut pos = 0;
+    while pos < data.len() {
+        let key = decode_varint(data, &mut pos)?;
+        let (number, wire_type) = (key >> 3, key & 0x7);
+        let len = match wire_type {
+            WIRE_TYPE_VARINT => {
+                decode_varint(data, &mut pos)?;
+                0
+            }
+            WIRE_TYPE_FIXED64 => 8,
+            WIRE_TYPE_LEN => decode_varint(data, &mut pos)? as usize,
+            WIRE_TYPE_FIXED32 => 4,
+            _ => return Err(StdError::parse_err("proto", "unsupported wire type")),
+        };
+        let end = pos
+            .checked_add(len)
+            .filter(|end| *end <= data.len())
+            .ok_or_else(|| StdError::parse_err("proto", "unexpected end of data"))?;
+        if number == field_number && wire_type == WIRE_TYPE_LEN {
+            value = String::from_utf8(data[pos..end].to_vec())
+                .map_err(|_| StdError::parse_err("proto", "invalid utf8 string"))?;
+        }
+        pos = end;
+    }
+    Ok(value)
+}
+
+fn decode_varint(data: &[u8], pos: &mut usize) -> StdResult<u64> {
+    let mut value = 0u64;
+    for shift in (0..64).step_by(7) {
+        let byte = *data
+            .get(*pos)
+            .ok_or_else(|| StdError::parse_err("proto", "unexpected end of data"))?;
+        *pos += 1;
+        value |= u64::from(byte & 0x7f) << shift;
+        if byte & 0x80 == 0 {
+            return Ok(value);
+        }
+    }
+    Err(StdError::parse_err("proto", "varint overflow"))
+}
@@ -12,11 +12,14 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	tmjson "github.com/tendermint/tendermint/libs/json"
 	"go.uber.org/zap"
 
 	"github.com/CoreumFoundation/coreum-tools/pkg/logger"
@@ -25,6 +28,8 @@ import (
 	"github.com/CoreumFoundation/coreum/testutil/event"
 	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
 	nfttypes "github.com/CoreumFoundation/coreum/x/nft"
 )
 
@@ -37,6 +42,8 @@ var (
 	ftWASM []byte
 	//go:embed testdata/wasm/nft/artifacts/nft.wasm
 	nftWASM []byte
+	//go:embed testdata/wasm/ft-extension/artifacts/ft_extension.wasm
+	ftExtensionWASM []byte
 )
 
 // bank wasm models
@@ -93,6 +100,20 @@ type accountBodyFTRequest struct {
 	Account string `json:"account"`
 }
 
+type issuerPaginationBodyFTRequest struct {
+	Issuer     string             `json:"issuer"`
+	Pagination *query.PageRequest `json:"pagination"`
+}
+
+type accountPaginationBodyFTRequest struct {
+	Account    string             `json:"account"`
+	Pagination *query.PageRequest `json:"pagination"`
+}
+
+type paginationBodyRequest struct {
+	Pagination *query.PageRequest `json:"pagination"`
+}
+
 type ftMethod string
 
 const (
@@ -106,9 +127,21 @@ const (
 	ftMethodSetWhitelistedLimit ftMethod = "set_whitelisted_limit"
 	ftMethodMintAndSend         ftMethod = "mint_and_send"
 	// query.
-	ftMethodToken              ftMethod = "token"
-	ftMethodFrozenBalance      ftMethod = "frozen_balance"
-	ftMethodWhitelistedBalance ftMethod = "whitelisted_balance"
+	ftMethodParams              ftMethod = "params"
+	ftMethodToken               ftMethod = "token"
+	ftMethodTokens              ftMethod = "tokens"
+	ftMethodFrozenBalance       ftMethod = "frozen_balance"
+	ftMethodFrozenBalances      ftMethod = "frozen_balances"
+	ftMethodWhitelistedBalance  ftMethod = "whitelisted_balance"
+	ftMethodWhitelistedBalances ftMethod = "whitelisted_balances"
+	ftMethodBlacklisted         ftMethod = "blacklisted"
+	ftMethodBlacklistedAccounts ftMethod = "blacklisted_accounts"
+	ftMethodMintAllowance       ftMethod = "mint_allowance"
+	ftMethodMintAllowances      ftMethod = "mint_allowances"
+	ftMethodRateExempt          ftMethod = "rate_exempt"
+	ftMethodRateExemptAccounts  ftMethod = "rate_exempt_accounts"
+	ftMethodMinGasPrice         ftMethod = "min_gas_price"
+	ftMethodFeeModelParams      ftMethod = "fee_model_params"
 )
 
 //nolint:tagliatelle
@@ -149,6 +182,16 @@ type nftOwnerRequest struct {
 	Owner string `json:"owner"`
 }
 
+type nftIDWithPaginationRequest struct {
+	ID         string             `json:"id"`
+	Pagination *query.PageRequest `json:"pagination"`
+}
+
+type nftOwnerWithPaginationRequest struct {
+	Owner      string             `json:"owner"`
+	Pagination *query.PageRequest `json:"pagination"`
+}
+
 type nftMethod string
 
 const (
@@ -161,13 +204,18 @@ const (
 	nftMethodRemoveFromWhiteList nftMethod = "remove_from_whitelist"
 	nftMethodSend                nftMethod = "send"
 	// query.
-	nftMethodClass       nftMethod = "class"
-	nftMethodFrozen      nftMethod = "frozen"
-	nftMethodWhitelisted nftMethod = "whitelisted"
-	nftMethodBalance     nftMethod = "balance"
-	nftMethodOwner       nftMethod = "owner"
-	nftMethodSupply      nftMethod = "supply"
-	nftMethodNFT         nftMethod = "nft"
+	nftMethodParams                    nftMethod = "params"
+	nftMethodClass                     nftMethod = "class"
+	nftMethodFrozen                    nftMethod = "frozen"
+	nftMethodWhitelisted               nftMethod = "whitelisted"
+	nftMethodWhitelistedAccountsForNFT nftMethod = "whitelisted_accounts_for_nft"
+	nftMethodBalance                   nftMethod = "balance"
+	nftMethodOwner                     nftMethod = "owner"
+	nftMethodSupply                    nftMethod = "supply"
+	nftMethodNFT                       nftMethod = "nft"
+	nftMethodNFTs                      nftMethod = "nfts"
+	nftMethodClassNFT                  nftMethod = "class_nft"
+	nftMethodClassesNFT                nftMethod = "classes_nft"
 )
 
 //nolint:tagliatelle
@@ -201,6 +249,31 @@ type nftRes struct {
 	NFT nftItem `json:"nft"`
 }
 
+type nftsRes struct {
+	NFTs       []nftItem           `json:"nfts"`
+	Pagination *query.PageResponse `json:"pagination"`
+}
+
+//nolint:tagliatelle
+type nftModuleClass struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	Description string `json:"description"`
+	URI         string `json:"uri"`
+	URIHash     string `json:"uri_hash"`
+	Data        string `json:"data"`
+}
+
+type nftModuleClassRes struct {
+	Class nftModuleClass `json:"class"`
+}
+
+type nftModuleClassesRes struct {
+	Classes    []nftModuleClass    `json:"classes"`
+	Pagination *query.PageResponse `json:"pagination"`
+}
+
 // TestWASMBankSendContract runs a contract deployment flow and tests that the contract is able to use Bank module
 // to disperse the native coins.
 func TestWASMBankSendContract(t *testing.T) {
@@ -680,6 +753,7 @@ func TestWASMFungibleTokenInContract(t *testing.T) {
 		},
 		BurnRate:           burnRate,
 		SendCommissionRate: sendCommissionRate,
+		MaxSupply:          sdk.ZeroInt(),
 	}
 	requireT.Equal(
 		expectedToken, tokenRes.Token,
@@ -919,6 +993,186 @@ func TestWASMFungibleTokenInContract(t *testing.T) {
 	requireT.Equal(
 		sdk.NewCoin(denom, amountToWhitelist), wasmWhitelistedBalanceRes.Balance,
 	)
+
+	// ********** Params **********
+
+	paramsPayload, err := json.Marshal(map[ftMethod]struct{}{
+		ftMethodParams: {},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, paramsPayload)
+	requireT.NoError(err)
+	var wasmParamsRes assetfttypes.QueryParamsResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmParamsRes))
+	paramsRes, err := ftClient.Params(ctx, &assetfttypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	requireT.Equal(paramsRes.Params.IssueFee.String(), wasmParamsRes.Params.IssueFee.String())
+
+	// ********** Tokens **********
+
+	tokensPayload, err := json.Marshal(map[ftMethod]issuerPaginationBodyFTRequest{
+		ftMethodTokens: {
+			Issuer: contractAddr,
+			Pagination: &query.PageRequest{
+				Limit:      1,
+				CountTotal: true,
+			},
+		},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, tokensPayload)
+	requireT.NoError(err)
+	var wasmTokensRes assetfttypes.QueryTokensResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmTokensRes))
+	requireT.Len(wasmTokensRes.Tokens, 1)
+	requireT.Equal(denom, wasmTokensRes.Tokens[0].Denom)
+	requireT.EqualValues(1, wasmTokensRes.Pagination.Total)
+
+	// ********** FrozenBalances **********
+
+	frozenBalancesPayload, err := json.Marshal(map[ftMethod]accountPaginationBodyFTRequest{
+		ftMethodFrozenBalances: {
+			Account: recipient1.String(),
+			Pagination: &query.PageRequest{
+				CountTotal: true,
+			},
+		},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, frozenBalancesPayload)
+	requireT.NoError(err)
+	var wasmFrozenBalancesRes assetfttypes.QueryFrozenBalancesResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmFrozenBalancesRes))
+	requireT.Equal(
+		sdk.NewCoins(sdk.NewCoin(denom, amountToFreeze.Sub(amountToUnfreeze))).String(), wasmFrozenBalancesRes.Balances.String(),
+	)
+	requireT.EqualValues(1, wasmFrozenBalancesRes.Pagination.Total)
+
+	// ********** WhitelistedBalances **********
+
+	whitelistedBalancesPayload, err := json.Marshal(map[ftMethod]accountPaginationBodyFTRequest{
+		ftMethodWhitelistedBalances: {
+			Account: recipient1.String(),
+			Pagination: &query.PageRequest{
+				CountTotal: true,
+			},
+		},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, whitelistedBalancesPayload)
+	requireT.NoError(err)
+	var wasmWhitelistedBalancesRes assetfttypes.QueryWhitelistedBalancesResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmWhitelistedBalancesRes))
+	requireT.Equal(
+		sdk.NewCoins(sdk.NewCoin(denom, amountToWhitelist)).String(), wasmWhitelistedBalancesRes.Balances.String(),
+	)
+	requireT.EqualValues(1, wasmWhitelistedBalancesRes.Pagination.Total)
+
+	// ********** Blacklisted **********
+
+	blacklistedPayload, err := json.Marshal(map[ftMethod]accountBodyFTRequest{
+		ftMethodBlacklisted: {
+			Account: recipient1.String(),
+		},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, blacklistedPayload)
+	requireT.NoError(err)
+	var wasmBlacklistedRes assetfttypes.QueryBlacklistedResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmBlacklistedRes))
+	requireT.False(wasmBlacklistedRes.Blacklisted)
+
+	// ********** BlacklistedAccounts **********
+
+	blacklistedAccountsPayload, err := json.Marshal(map[ftMethod]paginationBodyRequest{
+		ftMethodBlacklistedAccounts: {},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, blacklistedAccountsPayload)
+	requireT.NoError(err)
+	var wasmBlacklistedAccountsRes assetfttypes.QueryBlacklistedAccountsResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmBlacklistedAccountsRes))
+	requireT.Empty(wasmBlacklistedAccountsRes.Accounts)
+
+	// ********** MintAllowance **********
+
+	mintAllowancePayload, err := json.Marshal(map[ftMethod]accountBodyFTRequest{
+		ftMethodMintAllowance: {
+			Account: recipient1.String(),
+		},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, mintAllowancePayload)
+	requireT.NoError(err)
+	var wasmMintAllowanceRes assetfttypes.QueryMintAllowanceResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmMintAllowanceRes))
+	requireT.Equal(sdk.NewCoin(denom, sdk.ZeroInt()).String(), wasmMintAllowanceRes.Allowance.String())
+
+	// ********** MintAllowances **********
+
+	mintAllowancesPayload, err := json.Marshal(map[ftMethod]accountPaginationBodyFTRequest{
+		ftMethodMintAllowances: {
+			Account: recipient1.String(),
+		},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, mintAllowancesPayload)
+	requireT.NoError(err)
+	var wasmMintAllowancesRes assetfttypes.QueryMintAllowancesResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmMintAllowancesRes))
+	requireT.Empty(wasmMintAllowancesRes.Allowances)
+
+	// ********** RateExempt **********
+
+	rateExemptPayload, err := json.Marshal(map[ftMethod]accountBodyFTRequest{
+		ftMethodRateExempt: {
+			Account: recipient1.String(),
+		},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, rateExemptPayload)
+	requireT.NoError(err)
+	var wasmRateExemptRes assetfttypes.QueryRateExemptResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmRateExemptRes))
+	requireT.False(wasmRateExemptRes.Exempt)
+
+	// ********** RateExemptAccounts **********
+
+	rateExemptAccountsPayload, err := json.Marshal(map[ftMethod]paginationBodyRequest{
+		ftMethodRateExemptAccounts: {},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, rateExemptAccountsPayload)
+	requireT.NoError(err)
+	var wasmRateExemptAccountsRes assetfttypes.QueryRateExemptAccountsResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmRateExemptAccountsRes))
+	requireT.Empty(wasmRateExemptAccountsRes.Accounts)
+
+	// ********** MinGasPrice **********
+
+	minGasPricePayload, err := json.Marshal(map[ftMethod]struct{}{
+		ftMethodMinGasPrice: {},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, minGasPricePayload)
+	requireT.NoError(err)
+	var wasmMinGasPriceRes feemodeltypes.QueryMinGasPriceResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmMinGasPriceRes))
+	requireT.Equal(chain.NetworkConfig.Denom, wasmMinGasPriceRes.MinGasPrice.Denom)
+	requireT.True(wasmMinGasPriceRes.MinGasPrice.Amount.IsPositive())
+
+	// ********** FeeModelParams **********
+
+	feeModelParamsPayload, err := json.Marshal(map[ftMethod]struct{}{
+		ftMethodFeeModelParams: {},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, feeModelParamsPayload)
+	requireT.NoError(err)
+	var wasmFeeModelParamsRes feemodeltypes.QueryParamsResponse
+	requireT.NoError(json.Unmarshal(queryOut, &wasmFeeModelParamsRes))
+	requireT.True(wasmFeeModelParamsRes.Params.Model.InitialGasPrice.IsPositive())
+	requireT.Positive(wasmFeeModelParamsRes.Params.HistoryLength)
 }
 
 // TestWASMNonFungibleTokenInContract verifies that smart contract is able to execute all non-fungible token message and core queries.
@@ -1314,6 +1568,299 @@ func TestWASMNonFungibleTokenInContract(t *testing.T) {
 			Data:    dataString,
 		}, nftQueryRes.NFT,
 	)
+
+	// ********** Params **********
+
+	paramsPayload, err := json.Marshal(map[nftMethod]struct{}{
+		nftMethodParams: {},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, paramsPayload)
+	requireT.NoError(err)
+	var paramsQueryRes assetnfttypes.QueryParamsResponse
+	requireT.NoError(json.Unmarshal(queryOut, &paramsQueryRes))
+	paramsRes, err := assetNftClient.Params(ctx, &assetnfttypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	requireT.Equal(paramsRes.Params.MintFee.String(), paramsQueryRes.Params.MintFee.String())
+
+	// ********** WhitelistedAccountsForNFT **********
+
+	whitelistedAccountsPayload, err := json.Marshal(map[nftMethod]nftIDWithPaginationRequest{
+		nftMethodWhitelistedAccountsForNFT: {
+			ID: mintNFTReq2.ID,
+			Pagination: &query.PageRequest{
+				CountTotal: true,
+			},
+		},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, whitelistedAccountsPayload)
+	requireT.NoError(err)
+	var whitelistedAccountsQueryRes assetnfttypes.QueryWhitelistedAccountsForNFTResponse
+	requireT.NoError(json.Unmarshal(queryOut, &whitelistedAccountsQueryRes))
+	requireT.Equal([]string{recipient.String()}, whitelistedAccountsQueryRes.Accounts)
+	requireT.EqualValues(1, whitelistedAccountsQueryRes.Pagination.Total)
+
+	// ********** NFTs **********
+
+	nftsPayload, err := json.Marshal(map[nftMethod]nftOwnerWithPaginationRequest{
+		nftMethodNFTs: {
+			Owner: recipient.String(),
+			Pagination: &query.PageRequest{
+				Limit:      1,
+				CountTotal: true,
+			},
+		},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, nftsPayload)
+	requireT.NoError(err)
+	var nftsQueryRes nftsRes
+	requireT.NoError(json.Unmarshal(queryOut, &nftsQueryRes))
+	requireT.Equal([]nftItem{
+		{
+			ClassID: classID,
+			ID:      mintNFTReq2.ID,
+			URI:     mintNFTReq2.URI,
+			URIHash: mintNFTReq2.URIHash,
+			Data:    dataString,
+		},
+	}, nftsQueryRes.NFTs)
+	requireT.EqualValues(1, nftsQueryRes.Pagination.Total)
+
+	// ********** Class of the nft module **********
+
+	classNFTPayload, err := json.Marshal(map[nftMethod]struct{}{
+		nftMethodClassNFT: {},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, classNFTPayload)
+	requireT.NoError(err)
+	var classNFTQueryRes nftModuleClassRes
+	requireT.NoError(json.Unmarshal(queryOut, &classNFTQueryRes))
+	nftClassRes, err := nftClient.Class(ctx, &nfttypes.QueryClassRequest{ClassId: classID})
+	requireT.NoError(err)
+	requireT.Equal(nftModuleClass{
+		ID:          nftClassRes.Class.Id,
+		Name:        nftClassRes.Class.Name,
+		Symbol:      nftClassRes.Class.Symbol,
+		Description: nftClassRes.Class.Description,
+		URI:         nftClassRes.Class.Uri,
+		URIHash:     nftClassRes.Class.UriHash,
+		Data:        dataString,
+	}, classNFTQueryRes.Class)
+
+	// ********** Classes of the nft module **********
+
+	classesNFTPayload, err := json.Marshal(map[nftMethod]paginationBodyRequest{
+		nftMethodClassesNFT: {
+			Pagination: &query.PageRequest{
+				Limit: 1,
+			},
+		},
+	})
+	requireT.NoError(err)
+	queryOut, err = queryWASMContract(ctx, clientCtx, contractAddr, classesNFTPayload)
+	requireT.NoError(err)
+	var classesNFTQueryRes nftModuleClassesRes
+	requireT.NoError(json.Unmarshal(queryOut, &classesNFTQueryRes))
+	requireT.Len(classesNFTQueryRes.Classes, 1)
+}
+
+// TestWASMDeterministicGasSchedule checks that the execute message variant registered in the deterministic gas
+// schedule by governance consumes the deterministic gas.
+func TestWASMDeterministicGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	admin := chain.GenAccount()
+	proposer := chain.GenAccount()
+
+	requireT := require.New(t)
+
+	proposerBalance, err := chain.Governance.ComputeProposerBalance(ctx)
+	requireT.NoError(err)
+
+	requireT.NoError(chain.Faucet.FundAccounts(ctx,
+		integrationtests.NewFundedAccount(admin, chain.NewCoin(sdk.NewInt(5000000000))),
+		integrationtests.NewFundedAccount(proposer, proposerBalance),
+	))
+
+	initialPayload, err := json.Marshal(simpleState{
+		Count: 1337,
+	})
+	requireT.NoError(err)
+
+	clientCtx := chain.ClientContext.WithFromAddress(admin)
+	txf := chain.TxFactory().
+		WithSimulateAndExecute(true)
+
+	contractAddr, _, err := deployAndInstantiateWASMContract(
+		ctx,
+		clientCtx,
+		txf,
+		simpleStateWASM,
+		instantiateConfig{
+			accessType: wasmtypes.AccessTypeUnspecified,
+			payload:    initialPayload,
+			label:      "simple_state",
+		},
+	)
+	requireT.NoError(err)
+
+	// the schedule is bound to the contract address, so it doesn't affect other tests using the same code
+	deterministicGasClient := deterministicgastypes.NewQueryClient(chain.ClientContext)
+	paramsRes, err := deterministicGasClient.Params(ctx, &deterministicgastypes.QueryParamsRequest{})
+	requireT.NoError(err)
+
+	incrementPayload, err := methodToEmptyBodyPayload(simpleIncrement)
+	requireT.NoError(err)
+	incrementMsg := &wasmtypes.MsgExecuteContract{
+		Sender:   admin.String(),
+		Contract: contractAddr,
+		Msg:      wasmtypes.RawContractMessage(incrementPayload),
+		Funds:    sdk.NewCoins(),
+	}
+
+	// before registering the schedule the gas is estimated by the simulation
+	estimateRes, _, err := client.EstimateGas(ctx, clientCtx, txf.WithGasAdjustment(1), incrementMsg)
+	requireT.NoError(err)
+	requireT.False(estimateRes.Deterministic)
+
+	contractInfoRes, err := wasmtypes.NewQueryClient(chain.ClientContext).ContractInfo(ctx, &wasmtypes.QueryContractInfoRequest{
+		Address: contractAddr,
+	})
+	requireT.NoError(err)
+
+	const incrementGas = 70000
+	schedule := append(paramsRes.Params.WasmExecuteGas, deterministicgastypes.WasmExecuteGas{
+		CodeID:          contractInfoRes.CodeID,
+		ContractAddress: contractAddr,
+		MsgVariant:      string(simpleIncrement),
+		Gas:             incrementGas,
+	})
+	marshalledSchedule, err := tmjson.Marshal(schedule)
+	requireT.NoError(err)
+
+	requireT.NoError(chain.Governance.ProposeAndVote(ctx, proposer,
+		paramproposal.NewParameterChangeProposal(
+			"Register deterministic gas of the contract", "-",
+			[]paramproposal.ParamChange{
+				paramproposal.NewParamChange(
+					deterministicgastypes.ModuleName,
+					string(deterministicgastypes.KeyWasmExecuteGas),
+					string(marshalledSchedule),
+				),
+			},
+		),
+		govtypes.OptionYes,
+	))
+
+	paramsRes, err = deterministicGasClient.Params(ctx, &deterministicgastypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	requireT.Contains(paramsRes.Params.WasmExecuteGas, schedule[len(schedule)-1])
+
+	// once the schedule is registered the gas is estimated without the simulation
+	estimateRes, estimatedGas, err := client.EstimateGas(ctx, clientCtx, txf.WithGasAdjustment(1), incrementMsg)
+	requireT.NoError(err)
+	requireT.True(estimateRes.Deterministic)
+	requireT.EqualValues(chain.DeterministicGasConfig.FixedGas+incrementGas, estimatedGas)
+	requireT.EqualValues(chain.GasLimitByMsgs(incrementMsg), estimatedGas)
+
+	gasUsed := incrementAndVerify(ctx, clientCtx, txf, contractAddr, requireT, 1338)
+	requireT.EqualValues(chain.DeterministicGasConfig.FixedGas+incrementGas, gasUsed)
+}
+
+// TestWASMAssetFTExtension verifies that the transfers of the token are checked by its extension contract.
+func TestWASMAssetFTExtension(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	admin := chain.GenAccount()
+	recipient := chain.GenAccount()
+
+	requireT := require.New(t)
+	requireT.NoError(chain.Faucet.FundAccounts(ctx,
+		integrationtests.NewFundedAccount(admin, chain.NewCoin(sdk.NewInt(5000000000))),
+	))
+
+	clientCtx := chain.ClientContext.WithFromAddress(admin)
+	txf := chain.TxFactory().
+		WithSimulateAndExecute(true)
+	bankClient := banktypes.NewQueryClient(clientCtx)
+
+	// the contract rejects the transfers of 7, runs out of gas on the transfers of 13 and accepts the other ones
+	initialPayload, err := json.Marshal(struct{}{})
+	requireT.NoError(err)
+	contractAddr, _, err := deployAndInstantiateWASMContract(
+		ctx,
+		clientCtx,
+		txf,
+		ftExtensionWASM,
+		instantiateConfig{
+			accessType: wasmtypes.AccessTypeUnspecified,
+			payload:    initialPayload,
+			label:      "ft_extension",
+		},
+	)
+	requireT.NoError(err)
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:            admin.String(),
+		Symbol:            "EXT",
+		Subunit:           "uext",
+		Precision:         6,
+		InitialAmount:     sdk.NewInt(1000),
+		Features:          []assetfttypes.Feature{assetfttypes.Feature_extension},
+		ExtensionContract: contractAddr,
+	}
+	_, err = client.BroadcastTx(ctx, clientCtx, txf, issueMsg)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, admin)
+
+	// the transfer accepted by the contract, the gas is estimated by the simulation
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: admin.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 10)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		clientCtx,
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// the transfer rejected by the contract
+	sendMsg.Amount = sdk.NewCoins(sdk.NewInt64Coin(denom, 7))
+	_, err = client.BroadcastTx(
+		ctx,
+		clientCtx,
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(&banktypes.MsgSend{})+assetfttypes.ExtensionGasLimit),
+		sendMsg,
+	)
+	requireT.True(assetfttypes.ErrExtensionRejected.Is(err))
+
+	// the transfer is rejected if the contract runs out of gas even if the transaction has enough gas
+	sendMsg.Amount = sdk.NewCoins(sdk.NewInt64Coin(denom, 13))
+	_, err = client.BroadcastTx(
+		ctx,
+		clientCtx,
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(&banktypes.MsgSend{})+2*assetfttypes.ExtensionGasLimit),
+		sendMsg,
+	)
+	requireT.True(assetfttypes.ErrExtensionRejected.Is(err))
+	requireT.ErrorContains(err, "ran out of gas")
+
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: recipient.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin(denom, 10).String(), balanceRes.Balance.String())
 }
 
 func methodToEmptyBodyPayload(methodName simpleStateMethod) (json.RawMessage, error) {
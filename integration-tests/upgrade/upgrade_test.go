@@ -22,6 +22,9 @@ import (
 	appupgradev1 "github.com/CoreumFoundation/coreum/app/upgrade/v1"
 	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
 	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
 // TestUpgrade that after accepting upgrade proposal cosmovisor starts a new version of cored.
@@ -142,4 +145,20 @@ func TestUpgrade(t *testing.T) {
 	requireT.Equal(assetnfttypes.Params{
 		MintFee: chain.NewCoin(sdk.NewInt(0)),
 	}, paramsRes.Params)
+
+	// check that the feemodel params introduced in version 2 of the module are set to the defaults
+	feemodelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+	feemodelParamsRes, err := feemodelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	defaultFeemodelParams := feemodeltypes.DefaultParams()
+	requireT.Equal(defaultFeemodelParams.HistoryLength, feemodelParamsRes.Params.HistoryLength)
+	requireT.Empty(feemodelParamsRes.Params.AcceptedFeeDenoms)
+	requireT.Equal(defaultFeemodelParams.GasTrackingMode, feemodelParamsRes.Params.GasTrackingMode)
+	requireT.Equal(defaultFeemodelParams.TipBurnFraction.String(), feemodelParamsRes.Params.TipBurnFraction.String())
+
+	// check that the store of the deterministicgas module is added and initialized with the default gas table
+	deterministicGasClient := deterministicgastypes.NewQueryClient(chain.ClientContext)
+	gasTableRes, err := deterministicGasClient.DeterministicGasTable(ctx, &deterministicgastypes.QueryDeterministicGasTableRequest{})
+	requireT.NoError(err)
+	requireT.Equal(deterministicgas.DefaultConfig().GasTable(), gasTableRes.GasTable)
 }
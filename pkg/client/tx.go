@@ -21,8 +21,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/mempool"
 	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/CoreumFoundation/coreum-tools/pkg/retry"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
@@ -58,7 +61,7 @@ func BroadcastTx(ctx context.Context, clientCtx Context, txf Factory, msgs ...sd
 		gasPrice.Amount = gasPrice.Amount.Mul(clientCtx.GasPriceAdjustment())
 		txf = txf.WithGasPrices(gasPrice.String())
 
-		_, adjusted, err := CalculateGas(ctx, clientCtx, txf, msgs...)
+		_, adjusted, err := EstimateGas(ctx, clientCtx, txf, msgs...)
 		if err != nil {
 			return nil, err
 		}
@@ -117,11 +120,68 @@ func CalculateGas(ctx context.Context, clientCtx Context, txf Factory, msgs ...s
 		return nil, 0, errors.Wrap(err, "transaction estimation failed")
 	}
 
+	return simRes, adjustGas(clientCtx, txf, simRes.GasInfo.GasUsed), nil
+}
+
+// EstimateGas estimates the gas used by a transaction and returns the
+// estimation response obtained by the query and the adjusted gas amount.
+// Gas of transactions containing deterministic messages only is computed by the node without running
+// the simulation, other transactions are simulated. The path taken is reported by the Deterministic field
+// of the response. If the node does not support gas estimation, the transaction is simulated.
+func EstimateGas(
+	ctx context.Context,
+	clientCtx Context,
+	txf Factory,
+	msgs ...sdk.Msg,
+) (*deterministicgastypes.EstimateGasResponse, uint64, error) {
+	txf, err := prepareFactory(ctx, clientCtx, txf)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	txBytes, err := tx.BuildSimTx(txf, msgs...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	estimateRes, err := estimateGas(ctx, clientCtx, txBytes)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "transaction estimation failed")
+	}
+
+	return estimateRes, adjustGas(clientCtx, txf, estimateRes.GasUsed), nil
+}
+
+func adjustGas(clientCtx Context, txf Factory, gasUsed uint64) uint64 {
 	if txf.GasAdjustment() == 0 {
 		txf = txf.WithGasAdjustment(clientCtx.GasAdjustment())
 	}
+	return uint64(txf.GasAdjustment() * float64(gasUsed))
+}
+
+func estimateGas(ctx context.Context, clientCtx Context, txBytes []byte) (*deterministicgastypes.EstimateGasResponse, error) {
+	estimateRes, err := deterministicgastypes.NewServiceClient(clientCtx).EstimateGas(ctx, &deterministicgastypes.EstimateGasRequest{
+		TxBytes: txBytes,
+	})
+	if err == nil {
+		return estimateRes, nil
+	}
+	if status.Code(err) != codes.Unimplemented {
+		return nil, err
+	}
+
+	// the node doesn't provide the gas estimation service, so we fall back to the simulation
+	simRes, err := sdktx.NewServiceClient(clientCtx).Simulate(ctx, &sdktx.SimulateRequest{
+		TxBytes: txBytes,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
+	return &deterministicgastypes.EstimateGasResponse{
+		GasUsed:       simRes.GasInfo.GasUsed,
+		Deterministic: false,
+	}, nil
 }
 
 // BroadcastRawTx broadcast the txBytes using the clientCtx and set BroadcastMode.
@@ -46,7 +46,8 @@ func init() {
 	// common vars
 	var (
 		feeConfig = FeeConfig{
-			FeeModel: feemodeltypes.DefaultModel(),
+			FeeModel:      feemodeltypes.DefaultModel(),
+			HistoryLength: feemodeltypes.DefaultParams().HistoryLength,
 		}
 
 		govConfig = GovConfig{
@@ -200,6 +201,8 @@ func Networks() []Network {
 // FeeConfig is the part of network config defining parameters of our fee model.
 type FeeConfig struct {
 	FeeModel feemodeltypes.Model
+	// HistoryLength is the number of the latest blocks for which the gas price records are kept.
+	HistoryLength uint32
 }
 
 // GovConfig contains gov module configs.
@@ -568,6 +571,7 @@ func genesisByTemplate(n Network) ([]byte, error) {
 		MetadataDisplayDenom string
 		Denom                string
 		FeeModelParams       feemodeltypes.ModelParams
+		FeeHistoryLength     uint32
 		Gov                  GovConfig
 		Staking              StakingConfig
 		CustomParamsConfig   CustomParamsConfig
@@ -579,6 +583,7 @@ func genesisByTemplate(n Network) ([]byte, error) {
 		MetadataDisplayDenom: n.metadataDisplayDenom,
 		Denom:                n.denom,
 		FeeModelParams:       n.FeeModel().Params(),
+		FeeHistoryLength:     n.fee.HistoryLength,
 		Gov:                  n.gov,
 		Staking:              n.staking,
 		CustomParamsConfig:   n.customParams,
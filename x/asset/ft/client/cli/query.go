@@ -29,6 +29,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryFrozenBalances())
 	cmd.AddCommand(CmdQueryWhitelistedBalance())
 	cmd.AddCommand(CmdQueryWhitelistedBalances())
+	cmd.AddCommand(CmdQueryBlacklisted())
+	cmd.AddCommand(CmdQueryBlacklistedAccounts())
+	cmd.AddCommand(CmdQueryMintAllowance())
+	cmd.AddCommand(CmdQueryMintAllowances())
+	cmd.AddCommand(CmdQueryRateExempt())
+	cmd.AddCommand(CmdQueryRateExemptAccounts())
 	return cmd
 }
 
@@ -278,3 +284,248 @@ $ %[1]s query %s whitelisted-balance [account] [denom]
 
 	return cmd
 }
+
+// CmdQueryBlacklisted return the QueryBlacklisted cobra command.
+func CmdQueryBlacklisted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blacklisted [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query if the account is blacklisted for the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query if the account is blacklisted for the fungible token.
+
+Example:
+$ %[1]s query %s blacklisted [account] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			account := args[0]
+			denom := args[1]
+			res, err := queryClient.Blacklisted(cmd.Context(), &types.QueryBlacklistedRequest{
+				Account: account,
+				Denom:   denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryBlacklistedAccounts return the QueryBlacklistedAccounts cobra command.
+func CmdQueryBlacklistedAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blacklisted-accounts [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query blacklisted accounts of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query blacklisted accounts of the fungible token.
+
+Example:
+$ %[1]s query %s blacklisted-accounts [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			res, err := queryClient.BlacklistedAccounts(cmd.Context(), &types.QueryBlacklistedAccountsRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blacklisted accounts")
+
+	return cmd
+}
+
+// CmdQueryMintAllowances return the QueryMintAllowances cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdQueryMintAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-allowances [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible token mint allowances",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query fungible token mint allowances of an account.
+
+Example:
+$ %[1]s query %s mint-allowances [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			account := args[0]
+			res, err := queryClient.MintAllowances(cmd.Context(), &types.QueryMintAllowancesRequest{
+				Account:    account,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint allowances")
+
+	return cmd
+}
+
+// CmdQueryMintAllowance return the QueryMintAllowance cobra command.
+func CmdQueryMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-allowance [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query fungible token mint allowance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query fungible token mint allowance of an account.
+
+Example:
+$ %[1]s query %s mint-allowance [account] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			account := args[0]
+			denom := args[1]
+			res, err := queryClient.MintAllowance(cmd.Context(), &types.QueryMintAllowanceRequest{
+				Account: account,
+				Denom:   denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRateExempt return the QueryRateExempt cobra command.
+func CmdQueryRateExempt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-exempt [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query if the account is exempted from the rates of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query if the account is exempted from the burn rate and send commission rate of the fungible token.
+
+Example:
+$ %[1]s query %s rate-exempt [account] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			account := args[0]
+			denom := args[1]
+			res, err := queryClient.RateExempt(cmd.Context(), &types.QueryRateExemptRequest{
+				Account: account,
+				Denom:   denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRateExemptAccounts return the QueryRateExemptAccounts cobra command.
+func CmdQueryRateExemptAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-exempt-accounts [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query accounts exempted from the rates of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query accounts exempted from the burn rate and send commission rate of the fungible token.
+
+Example:
+$ %[1]s query %s rate-exempt-accounts [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			res, err := queryClient.RateExemptAccounts(cmd.Context(), &types.QueryRateExemptAccountsRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate exempt accounts")
+
+	return cmd
+}
@@ -30,6 +30,9 @@ func TestQueryTokens(t *testing.T) {
 		},
 		BurnRate:           sdk.MustNewDecFromStr("0.1"),
 		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+		MaxSupply:          sdk.NewInt(1000),
+		// the JSON response contains empty list of the recipients
+		CommissionRecipients: []types.CommissionRecipient{},
 	}
 
 	ctx := testNetwork.Validators[0].ClientCtx
@@ -46,6 +49,7 @@ func TestQueryTokens(t *testing.T) {
 	expectedToken := token
 	expectedToken.Denom = denom
 	expectedToken.Issuer = testNetwork.Validators[0].Address.String()
+	expectedToken.Admin = testNetwork.Validators[0].Address.String()
 	requireT.Equal(expectedToken, resp.Tokens[0])
 }
 
@@ -64,6 +68,9 @@ func TestQueryToken(t *testing.T) {
 		},
 		BurnRate:           sdk.MustNewDecFromStr("0.1"),
 		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+		MaxSupply:          sdk.NewInt(1000),
+		// the JSON response contains empty list of the recipients
+		CommissionRecipients: []types.CommissionRecipient{},
 	}
 	ctx := testNetwork.Validators[0].ClientCtx
 
@@ -79,5 +86,6 @@ func TestQueryToken(t *testing.T) {
 	expectedToken := token
 	expectedToken.Denom = denom
 	expectedToken.Issuer = testNetwork.Validators[0].Address.String()
+	expectedToken.Admin = testNetwork.Validators[0].Address.String()
 	requireT.Equal(expectedToken, resp.Token)
 }
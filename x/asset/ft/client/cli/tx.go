@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -24,6 +25,10 @@ const (
 	FeaturesFlag           = "features"
 	BurnRateFlag           = "burn-rate"
 	SendCommissionRateFlag = "send-commission-rate"
+	URIFlag                = "uri"
+	URIHashFlag            = "uri-hash"
+	MaxSupplyFlag          = "max-supply"
+	ExtensionContractFlag  = "extension-contract"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -42,9 +47,21 @@ func GetTxCmd() *cobra.Command {
 		CmdTxBurn(),
 		CmdTxFreeze(),
 		CmdTxUnfreeze(),
+		CmdTxTimedFreeze(),
 		CmdTxGloballyFreeze(),
 		CmdTxGloballyUnfreeze(),
 		CmdTxSetWhitelistedLimit(),
+		CmdTxTransferAdmin(),
+		CmdTxClearAdmin(),
+		CmdTxClawback(),
+		CmdTxUpdateMetadata(),
+		CmdTxAddToBlacklist(),
+		CmdTxRemoveFromBlacklist(),
+		CmdTxGrantMintAllowance(),
+		CmdTxRevokeMintAllowance(),
+		CmdTxAddRateExemption(),
+		CmdTxRemoveRateExemption(),
+		CmdTxUpdateCommissionRecipients(),
 	)
 
 	return cmd
@@ -60,7 +77,7 @@ func CmdTxIssue() *cobra.Command {
 	}
 	sort.Strings(allowedFeatures)
 	cmd := &cobra.Command{
-		Use:   "issue [symbol] [subunit] [precision] [initial_amount] [description] --from [issuer] --features=" + strings.Join(allowedFeatures, ",") + " --burn-rate=0.12 --send-commission-rate=0.2",
+		Use:   "issue [symbol] [subunit] [precision] [initial_amount] [description] --from [issuer] --features=" + strings.Join(allowedFeatures, ",") + " --burn-rate=0.12 --send-commission-rate=0.2 --max-supply=1000000",
 		Args:  cobra.ExactArgs(5),
 		Short: "Issue new fungible token",
 		Long: strings.TrimSpace(
@@ -135,6 +152,34 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 			}
 			description := args[4]
 
+			uri, err := cmd.Flags().GetString(URIFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			uriHash, err := cmd.Flags().GetString(URIHashFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			// if the max supply wasn't provided the supply is unlimited
+			maxSupply := sdk.ZeroInt()
+			maxSupplyStr, err := cmd.Flags().GetString(MaxSupplyFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if len(maxSupplyStr) > 0 {
+				var ok bool
+				maxSupply, ok = sdk.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return errors.Errorf("invalid max-supply %q", maxSupplyStr)
+				}
+			}
+
+			extensionContract, err := cmd.Flags().GetString(ExtensionContractFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
 			msg := &types.MsgIssue{
 				Issuer:             issuer.String(),
 				Symbol:             symbol,
@@ -145,6 +190,10 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 				Features:           features,
 				BurnRate:           burnRate,
 				SendCommissionRate: sendCommissionRate,
+				URI:                uri,
+				URIHash:            uriHash,
+				MaxSupply:          maxSupply,
+				ExtensionContract:  extensionContract,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -153,6 +202,10 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 	cmd.Flags().StringSlice(FeaturesFlag, []string{}, "Features to be enabled on fungible token. e.g --features="+strings.Join(allowedFeatures, ","))
 	cmd.Flags().String(BurnRateFlag, "0", "Indicates the rate at which coins will be burnt on top of the sent amount in every send action. Must be between 0 and 1.")
 	cmd.Flags().String(SendCommissionRateFlag, "0", "Indicates the rate at which coins will be sent to the issuer on top of the sent amount in every send action. Must be between 0 and 1.")
+	cmd.Flags().String(URIFlag, "", "URI of the token metadata.")
+	cmd.Flags().String(URIHashFlag, "", "Hash of the content the URI points to.")
+	cmd.Flags().String(MaxSupplyFlag, "0", "Maximum total supply of the token. Zero means the supply is unlimited.")
+	cmd.Flags().String(ExtensionContractFlag, "", "Address of the contract checking the transfers of the token. Requires the extension feature.")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -285,6 +338,54 @@ $ %s tx %s freeze [account_address] 100000ABC-%s --from [sender]
 	return cmd
 }
 
+// CmdTxTimedFreeze returns TimedFreeze cobra command.
+func CmdTxTimedFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timed-freeze [account_address] [amount] [unlock_time] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Freeze any amount of fungible token for the specific account until the unlock time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze a portion of fungible token until the unlock time provided in RFC3339 format.
+The frozen amount is released automatically once the unlock time is reached.
+
+Example:
+$ %s tx %s timed-freeze [account_address] 100000ABC-%s 2024-01-01T00:00:00Z --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+			unlockTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid unlock time")
+			}
+
+			msg := &types.MsgTimedFreeze{
+				Sender:     sender.String(),
+				Account:    account,
+				Coin:       amount,
+				UnlockTime: unlockTime,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdTxUnfreeze returns Unfreeze cobra command.
 //
 //nolint:dupl // most code is identical between Freeze/Unfreeze cmd, but reusing logic is not beneficial here.
@@ -449,3 +550,473 @@ $ %s tx %s globally-unfreeze ABC-%s --from [sender]
 
 	return cmd
 }
+
+// CmdTxTransferAdmin returns TransferAdmin cobra command.
+func CmdTxTransferAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-admin [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer the admin role of the fungible token to another account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfers the admin role of the fungible token to another account.
+Only the current admin is allowed to transfer the admin role.
+
+Example:
+$ %s tx %s transfer-admin [account_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgTransferAdmin{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClearAdmin returns ClearAdmin cobra command.
+func CmdTxClearAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-admin [denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove the admin of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Removes the admin of the fungible token, so nobody is able to perform the privileged operations on it anymore.
+This operation is irreversible.
+
+Example:
+$ %s tx %s clear-admin ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			msg := &types.MsgClearAdmin{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClawback returns Clawback cobra command.
+func CmdTxClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [account_address] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Clawback any amount of fungible token from the specific account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Return a portion of fungible token from the account to the admin.
+
+Example:
+$ %s tx %s clawback [account_address] 100000ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgClawback{
+				Sender:  sender.String(),
+				Account: account,
+				Coin:    amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateMetadata returns UpdateMetadata cobra command.
+func CmdTxUpdateMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-metadata [denom] [description] --from [sender] --uri=https://my-token-meta.invalid/1 --uri-hash=e000624",
+		Args:  cobra.ExactArgs(2),
+		Short: "Update the metadata of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the description, URI and URI hash of the fungible token. The omitted URI and URI hash are cleared.
+
+Example:
+$ %s tx %s update-metadata ABC-%s "ABC Token" --uri=https://my-token-meta.invalid/1 --uri-hash=e000624 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			uri, err := cmd.Flags().GetString(URIFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			uriHash, err := cmd.Flags().GetString(URIHashFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgUpdateMetadata{
+				Sender:      clientCtx.GetFromAddress().String(),
+				Denom:       args[0],
+				Description: args[1],
+				URI:         uri,
+				URIHash:     uriHash,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(URIFlag, "", "URI of the token metadata.")
+	cmd.Flags().String(URIHashFlag, "", "Hash of the content the URI points to.")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxAddToBlacklist returns AddToBlacklist cobra command.
+//
+//nolint:dupl // most code is identical between AddToBlacklist/RemoveFromBlacklist cmd, but reusing logic is not beneficial here.
+func CmdTxAddToBlacklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-blacklist [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Blacklist the account for the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Blacklists the account, so it can neither send nor receive the fungible token.
+
+Example:
+$ %s tx %s add-to-blacklist [account_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgAddToBlacklist{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRemoveFromBlacklist returns RemoveFromBlacklist cobra command.
+//
+//nolint:dupl // most code is identical between AddToBlacklist/RemoveFromBlacklist cmd, but reusing logic is not beneficial here.
+func CmdTxRemoveFromBlacklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-from-blacklist [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove the account from the blacklist of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Removes the account from the blacklist of the fungible token.
+
+Example:
+$ %s tx %s remove-from-blacklist [account_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgRemoveFromBlacklist{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGrantMintAllowance returns GrantMintAllowance cobra command.
+func CmdTxGrantMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-mint-allowance [minter_address] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Allow the account to mint up to the amount of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sets the amount of fungible token the minter is allowed to mint, replacing the previous mint allowance.
+
+Example:
+$ %s tx %s grant-mint-allowance [minter_address] 100000ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			minter := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgGrantMintAllowance{
+				Sender: sender.String(),
+				Minter: minter,
+				Coin:   amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRevokeMintAllowance returns RevokeMintAllowance cobra command.
+func CmdTxRevokeMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-mint-allowance [minter_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Revoke the mint allowance of the account for the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Removes the mint allowance of the minter for the fungible token.
+
+Example:
+$ %s tx %s revoke-mint-allowance [minter_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			minter := args[0]
+			denom := args[1]
+
+			msg := &types.MsgRevokeMintAllowance{
+				Sender: sender.String(),
+				Minter: minter,
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxAddRateExemption returns AddRateExemption cobra command.
+//
+//nolint:dupl // most code is identical between AddRateExemption/RemoveRateExemption cmd, but reusing logic is not beneficial here.
+func CmdTxAddRateExemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-rate-exemption [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Exempt the account from the burn rate and send commission rate of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Exempts the account from the burn rate and send commission rate of the fungible token, e.g. to let liquidity venues move the token without being charged.
+
+Example:
+$ %s tx %s add-rate-exemption [account_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgAddRateExemption{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRemoveRateExemption returns RemoveRateExemption cobra command.
+//
+//nolint:dupl // most code is identical between AddRateExemption/RemoveRateExemption cmd, but reusing logic is not beneficial here.
+func CmdTxRemoveRateExemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-exemption [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove the rate exemption of the account for the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Removes the burn rate and send commission rate exemption of the account for the fungible token.
+
+Example:
+$ %s tx %s remove-rate-exemption [account_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgRemoveRateExemption{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateCommissionRecipients returns UpdateCommissionRecipients cobra command.
+func CmdTxUpdateCommissionRecipients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-commission-recipients [denom] [address:weight,...] --from [sender]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Set the accounts receiving the send commission of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sets the accounts receiving the send commission of the fungible token proportionally to their weights.
+If no recipients are provided, the send commission is sent to the admin.
+
+Example:
+$ %s tx %s update-commission-recipients ABC-%s %s:3,%s:1 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, constant.AddressSampleTest, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			var recipients []types.CommissionRecipient
+			if len(args) > 1 {
+				for _, item := range strings.Split(args[1], ",") {
+					parts := strings.Split(item, ":")
+					if len(parts) != 2 {
+						return errors.Errorf("invalid commission recipient %q, the format must be address:weight", item)
+					}
+					weight, err := strconv.ParseUint(parts[1], 10, 32)
+					if err != nil {
+						return sdkerrors.Wrapf(err, "invalid weight of the commission recipient %q", item)
+					}
+					recipients = append(recipients, types.CommissionRecipient{
+						Address: parts[0],
+						Weight:  uint32(weight),
+					})
+				}
+			}
+
+			msg := &types.MsgUpdateCommissionRecipients{
+				Sender:     sender.String(),
+				Denom:      denom,
+				Recipients: recipients,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -169,6 +170,43 @@ func TestFreezeAndQueryFrozen(t *testing.T) {
 	requireT.Equal(sdk.NewInt64Coin(denom, 25).String(), resp.Balance.String())
 }
 
+func TestTimedFreezeAndQueryFrozen(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_freezing,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(777), testNetwork)
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// freeze part of the token until the far future
+	coinToFreeze := sdk.NewInt64Coin(denom, 100)
+	unlockTime := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	args := append([]string{recipient.String(), coinToFreeze.String(), unlockTime.Format(time.RFC3339), "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxTimedFreeze(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryFrozenBalanceResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryFrozenBalance(), []string{recipient.String(), denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(sdk.NewInt64Coin(denom, 0).String(), resp.Balance.String())
+	requireT.Equal([]types.TimedFreeze{{
+		Account:    recipient.String(),
+		Coin:       coinToFreeze,
+		UnlockTime: unlockTime,
+	}}, resp.TimedFreezes)
+}
+
 func TestGloballyFreezeUnfreeze(t *testing.T) {
 	requireT := require.New(t)
 	networkCfg, err := config.NetworkByChainID(constant.ChainIDDev)
@@ -262,6 +300,301 @@ func TestWhitelistAndQueryWhitelisted(t *testing.T) {
 	requireT.Len(balancesResp.Balances, 1)
 }
 
+func TestTransferAndClearAdmin(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issue(requireT, ctx, token, sdk.NewInt(100), testNetwork)
+
+	// transfer admin
+	newAdmin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	args := append([]string{newAdmin.String(), denom1, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxTransferAdmin(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryTokenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom1, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(newAdmin.String(), resp.Token.Admin)
+
+	// clear admin
+	token.Symbol = "eth" + uuid.NewString()[:4]
+	token.Subunit = "wei" + uuid.NewString()[:4]
+	denom2 := issue(requireT, ctx, token, sdk.NewInt(100), testNetwork)
+
+	args = append([]string{denom2, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxClearAdmin(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom2, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Empty(resp.Token.Admin)
+}
+
+func TestClawback(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_clawback,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(100), testNetwork)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	args := append([]string{testNetwork.Validators[0].Address.String(), account.String(), "40" + denom}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, bankcli.NewSendTxCmd(), args)
+	requireT.NoError(err)
+
+	// clawback
+	args = append([]string{account.String(), "30" + denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxClawback(), args)
+	requireT.NoError(err)
+
+	var balanceRsp banktypes.QueryAllBalancesResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, bankcli.GetBalancesCmd(), []string{account.String(), "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &balanceRsp))
+	requireT.Equal(sdk.NewInt(10).String(), balanceRsp.Balances.AmountOf(denom).String())
+}
+
+func TestBlacklistAndQueryBlacklisted(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_blacklisting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(100), testNetwork)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// blacklist the account
+	args := append([]string{account.String(), denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxAddToBlacklist(), args)
+	requireT.NoError(err)
+
+	var blacklistedResp types.QueryBlacklistedResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryBlacklisted(), []string{account.String(), denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &blacklistedResp))
+	requireT.True(blacklistedResp.Blacklisted)
+
+	var accountsResp types.QueryBlacklistedAccountsResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryBlacklistedAccounts(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &accountsResp))
+	requireT.Equal([]string{account.String()}, accountsResp.Accounts)
+
+	// remove from the blacklist
+	args = append([]string{account.String(), denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxRemoveFromBlacklist(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryBlacklisted(), []string{account.String(), denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &blacklistedResp))
+	requireT.False(blacklistedResp.Blacklisted)
+}
+
+func TestMintAllowanceAndQueryMintAllowance(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(100), testNetwork)
+	minter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// grant the mint allowance
+	coin := sdk.NewInt64Coin(denom, 50)
+	args := append([]string{minter.String(), coin.String(), "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxGrantMintAllowance(), args)
+	requireT.NoError(err)
+
+	var allowanceResp types.QueryMintAllowanceResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryMintAllowance(), []string{minter.String(), denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &allowanceResp))
+	requireT.Equal(coin.String(), allowanceResp.Allowance.String())
+
+	var allowancesResp types.QueryMintAllowancesResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryMintAllowances(), []string{minter.String(), "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &allowancesResp))
+	requireT.Equal(sdk.NewCoins(coin).String(), allowancesResp.Allowances.String())
+
+	// revoke the mint allowance
+	args = append([]string{minter.String(), denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxRevokeMintAllowance(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryMintAllowance(), []string{minter.String(), denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &allowanceResp))
+	requireT.True(allowanceResp.Allowance.Amount.IsZero())
+}
+
+func TestRateExemptionAndQueryRateExempt(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		BurnRate:    sdk.MustNewDecFromStr("0.1"),
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(100), testNetwork)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// add the rate exemption
+	args := append([]string{account.String(), denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxAddRateExemption(), args)
+	requireT.NoError(err)
+
+	var exemptResp types.QueryRateExemptResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryRateExempt(), []string{account.String(), denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &exemptResp))
+	requireT.True(exemptResp.Exempt)
+
+	var accountsResp types.QueryRateExemptAccountsResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryRateExemptAccounts(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &accountsResp))
+	requireT.Equal([]string{account.String()}, accountsResp.Accounts)
+
+	// remove the rate exemption
+	args = append([]string{account.String(), denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxRemoveRateExemption(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryRateExempt(), []string{account.String(), denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &exemptResp))
+	requireT.False(exemptResp.Exempt)
+}
+
+func TestUpdateCommissionRecipients(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:             "btc" + uuid.NewString()[:4],
+		Subunit:            "satoshi" + uuid.NewString()[:4],
+		Precision:          8,
+		Description:        "description",
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(100), testNetwork)
+	treasury := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	pool := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// set the recipients
+	args := append([]string{
+		denom,
+		fmt.Sprintf("%s:3,%s:1", treasury.String(), pool.String()),
+		"--output", "json",
+	}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxUpdateCommissionRecipients(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryTokenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal([]types.CommissionRecipient{
+		{Address: treasury.String(), Weight: 3},
+		{Address: pool.String(), Weight: 1},
+	}, resp.Token.CommissionRecipients)
+
+	// reset the recipients
+	args = append([]string{denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxUpdateCommissionRecipients(), args)
+	requireT.NoError(err)
+
+	var resetResp types.QueryTokenResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resetResp))
+	requireT.Empty(resetResp.Token.CommissionRecipients)
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_metadata_updating,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(100), testNetwork)
+
+	args := append([]string{
+		denom,
+		"new description",
+		fmt.Sprintf("--%s=%s", cli.URIFlag, "https://my-token-meta.invalid/1"),
+		fmt.Sprintf("--%s=%s", cli.URIHashFlag, "content-hash"),
+		"--output", "json",
+	}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxUpdateMetadata(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryTokenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal("new description", resp.Token.Description)
+	requireT.Equal("https://my-token-meta.invalid/1", resp.Token.URI)
+	requireT.Equal("content-hash", resp.Token.URIHash)
+}
+
 func issue(requireT *require.Assertions, ctx client.Context, token types.Token, initialAmount sdk.Int, testNetwork *network.Network) string {
 	features := make([]string, 0, len(token.Features))
 	for _, feature := range token.Features {
@@ -281,6 +614,9 @@ func issue(requireT *require.Assertions, ctx client.Context, token types.Token,
 	if !token.SendCommissionRate.IsNil() {
 		args = append(args, fmt.Sprintf("--%s=%s", cli.SendCommissionRateFlag, token.SendCommissionRate.String()))
 	}
+	if !token.MaxSupply.IsNil() {
+		args = append(args, fmt.Sprintf("--%s=%s", cli.MaxSupplyFlag, token.MaxSupply.String()))
+	}
 
 	args = append(args, txValidator1Args(testNetwork)...)
 	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxIssue(), args)
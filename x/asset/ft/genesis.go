@@ -1,13 +1,41 @@
 package ft
 
 import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
 
 	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
+// UnmarshalGenesisState unmarshals the asset ft genesis state and migrates the tokens exported before the admin role
+// was introduced. Such tokens don't contain the admin field at all, so their admin is set to the issuer. The tokens
+// having the admin cleared contain the empty admin field, so they are kept without the admin.
+func UnmarshalGenesisState(cdc codec.JSONCodec, bz json.RawMessage) (types.GenesisState, error) {
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return types.GenesisState{}, errors.Wrapf(err, "failed to unmarshal %s genesis state", types.ModuleName)
+	}
+
+	var rawGenState struct {
+		Tokens []map[string]json.RawMessage `json:"tokens"`
+	}
+	if err := json.Unmarshal(bz, &rawGenState); err != nil {
+		return types.GenesisState{}, errors.Wrapf(err, "failed to unmarshal %s genesis state", types.ModuleName)
+	}
+	for i, rawToken := range rawGenState.Tokens {
+		if _, ok := rawToken["admin"]; !ok {
+			genState.Tokens[i].Admin = genState.Tokens[i].Issuer
+		}
+	}
+
+	return genState, nil
+}
+
 // InitGenesis initializes the asset module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
@@ -24,11 +52,17 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 
 		definition := types.Definition{
-			Denom:              token.Denom,
-			Issuer:             token.Issuer,
-			Features:           token.Features,
-			BurnRate:           token.BurnRate,
-			SendCommissionRate: token.SendCommissionRate,
+			Denom:                token.Denom,
+			Issuer:               token.Issuer,
+			Features:             token.Features,
+			BurnRate:             token.BurnRate,
+			SendCommissionRate:   token.SendCommissionRate,
+			Admin:                token.Admin,
+			URI:                  token.URI,
+			URIHash:              token.URIHash,
+			MaxSupply:            token.MaxSupply,
+			CommissionRecipients: token.CommissionRecipients,
+			ExtensionContract:    token.ExtensionContract,
 		}
 
 		k.SetDefinition(ctx, issuer, subunit, definition)
@@ -59,6 +93,40 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		address := sdk.MustAccAddressFromBech32(whitelistedBalance.Address)
 		k.SetWhitelistedBalances(ctx, address, whitelistedBalance.Coins)
 	}
+
+	// Init timed freezes
+	for _, timedFreeze := range genState.TimedFreezes {
+		if err := k.SetTimedFreeze(ctx, timedFreeze); err != nil {
+			panic(err)
+		}
+	}
+
+	// Init rate exempt accounts
+	for _, rateExemptAccounts := range genState.RateExemptAccounts {
+		for _, account := range rateExemptAccounts.Accounts {
+			if err := k.SetRateExempt(ctx, rateExemptAccounts.Denom, sdk.MustAccAddressFromBech32(account), true); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	// Init mint allowances
+	for _, mintAllowance := range genState.MintAllowances {
+		if err := types.ValidateAssetCoins(mintAllowance.Coins); err != nil {
+			panic(err)
+		}
+		address := sdk.MustAccAddressFromBech32(mintAllowance.Address)
+		k.SetMintAllowances(ctx, address, mintAllowance.Coins)
+	}
+
+	// Init blacklisted accounts
+	for _, blacklistedAccounts := range genState.BlacklistedAccounts {
+		for _, account := range blacklistedAccounts.Accounts {
+			if err := k.SetBlacklisted(ctx, blacklistedAccounts.Denom, sdk.MustAccAddressFromBech32(account), true); err != nil {
+				panic(err)
+			}
+		}
+	}
 }
 
 // ExportGenesis returns the asset module's exported genesis.
@@ -81,10 +149,41 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	// Export timed freezes
+	var timedFreezes []types.TimedFreeze
+	if err := k.IterateAllTimedFreezes(ctx, func(timedFreeze types.TimedFreeze) bool {
+		timedFreezes = append(timedFreezes, timedFreeze)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+
+	// Export blacklisted accounts
+	blacklistedAccounts, _, err := k.GetAllBlacklistedAccounts(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	// Export rate exempt accounts
+	rateExemptAccounts, _, err := k.GetAllRateExemptAccounts(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	// Export mint allowances
+	mintAllowances, _, err := k.GetAccountsMintAllowances(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:              k.GetParams(ctx),
 		Tokens:              tokens,
 		FrozenBalances:      frozenBalances,
 		WhitelistedBalances: whitelistedBalances,
+		TimedFreezes:        timedFreezes,
+		BlacklistedAccounts: blacklistedAccounts,
+		MintAllowances:      mintAllowances,
+		RateExemptAccounts:  rateExemptAccounts,
 	}
 }
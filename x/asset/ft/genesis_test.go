@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -44,6 +45,21 @@ func TestInitAndExportGenesis(t *testing.T) {
 				types.Feature_freezing,
 				types.Feature_whitelisting,
 			},
+			Admin:     issuer.String(),
+			URI:       fmt.Sprintf("https://my-token-meta.invalid/%d", i),
+			URIHash:   fmt.Sprintf("content-hash%d", i),
+			MaxSupply: sdk.NewInt(int64(i) * 1_000_000),
+		}
+		// Clear admin of some Tokens.
+		if i == 3 {
+			token.Admin = ""
+		}
+		// Set commission recipients of some Tokens.
+		if i > 2 {
+			token.CommissionRecipients = []types.CommissionRecipient{
+				{Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), Weight: 3},
+				{Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), Weight: 1},
+			}
 		}
 		// Globally freeze some Tokens.
 		if i%2 == 0 {
@@ -81,11 +97,73 @@ func TestInitAndExportGenesis(t *testing.T) {
 			})
 	}
 
+	// timed freezes
+	var timedFreezes []types.TimedFreeze
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		timedFreezes = append(timedFreezes,
+			types.TimedFreeze{
+				Account:    addr.String(),
+				Coin:       sdk.NewCoin(tokens[0].Denom, sdk.NewInt(rand.Int63n(1000)+1)),
+				UnlockTime: time.Date(2100, 1, i+1, 0, 0, 0, 0, time.UTC),
+			},
+			types.TimedFreeze{
+				Account:    addr.String(),
+				Coin:       sdk.NewCoin(tokens[1].Denom, sdk.NewInt(rand.Int63n(1000)+1)),
+				UnlockTime: time.Date(2100, 2, i+1, 0, 0, 0, 0, time.UTC),
+			},
+		)
+	}
+
+	// blacklisted accounts
+	var blacklistedAccounts []types.BlacklistedAccounts
+	for i := 0; i < 2; i++ {
+		var accounts []string
+		for j := 0; j < 3; j++ {
+			accounts = append(accounts, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String())
+		}
+		blacklistedAccounts = append(blacklistedAccounts, types.BlacklistedAccounts{
+			Denom:    tokens[i].Denom,
+			Accounts: accounts,
+		})
+	}
+
+	// rate exempt accounts
+	var rateExemptAccounts []types.RateExemptAccounts
+	for i := 1; i < 3; i++ {
+		var accounts []string
+		for j := 0; j < 3; j++ {
+			accounts = append(accounts, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String())
+		}
+		rateExemptAccounts = append(rateExemptAccounts, types.RateExemptAccounts{
+			Denom:    tokens[i].Denom,
+			Accounts: accounts,
+		})
+	}
+
+	// mint allowances
+	var mintAllowances []types.Balance
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		mintAllowances = append(mintAllowances,
+			types.Balance{
+				Address: addr.String(),
+				Coins: sdk.NewCoins(
+					sdk.NewCoin(tokens[0].Denom, sdk.NewInt(rand.Int63())),
+					sdk.NewCoin(tokens[1].Denom, sdk.NewInt(rand.Int63())),
+				),
+			})
+	}
+
 	genState := types.GenesisState{
 		Params:              types.DefaultParams(),
 		Tokens:              tokens,
 		FrozenBalances:      frozenBalances,
 		WhitelistedBalances: whitelistedBalances,
+		TimedFreezes:        timedFreezes,
+		BlacklistedAccounts: blacklistedAccounts,
+		MintAllowances:      mintAllowances,
+		RateExemptAccounts:  rateExemptAccounts,
 	}
 
 	// init the keeper
@@ -123,6 +201,38 @@ func TestInitAndExportGenesis(t *testing.T) {
 		assertT.EqualValues(balance.Coins.String(), coins.String())
 	}
 
+	// timed freezes
+	for _, timedFreeze := range timedFreezes {
+		address, err := sdk.AccAddressFromBech32(timedFreeze.Account)
+		requireT.NoError(err)
+		storedTimedFreezes, err := ftKeeper.GetTimedFreezes(ctx, address, timedFreeze.Coin.Denom)
+		requireT.NoError(err)
+		assertT.EqualValues([]types.TimedFreeze{timedFreeze}, storedTimedFreezes)
+	}
+
+	// blacklisted accounts
+	for _, blacklisted := range blacklistedAccounts {
+		accounts, _, err := ftKeeper.GetBlacklistedAccounts(ctx, blacklisted.Denom, nil)
+		requireT.NoError(err)
+		assertT.ElementsMatch(blacklisted.Accounts, accounts)
+	}
+
+	// rate exempt accounts
+	for _, rateExempt := range rateExemptAccounts {
+		accounts, _, err := ftKeeper.GetRateExemptAccounts(ctx, rateExempt.Denom, nil)
+		requireT.NoError(err)
+		assertT.ElementsMatch(rateExempt.Accounts, accounts)
+	}
+
+	// mint allowances
+	for _, allowance := range mintAllowances {
+		address, err := sdk.AccAddressFromBech32(allowance.Address)
+		requireT.NoError(err)
+		coins, _, err := ftKeeper.GetMintAllowances(ctx, address, nil)
+		requireT.NoError(err)
+		assertT.EqualValues(allowance.Coins.String(), coins.String())
+	}
+
 	// check that export is equal import
 	exportedGenState := ft.ExportGenesis(ctx, ftKeeper)
 
@@ -130,4 +240,70 @@ func TestInitAndExportGenesis(t *testing.T) {
 	assertT.ElementsMatch(genState.Tokens, exportedGenState.Tokens)
 	assertT.ElementsMatch(genState.FrozenBalances, exportedGenState.FrozenBalances)
 	assertT.ElementsMatch(genState.WhitelistedBalances, exportedGenState.WhitelistedBalances)
+	assertT.ElementsMatch(genState.TimedFreezes, exportedGenState.TimedFreezes)
+	assertT.ElementsMatch(genState.MintAllowances, exportedGenState.MintAllowances)
+	assertT.Len(exportedGenState.BlacklistedAccounts, len(genState.BlacklistedAccounts))
+	for i, blacklisted := range genState.BlacklistedAccounts {
+		assertT.Equal(blacklisted.Denom, exportedGenState.BlacklistedAccounts[i].Denom)
+		assertT.ElementsMatch(blacklisted.Accounts, exportedGenState.BlacklistedAccounts[i].Accounts)
+	}
+	assertT.Len(exportedGenState.RateExemptAccounts, len(genState.RateExemptAccounts))
+	for i, rateExempt := range genState.RateExemptAccounts {
+		assertT.Equal(rateExempt.Denom, exportedGenState.RateExemptAccounts[i].Denom)
+		assertT.ElementsMatch(rateExempt.Accounts, exportedGenState.RateExemptAccounts[i].Accounts)
+	}
+}
+
+func TestInitGenesisWithoutAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	oldDenom := types.BuildDenom("old", issuer)
+	clearedDenom := types.BuildDenom("cleared", issuer)
+
+	// the first token is exported before the admin role was introduced, the second one has the admin cleared
+	genStateJSON := fmt.Sprintf(`{
+		"params": {"issue_fee": {"denom": "stake", "amount": "0"}},
+		"tokens": [
+			{"denom": "%[1]s", "issuer": "%[3]s", "symbol": "OLD", "subunit": "old", "precision": 6, "features": ["freezing"]},
+			{"denom": "%[2]s", "issuer": "%[3]s", "symbol": "CLEARED", "subunit": "cleared", "precision": 6, "admin": ""}
+		]
+	}`, oldDenom, clearedDenom, issuer)
+
+	genState, err := ft.UnmarshalGenesisState(testApp.AppCodec(), []byte(genStateJSON))
+	requireT.NoError(err)
+	requireT.NoError(genState.Validate())
+
+	for _, token := range genState.Tokens {
+		requireT.NoError(ftKeeper.SetDenomMetadata(ctx, token.Denom, token.Symbol, token.Description, token.Precision))
+	}
+	ft.InitGenesis(ctx, ftKeeper, genState)
+
+	token, err := ftKeeper.GetToken(ctx, oldDenom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), token.Admin)
+
+	token, err = ftKeeper.GetToken(ctx, clearedDenom)
+	requireT.NoError(err)
+	requireT.Empty(token.Admin)
+
+	// the exported genesis is imported keeping the cleared admin
+	exportedGenState, err := ft.UnmarshalGenesisState(
+		testApp.AppCodec(),
+		testApp.AppCodec().MustMarshalJSON(ft.ExportGenesis(ctx, ftKeeper)),
+	)
+	requireT.NoError(err)
+	admins := map[string]string{}
+	for _, token := range exportedGenState.Tokens {
+		admins[token.Denom] = token.Admin
+	}
+	requireT.Equal(map[string]string{
+		oldDenom:     issuer.String(),
+		clearedDenom: "",
+	}, admins)
 }
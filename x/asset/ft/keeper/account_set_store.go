@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset"
+)
+
+// denomAccounts holds the accounts of the account set of the denom.
+type denomAccounts struct {
+	Denom    string
+	Accounts []string
+}
+
+func newAccountSetStore(
+	kvStore sdk.KVStore,
+	pref []byte,
+	name string,
+	createDenomKey func(denom string) ([]byte, error),
+	parseKey func(key []byte) (string, sdk.AccAddress, error),
+) accountSetStore {
+	return accountSetStore{
+		kvStore:        kvStore,
+		store:          prefix.NewStore(kvStore, pref),
+		name:           name,
+		createDenomKey: createDenomKey,
+		parseKey:       parseKey,
+	}
+}
+
+// accountSetStore is the unified store for getting the sets of accounts of the denoms, currently it is used by
+// blacklisting and rate exemptions.
+type accountSetStore struct {
+	kvStore        sdk.KVStore
+	store          prefix.Store
+	name           string
+	createDenomKey func(denom string) ([]byte, error)
+	parseKey       func(key []byte) (string, sdk.AccAddress, error)
+}
+
+func (s accountSetStore) Accounts(denom string, pagination *query.PageRequest) ([]string, *query.PageResponse, error) {
+	denomKey, err := s.createDenomKey(denom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accounts := []string{}
+	pageRes, err := query.Paginate(prefix.NewStore(s.kvStore, denomKey), pagination, func(key, value []byte) error {
+		if !bytes.Equal(value, asset.StoreTrue) {
+			return errors.Errorf("value stored in %s store is not %x, value %x", s.name, asset.StoreTrue, value)
+		}
+
+		account := sdk.AccAddress(key[1:]) // the first byte contains the length prefix
+		accounts = append(accounts, account.String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, pageRes, nil
+}
+
+func (s accountSetStore) AllAccounts(pagination *query.PageRequest) ([]denomAccounts, *query.PageResponse, error) {
+	var allAccounts []denomAccounts
+	mapDenomToIdx := make(map[string]int)
+	pageRes, err := query.Paginate(s.store, pagination, func(key, value []byte) error {
+		denom, addr, err := s.parseKey(key)
+		if err != nil {
+			return err
+		}
+
+		idx, ok := mapDenomToIdx[denom]
+		if !ok {
+			allAccounts = append(allAccounts, denomAccounts{Denom: denom})
+			idx = len(allAccounts) - 1
+			mapDenomToIdx[denom] = idx
+		}
+		allAccounts[idx].Accounts = append(allAccounts[idx].Accounts, addr.String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return allAccounts, pageRes, nil
+}
+
+func (s accountSetStore) IterateAllAccounts(cb func(string, sdk.AccAddress) bool) error {
+	iterator := s.store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom, addr, err := s.parseKey(iterator.Key())
+		if err != nil {
+			return err
+		}
+
+		if cb(denom, addr) {
+			break
+		}
+	}
+
+	return nil
+}
@@ -1,10 +1,17 @@
 package keeper
 
 import (
+	"encoding/json"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
 
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 )
 
 // BeforeSendCoins checks that a transfer request is allowed or not.
@@ -57,38 +64,82 @@ func (k Keeper) applyFeatures(ctx sdk.Context, inputs []banktypes.Input, outputs
 }
 
 func (k Keeper) applyRules(ctx sdk.Context, inputs, outputs groupedByDenomAccountOperations) error {
-	for denom, inOps := range inputs {
+	// the maps are iterated in the sorted order, so the bank operations, events and consumed gas are deterministic
+	for _, denom := range sortedKeys(inputs) {
+		inOps := inputs[denom]
 		def, err := k.GetDefinition(ctx, denom)
 		if types.ErrInvalidDenom.Is(err) || types.ErrTokenNotFound.Is(err) {
-			return nil
+			// the rules of the other denoms sent together with the non-asset ones must still be applied
+			continue
+		}
+		if err != nil {
+			return err
 		}
 
 		outOps := outputs[denom]
 
-		burnShares := CalculateRateShares(def.BurnRate, def.Issuer, inOps, outOps)
-		for account, amount := range burnShares {
-			if err := k.burnIfSpendable(ctx, sdk.MustAccAddressFromBech32(account), def, amount); err != nil {
+		for _, account := range sortedKeys(inOps) {
+			if err := k.checkNotBlacklisted(ctx, sdk.MustAccAddressFromBech32(account), def); err != nil {
+				return err
+			}
+		}
+		for _, account := range sortedKeys(outOps) {
+			if err := k.checkNotBlacklisted(ctx, sdk.MustAccAddressFromBech32(account), def); err != nil {
+				return err
+			}
+		}
+
+		exemptAccounts, err := k.rateExemptAccounts(ctx, def, inOps, outOps)
+		if err != nil {
+			return err
+		}
+
+		burnShares := CalculateRateShares(def.BurnRate, exemptAccounts, inOps, outOps)
+		for _, account := range sortedKeys(burnShares) {
+			if err := k.burnIfSpendable(ctx, sdk.MustAccAddressFromBech32(account), def, burnShares[account]); err != nil {
 				return err
 			}
 		}
 
-		commissionShares := CalculateRateShares(def.SendCommissionRate, def.Issuer, inOps, outOps)
-		issuer := sdk.MustAccAddressFromBech32(def.Issuer)
-		for account, amount := range commissionShares {
-			coins := sdk.NewCoins(sdk.NewCoin(def.Denom, amount))
-			if err := k.bankKeeper.SendCoins(ctx, sdk.MustAccAddressFromBech32(account), issuer, coins); err != nil {
+		// send commission is collected by the commission recipients or by the admin if the recipients are not set,
+		// so if there are neither recipients nor admin, the commission is not charged
+		if recipients := commissionRecipients(def); len(recipients) > 0 {
+			commissionShares := CalculateRateShares(def.SendCommissionRate, exemptAccounts, inOps, outOps)
+			distributedShares := DistributeRateShares(commissionShares, recipients)
+			for _, account := range sortedKeys(distributedShares) {
+				recipientShares := distributedShares[account]
+				for _, recipient := range recipients {
+					amount, ok := recipientShares[recipient.Address]
+					if !ok {
+						continue
+					}
+					coins := sdk.NewCoins(sdk.NewCoin(def.Denom, amount))
+					if err := k.bankKeeper.SendCoins(
+						ctx,
+						sdk.MustAccAddressFromBech32(account),
+						sdk.MustAccAddressFromBech32(recipient.Address),
+						coins,
+					); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		for _, account := range sortedKeys(inOps) {
+			if err := k.isCoinSpendable(ctx, sdk.MustAccAddressFromBech32(account), def, inOps[account]); err != nil {
 				return err
 			}
 		}
 
-		for account, amount := range inOps {
-			if err := k.isCoinSpendable(ctx, sdk.MustAccAddressFromBech32(account), def, amount); err != nil {
+		for _, account := range sortedKeys(outOps) {
+			if err := k.isCoinReceivable(ctx, sdk.MustAccAddressFromBech32(account), def, outOps[account]); err != nil {
 				return err
 			}
 		}
 
-		for account, amount := range outOps {
-			if err := k.isCoinReceivable(ctx, sdk.MustAccAddressFromBech32(account), def, amount); err != nil {
+		if def.IsFeatureEnabled(types.Feature_extension) {
+			if err := k.invokeExtension(ctx, def, inOps, outOps); err != nil {
 				return err
 			}
 		}
@@ -97,10 +148,88 @@ func (k Keeper) applyRules(ctx sdk.Context, inputs, outputs groupedByDenomAccoun
 	return nil
 }
 
-func nonIssuerSum(ops accountOperationMap, issuer string) sdk.Int {
+// invokeExtension calls the extension contract of the token for every transfer to let it reject the transfer.
+// Transfers from multiple senders can't be split into the separate transfers, so they are rejected.
+func (k Keeper) invokeExtension(ctx sdk.Context, def types.Definition, inOps, outOps accountOperationMap) error {
+	if len(inOps) != 1 {
+		return sdkerrors.Wrap(types.ErrExtensionRejected, "transfers from multiple senders are not supported")
+	}
+	var from string
+	for account := range inOps {
+		from = account
+	}
+	// the transfers sent by the extension contract itself are not checked to avoid the recursive calls
+	if from == def.ExtensionContract {
+		return nil
+	}
+
+	for _, to := range sortedKeys(outOps) {
+		if err := k.callExtension(ctx, def, from, to, outOps[to]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// callExtension calls the extension contract to check the transfer. The contract is executed with the gas limited
+// to types.ExtensionGasLimit and the consumed gas is charged to the transaction on top of the message gas.
+// The transfer is rejected if the contract returns an error or runs out of gas. The state changes done by the contract
+// are discarded in that case.
+func (k Keeper) callExtension(ctx sdk.Context, def types.Definition, from, to string, amount sdk.Int) (err error) {
+	msg, err := json.Marshal(types.ExtensionSudoMsg{
+		ExtensionTransfer: &types.ExtensionTransferMsg{
+			From:   from,
+			To:     to,
+			Amount: sdk.NewCoin(def.Denom, amount),
+		},
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	gasMeter := sdk.NewGasMeter(types.ExtensionGasLimit)
+	extensionCtx, write := ctx.CacheContext()
+	extensionCtx = extensionCtx.WithGasMeter(gasMeter)
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(types.ErrExtensionRejected, "extension contract ran out of gas, limit: %d", types.ExtensionGasLimit)
+		}
+		deterministicgastypes.TxGasMeter(ctx).ConsumeGas(gasMeter.GasConsumedToLimit(), "asset ft extension")
+	}()
+
+	if _, err := k.wasmKeeper.Sudo(extensionCtx, sdk.MustAccAddressFromBech32(def.ExtensionContract), msg); err != nil {
+		return sdkerrors.Wrapf(types.ErrExtensionRejected, "%s", err)
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(extensionCtx.EventManager().Events())
+	return nil
+}
+
+// sortedKeys returns the sorted keys of the map to iterate over it in the deterministic order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := lo.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
+
+func commissionRecipients(def types.Definition) []types.CommissionRecipient {
+	if len(def.CommissionRecipients) > 0 {
+		return def.CommissionRecipients
+	}
+	if def.HasAdmin() {
+		return []types.CommissionRecipient{{Address: def.Admin, Weight: 1}}
+	}
+	return nil
+}
+
+func nonExemptSum(ops accountOperationMap, exemptAccounts map[string]bool) sdk.Int {
 	sum := sdk.ZeroInt()
 	for account, amount := range ops {
-		if account != issuer {
+		if !exemptAccounts[account] {
 			sum = sum.Add(amount)
 		}
 	}
@@ -108,52 +237,104 @@ func nonIssuerSum(ops accountOperationMap, issuer string) sdk.Int {
 }
 
 // CalculateRateShares calculates how the burn or commission share amount should be split between different parties.
-func CalculateRateShares(rate sdk.Dec, issuer string, inOps, outOps accountOperationMap) map[string]sdk.Int {
-	// Since burning & send commission are not applied when sending to/from token issuer we can't simply apply original burn rate or send commission rate when bank multisend with issuer in inputs or outputs.
-	// To recalculate new adjusted amount we split whole "commission" between all non-issuer senders proportionally to amount they send.
+// The exempt accounts (the token admin and the accounts exempted by it) neither pay nor cause the rates.
+func CalculateRateShares(rate sdk.Dec, exemptAccounts map[string]bool, inOps, outOps accountOperationMap) map[string]sdk.Int {
+	// Since burning & send commission are not applied when sending to/from token admin we can't simply apply original burn rate or send commission rate when bank multisend with admin in inputs or outputs.
+	// To recalculate new adjusted amount we split whole "commission" between all non-admin senders proportionally to amount they send.
+	// The accounts exempted from the rates are handled exactly the same way as the admin.
 
 	// Examples
 	// burn_rate: 10%
 
 	// inputs:
 	// 75, 75
-	// 25 <-- issuer
+	// 25 <-- admin
 
 	// outputs:
 	// 50
-	// 100 <-- issuer
+	// 100 <-- admin
 	// 25
 
-	// In this case commissioned amount is: min(non_issuer_inputs, non_issuer_outputs) = min(75+75, 50+25) = 75
+	// In this case commissioned amount is: min(non_admin_inputs, non_admin_outputs) = min(75+75, 50+25) = 75
 	// Expected commission: 75 * 10% = 7.5
 	// And now we divide it proportionally between all input sender: 7.5 / 150 * 75 = 3.75
 	// As result each sender is expected to pay 3.75 of commission.
 	// Note that if we used original rate it would be 75 * 10% = 7.5
 	// Here is the final formula we use to calculate adjusted burn/commission amount for multisend txs:
-	// amount * rate * min(non_issuer_inputs_sum, non_issuer_outputs_sum) / non_issuer_inputs_sum
+	// amount * rate * min(non_admin_inputs_sum, non_admin_outputs_sum) / non_admin_inputs_sum
 	if rate.IsNil() || !rate.IsPositive() {
 		return nil
 	}
 
-	inputSumNonIssuer := nonIssuerSum(inOps, issuer)
-	outputSumNonIssuer := nonIssuerSum(outOps, issuer)
+	inputSumNonAdmin := nonExemptSum(inOps, exemptAccounts)
+	outputSumNonAdmin := nonExemptSum(outOps, exemptAccounts)
 
-	minNonIssuer := inputSumNonIssuer
-	if outputSumNonIssuer.LT(minNonIssuer) {
-		minNonIssuer = outputSumNonIssuer
+	minNonAdmin := inputSumNonAdmin
+	if outputSumNonAdmin.LT(minNonAdmin) {
+		minNonAdmin = outputSumNonAdmin
 	}
 
-	if !minNonIssuer.IsPositive() {
+	if !minNonAdmin.IsPositive() {
 		return nil
 	}
 
 	shares := make(accountOperationMap, 0)
 	for account, amount := range inOps {
-		if account != issuer {
+		if !exemptAccounts[account] {
 			// in order to reduce precision errors, we first multiply all sdk.Ints, and then multiply sdk.Decs, and then divide
-			finalShare := rate.MulInt(minNonIssuer.Mul(amount)).QuoInt(inputSumNonIssuer).Ceil().RoundInt()
+			finalShare := rate.MulInt(minNonAdmin.Mul(amount)).QuoInt(inputSumNonAdmin).Ceil().RoundInt()
 			shares[account] = finalShare
 		}
 	}
 	return shares
 }
+
+// DistributeRateShares splits the share of each payer between the recipients proportionally to their weights.
+// The split is exact, the amounts received from the payer always sum up to the share of that payer. The remainder
+// left after the integer division is given to the recipients with the largest fractional parts, and if they are
+// equal, to the recipients listed first. The recipients receiving nothing from the payer are omitted.
+func DistributeRateShares(shares map[string]sdk.Int, recipients []types.CommissionRecipient) map[string]map[string]sdk.Int {
+	totalWeight := sdk.ZeroInt()
+	for _, recipient := range recipients {
+		totalWeight = totalWeight.Add(sdk.NewIntFromUint64(uint64(recipient.Weight)))
+	}
+	if !totalWeight.IsPositive() {
+		return nil
+	}
+
+	distribution := make(map[string]map[string]sdk.Int, len(shares))
+	for account, share := range shares {
+		amounts := make([]sdk.Int, len(recipients))
+		remainders := make([]sdk.Int, len(recipients))
+		left := share
+		for i, recipient := range recipients {
+			weighted := share.Mul(sdk.NewIntFromUint64(uint64(recipient.Weight)))
+			amounts[i] = weighted.Quo(totalWeight)
+			remainders[i] = weighted.Mod(totalWeight)
+			left = left.Sub(amounts[i])
+		}
+
+		// the left amount is always lower than the number of recipients
+		order := make([]int, len(recipients))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return remainders[order[i]].GT(remainders[order[j]])
+		})
+		for i := 0; left.IsPositive(); i++ {
+			amounts[order[i]] = amounts[order[i]].AddRaw(1)
+			left = left.SubRaw(1)
+		}
+
+		recipientShares := make(map[string]sdk.Int, len(recipients))
+		for i, recipient := range recipients {
+			if amounts[i].IsPositive() {
+				recipientShares[recipient.Address] = amounts[i]
+			}
+		}
+		distribution[account] = recipientShares
+	}
+
+	return distribution
+}
@@ -8,10 +8,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/CoreumFoundation/coreum/pkg/config"
 	"github.com/CoreumFoundation/coreum/pkg/config/constant"
 	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
 func TestMain(m *testing.M) {
@@ -237,10 +239,136 @@ func TestCalculateRateShares(t *testing.T) {
 		name := fmt.Sprintf("%+v", tc)
 		t.Run(name, func(t *testing.T) {
 			assertT := assert.New(t)
-			shares := keeper.CalculateRateShares(sdk.MustNewDecFromStr(tc.rate), issuer, tc.senders, tc.receivers)
+			shares := keeper.CalculateRateShares(sdk.MustNewDecFromStr(tc.rate), map[string]bool{issuer: true}, tc.senders, tc.receivers)
 			for account, share := range shares {
 				assertT.EqualValues(tc.shares[account].String(), share.String())
 			}
 		})
 	}
 }
+
+func TestDistributeRateShares(t *testing.T) {
+	genAccount := func() string {
+		return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	}
+	var accounts []string
+	for i := 0; i < 3; i++ {
+		accounts = append(accounts, genAccount())
+	}
+	var recipients []string
+	for i := 0; i < 3; i++ {
+		recipients = append(recipients, genAccount())
+	}
+	pow10 := func(ex int64) sdk.Int {
+		return sdk.NewIntFromBigInt(big.NewInt(0).Exp(big.NewInt(10), big.NewInt(ex), nil))
+	}
+	testCases := []struct {
+		name         string
+		shares       map[string]sdk.Int
+		recipients   []types.CommissionRecipient
+		distribution map[string]map[string]sdk.Int
+	}{
+		{
+			name: "single recipient",
+			shares: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(25),
+			},
+			recipients: []types.CommissionRecipient{
+				{Address: recipients[0], Weight: 1},
+			},
+			distribution: map[string]map[string]sdk.Int{
+				accounts[0]: {recipients[0]: sdk.NewInt(25)},
+			},
+		},
+		{
+			name: "remainder to the largest fraction",
+			shares: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(25),
+			},
+			recipients: []types.CommissionRecipient{
+				{Address: recipients[0], Weight: 3},
+				{Address: recipients[1], Weight: 1},
+			},
+			distribution: map[string]map[string]sdk.Int{
+				accounts[0]: {recipients[0]: sdk.NewInt(19), recipients[1]: sdk.NewInt(6)},
+			},
+		},
+		{
+			name: "remainder to the first listed",
+			shares: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(10),
+			},
+			recipients: []types.CommissionRecipient{
+				{Address: recipients[0], Weight: 1},
+				{Address: recipients[1], Weight: 1},
+				{Address: recipients[2], Weight: 1},
+			},
+			distribution: map[string]map[string]sdk.Int{
+				accounts[0]: {recipients[0]: sdk.NewInt(4), recipients[1]: sdk.NewInt(3), recipients[2]: sdk.NewInt(3)},
+			},
+		},
+		{
+			name: "zero amounts are omitted",
+			shares: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(1),
+			},
+			recipients: []types.CommissionRecipient{
+				{Address: recipients[0], Weight: 1},
+				{Address: recipients[1], Weight: 1},
+			},
+			distribution: map[string]map[string]sdk.Int{
+				accounts[0]: {recipients[0]: sdk.NewInt(1)},
+			},
+		},
+		{
+			name: "multiple payers",
+			shares: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(7),
+				accounts[1]: sdk.NewInt(11),
+			},
+			recipients: []types.CommissionRecipient{
+				{Address: recipients[0], Weight: 2},
+				{Address: recipients[1], Weight: 5},
+			},
+			distribution: map[string]map[string]sdk.Int{
+				accounts[0]: {recipients[0]: sdk.NewInt(2), recipients[1]: sdk.NewInt(5)},
+				accounts[1]: {recipients[0]: sdk.NewInt(3), recipients[1]: sdk.NewInt(8)},
+			},
+		},
+		{
+			name: "big amounts",
+			shares: map[string]sdk.Int{
+				accounts[0]: pow10(30),
+			},
+			recipients: []types.CommissionRecipient{
+				{Address: recipients[0], Weight: 1},
+				{Address: recipients[1], Weight: 2},
+			},
+			distribution: map[string]map[string]sdk.Int{
+				accounts[0]: {
+					recipients[0]: pow10(30).QuoRaw(3),
+					recipients[1]: pow10(30).Sub(pow10(30).QuoRaw(3)),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			distribution := keeper.DistributeRateShares(tc.shares, tc.recipients)
+			requireT.Len(distribution, len(tc.distribution))
+			for account, recipientShares := range distribution {
+				expectedShares := tc.distribution[account]
+				requireT.Len(recipientShares, len(expectedShares))
+				sum := sdk.ZeroInt()
+				for recipient, amount := range recipientShares {
+					requireT.Equal(expectedShares[recipient].String(), amount.String())
+					sum = sum.Add(amount)
+				}
+				requireT.Equal(tc.shares[account].String(), sum.String())
+			}
+		})
+	}
+}
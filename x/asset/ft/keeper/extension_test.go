@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+type wasmKeeperMock struct {
+	contract  sdk.AccAddress
+	gas       sdk.Gas
+	rejected  map[string]bool
+	transfers []types.ExtensionTransferMsg
+}
+
+func (k *wasmKeeperMock) HasContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) bool {
+	return contractAddress.Equals(k.contract)
+}
+
+func (k *wasmKeeperMock) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	if !contractAddress.Equals(k.contract) {
+		return nil, errors.New("unknown contract")
+	}
+	ctx.GasMeter().ConsumeGas(k.gas, "contract")
+
+	var sudoMsg types.ExtensionSudoMsg
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	if k.rejected[sudoMsg.ExtensionTransfer.To] {
+		return nil, errors.New("recipient is not allowed")
+	}
+	k.transfers = append(k.transfers, *sudoMsg.ExtensionTransfer)
+	return nil, nil
+}
+
+func TestKeeper_Extension(t *testing.T) {
+	requireT := require.New(t)
+
+	genAccount := func() sdk.AccAddress {
+		return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	wasmKeeper := &wasmKeeperMock{
+		contract: genAccount(),
+		gas:      1000,
+		rejected: map[string]bool{},
+	}
+	ftKeeper := keeper.NewKeeper(
+		testApp.AppCodec(),
+		testApp.GetSubspace(types.ModuleName),
+		testApp.GetKey(types.StoreKey),
+		testApp.AccountKeeper,
+		testApp.BankKeeper.BaseKeeper,
+		wasmKeeper,
+	)
+	ftKeeper.SetParams(ctx, types.Params{IssueFee: sdk.NewInt64Coin(constant.DenomDev, 0)})
+
+	issuer := genAccount()
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_extension},
+	}
+
+	// issue without the extension contract
+	_, err := ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidAddress)
+
+	// issue with the extension contract which doesn't exist
+	settings.ExtensionContract = genAccount().String()
+	_, err = ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// issue with the extension contract but without the feature
+	settings.ExtensionContract = wasmKeeper.contract.String()
+	settings.Features = nil
+	_, err = ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	settings.Features = []types.Feature{types.Feature_extension}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(wasmKeeper.contract.String(), token.ExtensionContract)
+
+	recipient1 := genAccount()
+	recipient2 := genAccount()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+
+	// transfer is checked by the contract and the gas consumed by it is charged
+	gasBefore := ctx.GasMeter().GasConsumed()
+	requireT.NoError(ftKeeper.BeforeSendCoins(ctx, issuer, recipient1, coins))
+	requireT.GreaterOrEqual(ctx.GasMeter().GasConsumed(), gasBefore+wasmKeeper.gas)
+	requireT.Equal([]types.ExtensionTransferMsg{
+		{
+			From:   issuer.String(),
+			To:     recipient1.String(),
+			Amount: sdk.NewInt64Coin(denom, 10),
+		},
+	}, wasmKeeper.transfers)
+
+	// transfer is rejected by the contract
+	wasmKeeper.transfers = nil
+	wasmKeeper.rejected[recipient2.String()] = true
+	err = ftKeeper.BeforeSendCoins(ctx, issuer, recipient2, coins)
+	requireT.ErrorIs(err, types.ErrExtensionRejected)
+
+	// every output of the multi-send is checked, the other denoms don't prevent the check
+	wasmKeeper.transfers = nil
+	err = ftKeeper.BeforeInputOutputCoins(ctx,
+		[]banktypes.Input{{
+			Address: issuer.String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 2), sdk.NewInt64Coin(denom, 20)),
+		}},
+		[]banktypes.Output{
+			{Address: recipient1.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1), sdk.NewInt64Coin(denom, 10))},
+			{Address: recipient2.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1), sdk.NewInt64Coin(denom, 10))},
+		},
+	)
+	requireT.ErrorIs(err, types.ErrExtensionRejected)
+
+	// transfers from multiple senders are rejected
+	err = ftKeeper.BeforeInputOutputCoins(ctx,
+		[]banktypes.Input{
+			{Address: issuer.String(), Coins: coins},
+			{Address: recipient1.String(), Coins: coins},
+		},
+		[]banktypes.Output{{Address: recipient2.String(), Coins: coins.Add(coins...)}},
+	)
+	requireT.ErrorIs(err, types.ErrExtensionRejected)
+
+	// transfers sent by the contract are not checked
+	wasmKeeper.transfers = nil
+	requireT.NoError(ftKeeper.BeforeSendCoins(ctx, wasmKeeper.contract, recipient2, coins))
+	requireT.Empty(wasmKeeper.transfers)
+
+	// transfer is rejected if the contract runs out of gas, the consumed gas is charged
+	wasmKeeper.gas = types.ExtensionGasLimit + 1
+	gasBefore = ctx.GasMeter().GasConsumed()
+	err = ftKeeper.BeforeSendCoins(ctx, issuer, recipient1, coins)
+	requireT.ErrorIs(err, types.ErrExtensionRejected)
+	requireT.GreaterOrEqual(ctx.GasMeter().GasConsumed(), gasBefore+types.ExtensionGasLimit)
+}
@@ -19,8 +19,15 @@ type QueryKeeper interface {
 	GetToken(ctx sdk.Context, denom string) (types.Token, error)
 	GetFrozenBalances(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	GetFrozenBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetTimedFreezes(ctx sdk.Context, addr sdk.AccAddress, denom string) ([]types.TimedFreeze, error)
 	GetWhitelistedBalances(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	GetWhitelistedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	IsBlacklisted(ctx sdk.Context, addr sdk.AccAddress, denom string) (bool, error)
+	GetBlacklistedAccounts(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]string, *query.PageResponse, error)
+	GetMintAllowances(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	GetMintAllowance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	IsRateExempt(ctx sdk.Context, addr sdk.AccAddress, denom string) (bool, error)
+	GetRateExemptAccounts(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]string, *query.PageResponse, error)
 }
 
 // QueryService serves grpc query requests for assets module.
@@ -89,7 +96,7 @@ func (qs QueryService) FrozenBalances(goCtx context.Context, req *types.QueryFro
 	}, nil
 }
 
-// FrozenBalance lists frozen balance of a denom on a given account.
+// FrozenBalance lists frozen balance and not yet expired timed freezes of a denom on a given account.
 func (qs QueryService) FrozenBalance(goCtx context.Context, req *types.QueryFrozenBalanceRequest) (*types.QueryFrozenBalanceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	account, err := sdk.AccAddressFromBech32(req.Account)
@@ -97,9 +104,14 @@ func (qs QueryService) FrozenBalance(goCtx context.Context, req *types.QueryFroz
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
 	}
 	balance := qs.keeper.GetFrozenBalance(ctx, account, req.GetDenom())
+	timedFreezes, err := qs.keeper.GetTimedFreezes(ctx, account, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.QueryFrozenBalanceResponse{
-		Balance: balance,
+		Balance:      balance,
+		TimedFreezes: timedFreezes,
 	}, nil
 }
 
@@ -134,3 +146,97 @@ func (qs QueryService) WhitelistedBalance(goCtx context.Context, req *types.Quer
 		Balance: balance,
 	}, nil
 }
+
+// Blacklisted checks if the account is blacklisted for the denom.
+func (qs QueryService) Blacklisted(goCtx context.Context, req *types.QueryBlacklistedRequest) (*types.QueryBlacklistedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+	blacklisted, err := qs.keeper.IsBlacklisted(ctx, account, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlacklistedResponse{
+		Blacklisted: blacklisted,
+	}, nil
+}
+
+// BlacklistedAccounts lists blacklisted accounts of the denom.
+func (qs QueryService) BlacklistedAccounts(goCtx context.Context, req *types.QueryBlacklistedAccountsRequest) (*types.QueryBlacklistedAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accounts, pageRes, err := qs.keeper.GetBlacklistedAccounts(ctx, req.GetDenom(), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlacklistedAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// MintAllowances lists mint allowances of a given account.
+func (qs QueryService) MintAllowances(goCtx context.Context, req *types.QueryMintAllowancesRequest) (*types.QueryMintAllowancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+	allowances, pageRes, err := qs.keeper.GetMintAllowances(ctx, account, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMintAllowancesResponse{
+		Allowances: allowances,
+		Pagination: pageRes,
+	}, nil
+}
+
+// MintAllowance returns mint allowance of a denom on a given account.
+func (qs QueryService) MintAllowance(goCtx context.Context, req *types.QueryMintAllowanceRequest) (*types.QueryMintAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+	allowance := qs.keeper.GetMintAllowance(ctx, account, req.GetDenom())
+
+	return &types.QueryMintAllowanceResponse{
+		Allowance: allowance,
+	}, nil
+}
+
+// RateExempt checks if the account is exempted from the rates of the denom.
+func (qs QueryService) RateExempt(goCtx context.Context, req *types.QueryRateExemptRequest) (*types.QueryRateExemptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+	exempt, err := qs.keeper.IsRateExempt(ctx, account, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateExemptResponse{
+		Exempt: exempt,
+	}, nil
+}
+
+// RateExemptAccounts lists accounts exempted from the rates of the denom.
+func (qs QueryService) RateExemptAccounts(goCtx context.Context, req *types.QueryRateExemptAccountsRequest) (*types.QueryRateExemptAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accounts, pageRes, err := qs.keeper.GetRateExemptAccounts(ctx, req.GetDenom(), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateExemptAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
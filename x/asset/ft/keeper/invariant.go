@@ -13,6 +13,10 @@ const (
 	FreezingInvariantName = "freezing"
 	// WhitelistingInvariantName is whitelisted balances invariant name.
 	WhitelistingInvariantName = "whitelisting"
+	// BlacklistingInvariantName is blacklisted accounts invariant name.
+	BlacklistingInvariantName = "blacklisting"
+	// RateExemptionInvariantName is rate exempt accounts invariant name.
+	RateExemptionInvariantName = "rate-exemption"
 	// BankMetadataExistsInvariantName is bank metadata exist name.
 	BankMetadataExistsInvariantName = "bank-metadata-exist"
 )
@@ -21,6 +25,8 @@ const (
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, FreezingInvariantName, FreezingInvariant(k))
 	ir.RegisterRoute(types.ModuleName, WhitelistingInvariantName, WhitelistingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, BlacklistingInvariantName, BlacklistingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, RateExemptionInvariantName, RateExemptionInvariant(k))
 	ir.RegisterRoute(types.ModuleName, BankMetadataExistsInvariantName, BankMetadataExistInvariant(k))
 }
 
@@ -74,6 +80,87 @@ func WhitelistingInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// BlacklistingInvariant checks that all accounts are blacklisted only for the tokens with the blacklisting feature enabled.
+func BlacklistingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			count int
+			msg   string
+		)
+
+		definitions := make(map[string]types.Definition)
+		err := k.IterateAllBlacklistedAccounts(ctx, func(denom string, addr sdk.AccAddress) bool {
+			definition, ok := definitions[denom]
+			if !ok {
+				var err error
+				definition, err = k.GetDefinition(ctx, denom)
+				if err != nil {
+					count++
+					msg += fmt.Sprintf("	 definition for the %s denom not found\n", denom)
+					return false
+				}
+				definitions[denom] = definition
+			}
+
+			if !definition.IsFeatureEnabled(types.Feature_blacklisting) {
+				count++
+				msg += fmt.Sprintf("	 feature %s is disabled, but address %s is blacklisted for %s\n", types.Feature_blacklisting, addr, denom)
+			}
+			return false
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("can't iterate over blacklisted accounts %s\n", err)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, BlacklistingInvariantName,
+			fmt.Sprintf("amount of invalid blacklisted accounts found: %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// RateExemptionInvariant checks that all accounts are exempted only from the rates of the tokens having burn rate or send
+// commission rate.
+func RateExemptionInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			count int
+			msg   string
+		)
+
+		definitions := make(map[string]types.Definition)
+		err := k.IterateAllRateExemptAccounts(ctx, func(denom string, addr sdk.AccAddress) bool {
+			definition, ok := definitions[denom]
+			if !ok {
+				var err error
+				definition, err = k.GetDefinition(ctx, denom)
+				if err != nil {
+					count++
+					msg += fmt.Sprintf("	 definition for the %s denom not found\n", denom)
+					return false
+				}
+				definitions[denom] = definition
+			}
+
+			if !hasRates(definition) {
+				count++
+				msg += fmt.Sprintf("	 token %s has no rates, but address %s is exempted from them\n", denom, addr)
+			}
+			return false
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("can't iterate over rate exempt accounts %s\n", err)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, RateExemptionInvariantName,
+			fmt.Sprintf("amount of invalid rate exempt accounts found: %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
 // BankMetadataExistInvariant checks that all fungible tokens demons are in the bank as well.
 func BankMetadataExistInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
@@ -163,6 +163,115 @@ func TestWhitelistedBalancesInvariant(t *testing.T) {
 	requireT.False(isBroken)
 }
 
+func TestBlacklistingInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings1 := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_blacklisting,
+		},
+	}
+
+	denom1, err := ftKeeper.Issue(ctx, settings1)
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.AddToBlacklist(ctx, issuer, recipient, denom1))
+
+	// check that current state is valid
+	_, isBroken := keeper.BlacklistingInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	settings2 := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF2",
+		Subunit:       "def2",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(1000),
+		// the blacklisting disabled
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+	}
+
+	denom2, err := ftKeeper.Issue(ctx, settings2)
+	requireT.NoError(err)
+
+	// break blacklisted state and check
+	requireT.NoError(ftKeeper.SetBlacklisted(ctx, denom2, recipient, true))
+	_, isBroken = keeper.BlacklistingInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+
+	// make the state valid
+	requireT.NoError(ftKeeper.SetBlacklisted(ctx, denom2, recipient, false))
+	_, isBroken = keeper.BlacklistingInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+}
+
+func TestRateExemptionInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings1 := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(1000),
+		BurnRate:      sdk.MustNewDecFromStr("0.1"),
+	}
+
+	denom1, err := ftKeeper.Issue(ctx, settings1)
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.AddRateExemption(ctx, issuer, recipient, denom1))
+
+	// check that current state is valid
+	_, isBroken := keeper.RateExemptionInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	settings2 := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF2",
+		Subunit:       "def2",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(1000),
+		// no rates
+	}
+
+	denom2, err := ftKeeper.Issue(ctx, settings2)
+	requireT.NoError(err)
+
+	// break rate exemption state and check
+	requireT.NoError(ftKeeper.SetRateExempt(ctx, denom2, recipient, true))
+	_, isBroken = keeper.RateExemptionInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+
+	// make the state valid
+	requireT.NoError(ftKeeper.SetRateExempt(ctx, denom2, recipient, false))
+	_, isBroken = keeper.RateExemptionInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+}
+
 func TestBankMetadataExistInvariant(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
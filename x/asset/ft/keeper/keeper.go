@@ -3,12 +3,14 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -28,7 +30,9 @@ type Keeper struct {
 	cdc           codec.BinaryCodec
 	paramSubspace ParamSubspace
 	storeKey      sdk.StoreKey
+	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	wasmKeeper    types.WasmKeeper
 }
 
 // NewKeeper creates a new instance of the Keeper.
@@ -36,13 +40,17 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	paramSubspace ParamSubspace,
 	storeKey sdk.StoreKey,
+	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	wasmKeeper types.WasmKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:           cdc,
 		paramSubspace: paramSubspace,
 		storeKey:      storeKey,
+		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
+		wasmKeeper:    wasmKeeper,
 	}
 }
 
@@ -152,6 +160,22 @@ func (k Keeper) Issue(ctx sdk.Context, settings types.IssueSettings) (string, er
 		return "", sdkerrors.Wrapf(err, "provided symbol: %s", settings.Symbol)
 	}
 
+	maxSupply := settings.MaxSupply
+	if maxSupply.IsNil() {
+		maxSupply = sdk.ZeroInt()
+	}
+	if err := types.ValidateMaxSupply(maxSupply, settings.InitialAmount); err != nil {
+		return "", err
+	}
+
+	if err := types.ValidateExtensionContract(settings.Features, settings.ExtensionContract); err != nil {
+		return "", err
+	}
+	if settings.ExtensionContract != "" &&
+		!k.wasmKeeper.HasContractInfo(ctx, sdk.MustAccAddressFromBech32(settings.ExtensionContract)) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidInput, "extension contract %s does not exist", settings.ExtensionContract)
+	}
+
 	denom := types.BuildDenom(settings.Subunit, settings.Issuer)
 	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
 		return "", sdkerrors.Wrapf(
@@ -179,6 +203,11 @@ func (k Keeper) Issue(ctx sdk.Context, settings types.IssueSettings) (string, er
 		Features:           settings.Features,
 		BurnRate:           settings.BurnRate,
 		SendCommissionRate: settings.SendCommissionRate,
+		Admin:              settings.Issuer.String(),
+		URI:                settings.URI,
+		URIHash:            settings.URIHash,
+		MaxSupply:          maxSupply,
+		ExtensionContract:  settings.ExtensionContract,
 	}
 
 	if err := k.SetDenomMetadata(ctx, denom, settings.Symbol, settings.Description, settings.Precision); err != nil {
@@ -201,6 +230,10 @@ func (k Keeper) Issue(ctx sdk.Context, settings types.IssueSettings) (string, er
 		Features:           settings.Features,
 		BurnRate:           settings.BurnRate,
 		SendCommissionRate: settings.SendCommissionRate,
+		URI:                settings.URI,
+		URIHash:            settings.URIHash,
+		MaxSupply:          maxSupply,
+		ExtensionContract:  settings.ExtensionContract,
 	}); err != nil {
 		return "", sdkerrors.Wrap(err, "can't emit EventIssued event")
 	}
@@ -227,6 +260,8 @@ func (k Keeper) SetDefinition(ctx sdk.Context, issuer sdk.AccAddress, subunit st
 }
 
 // SetDenomMetadata registers denom metadata on the bank keeper.
+// The URI and URI hash of the token are kept in the definition only, since the bank metadata of the Cosmos SDK v0.45
+// doesn't have such fields.
 func (k Keeper) SetDenomMetadata(ctx sdk.Context, denom, symbol, description string, precision uint32) error {
 	denomMetadata := banktypes.Metadata{
 		Name:        symbol,
@@ -265,10 +300,27 @@ func (k Keeper) Mint(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) erro
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
+	var mintByAllowance bool
 	if err = def.CheckFeatureAllowed(sender, types.Feature_minting); err != nil {
+		// the account not allowed to mint by itself might still mint within its mint allowance,
+		// the allowances are granted by the admin, so they are not valid anymore once the admin is cleared
+		if !def.IsFeatureEnabled(types.Feature_minting) || def.Admin == "" ||
+			!k.GetMintAllowance(ctx, sender, coin.Denom).IsPositive() {
+			return err
+		}
+		mintByAllowance = true
+	}
+
+	if err := k.checkMaxSupply(ctx, def, coin.Amount); err != nil {
 		return err
 	}
 
+	if mintByAllowance {
+		if err := k.spendMintAllowance(ctx, sender, coin); err != nil {
+			return err
+		}
+	}
+
 	return k.mintIfReceivable(ctx, def, coin.Amount, sender)
 }
 
@@ -298,8 +350,8 @@ func (k Keeper) Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Co
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	if def.IsIssuer(addr) {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "issuer's balance can't be frozen")
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin's balance can't be frozen")
 	}
 
 	if err = def.CheckFeatureAllowed(sender, types.Feature_freezing); err != nil {
@@ -355,6 +407,46 @@ func (k Keeper) Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.
 	})
 }
 
+// TimedFreeze freezes specified token from the specified account until the unlock time.
+func (k Keeper) TimedFreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin, unlockTime time.Time) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "freeze amount should be positive")
+	}
+
+	if !unlockTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "unlock time %s must be after the block time %s", unlockTime, ctx.BlockTime())
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin's balance can't be frozen")
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_freezing); err != nil {
+		return err
+	}
+
+	timedFreeze, err := k.getTimedFreeze(ctx, addr, coin.Denom, unlockTime)
+	if err != nil {
+		return err
+	}
+	timedFreeze.Coin = timedFreeze.Coin.Add(coin)
+	if err := k.SetTimedFreeze(ctx, timedFreeze); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventTimedFreezeAdded{
+		Account:    addr.String(),
+		Denom:      coin.Denom,
+		Amount:     coin.Amount,
+		UnlockTime: unlockTime,
+	})
+}
+
 // GloballyFreeze enables global freeze on a fungible token. This function is idempotent.
 func (k Keeper) GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
 	def, err := k.GetDefinition(ctx, denom)
@@ -385,6 +477,427 @@ func (k Keeper) GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom s
 	return nil
 }
 
+// TransferAdmin changes the admin of a fungible token.
+func (k Keeper) TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can transfer the admin role")
+	}
+
+	previousAdmin := def.Admin
+	def.Admin = addr.String()
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAdminTransferred{
+		Denom:         denom,
+		PreviousAdmin: previousAdmin,
+		CurrentAdmin:  def.Admin,
+	})
+}
+
+// ClearAdmin removes the admin of a fungible token, so none of the privileged operations may be executed anymore.
+func (k Keeper) ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can clear the admin role")
+	}
+
+	previousAdmin := def.Admin
+	def.Admin = ""
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAdminCleared{
+		Denom:         denom,
+		PreviousAdmin: previousAdmin,
+	})
+}
+
+// Clawback returns the specified amount of fungible token from the account to the admin.
+// The freezing and whitelisting rules are not applied.
+func (k Keeper) Clawback(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "clawback amount should be positive")
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_clawback); err != nil {
+		return err
+	}
+
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin's balance can't be clawed back")
+	}
+
+	// the module accounts hold the balances they account for (e.g. escrowed or bonded tokens),
+	// so taking them would break the accounting of the module
+	if _, isModuleAccount := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI); isModuleAccount {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "module account's balance can't be clawed back")
+	}
+
+	// the original bank keeper is used, so the coins are moved without applying the token rules
+	if err := k.bankKeeper.SendCoins(ctx, addr, sender, sdk.NewCoins(coin)); err != nil {
+		return sdkerrors.Wrapf(err, "can't send coins from account %s to admin %s", addr.String(), sender.String())
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAmountClawedBack{
+		Account: addr.String(),
+		Denom:   coin.Denom,
+		Amount:  coin.Amount,
+	})
+}
+
+// UpdateMetadata updates the description, URI and URI hash of the fungible token.
+func (k Keeper) UpdateMetadata(ctx sdk.Context, sender sdk.AccAddress, denom, description, uri, uriHash string) error {
+	if err := types.ValidateMetadata(description, uri, uriHash); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_metadata_updating); err != nil {
+		return err
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTokenNotFound, "metadata for %s denom not found", denom)
+	}
+	metadata.Description = description
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	def.URI = uri
+	def.URIHash = uriHash
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMetadataUpdated{
+		Denom:       denom,
+		Description: description,
+		URI:         uri,
+		URIHash:     uriHash,
+	})
+}
+
+// AddToBlacklist blacklists the account, so it can neither send nor receive the token.
+func (k Keeper) AddToBlacklist(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin can't be blacklisted")
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_blacklisting); err != nil {
+		return err
+	}
+
+	if err := k.SetBlacklisted(ctx, denom, addr, true); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAddedToBlacklist{
+		Account: addr.String(),
+		Denom:   denom,
+	})
+}
+
+// RemoveFromBlacklist removes the account from the blacklist of the token.
+func (k Keeper) RemoveFromBlacklist(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_blacklisting); err != nil {
+		return err
+	}
+
+	if err := k.SetBlacklisted(ctx, denom, addr, false); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRemovedFromBlacklist{
+		Account: addr.String(),
+		Denom:   denom,
+	})
+}
+
+// IsBlacklisted checks if the account is blacklisted for the denom.
+func (k Keeper) IsBlacklisted(ctx sdk.Context, addr sdk.AccAddress, denom string) (bool, error) {
+	key, err := types.CreateBlacklistedAccountKey(denom, addr)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(ctx.KVStore(k.storeKey).Get(key), asset.StoreTrue), nil
+}
+
+// GetBlacklistedAccounts returns the blacklisted accounts of the denom.
+func (k Keeper) GetBlacklistedAccounts(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]string, *query.PageResponse, error) {
+	return k.blacklistedAccountsStore(ctx).Accounts(denom, pagination)
+}
+
+// GetAllBlacklistedAccounts returns the blacklisted accounts of all the denoms.
+func (k Keeper) GetAllBlacklistedAccounts(ctx sdk.Context, pagination *query.PageRequest) ([]types.BlacklistedAccounts, *query.PageResponse, error) {
+	allAccounts, pageRes, err := k.blacklistedAccountsStore(ctx).AllAccounts(pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blacklistedAccounts := make([]types.BlacklistedAccounts, 0, len(allAccounts))
+	for _, accounts := range allAccounts {
+		blacklistedAccounts = append(blacklistedAccounts, types.BlacklistedAccounts(accounts))
+	}
+	return blacklistedAccounts, pageRes, nil
+}
+
+// IterateAllBlacklistedAccounts iterates over all blacklisted accounts of all denoms and applies the provided callback.
+// If true is returned from the callback, iteration is stopped.
+func (k Keeper) IterateAllBlacklistedAccounts(ctx sdk.Context, cb func(string, sdk.AccAddress) bool) error {
+	return k.blacklistedAccountsStore(ctx).IterateAllAccounts(cb)
+}
+
+// SetBlacklisted adds the account to the blacklist of the denom if blacklisted is true and removes it otherwise.
+func (k Keeper) SetBlacklisted(ctx sdk.Context, denom string, addr sdk.AccAddress, blacklisted bool) error {
+	key, err := types.CreateBlacklistedAccountKey(denom, addr)
+	if err != nil {
+		return err
+	}
+
+	if blacklisted {
+		ctx.KVStore(k.storeKey).Set(key, asset.StoreTrue)
+	} else {
+		ctx.KVStore(k.storeKey).Delete(key)
+	}
+
+	return nil
+}
+
+// GrantMintAllowance sets the amount of the token the minter is allowed to mint.
+func (k Keeper) GrantMintAllowance(ctx sdk.Context, sender, minter sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "mint allowance amount should be positive")
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	if def.IsAdmin(minter) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "admin can't be granted a mint allowance")
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_minting); err != nil {
+		return err
+	}
+
+	return k.setMintAllowance(ctx, minter, coin)
+}
+
+// RevokeMintAllowance removes the mint allowance of the minter.
+func (k Keeper) RevokeMintAllowance(ctx sdk.Context, sender, minter sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_minting); err != nil {
+		return err
+	}
+
+	if !k.GetMintAllowance(ctx, minter, denom).IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "minter %s has no mint allowance for denom:%s", minter.String(), denom)
+	}
+
+	return k.setMintAllowance(ctx, minter, sdk.NewCoin(denom, sdk.ZeroInt()))
+}
+
+// GetAccountsMintAllowances returns the mint allowances of all the minters.
+func (k Keeper) GetAccountsMintAllowances(ctx sdk.Context, pagination *query.PageRequest) ([]types.Balance, *query.PageResponse, error) {
+	return collectBalances(k.cdc, prefix.NewStore(ctx.KVStore(k.storeKey), types.MintAllowancesKeyPrefix), pagination)
+}
+
+// IterateAccountsMintAllowances iterates over all mint allowances of all minters and applies the provided callback.
+// If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAccountsMintAllowances(ctx sdk.Context, cb func(sdk.AccAddress, sdk.Coin) bool) error {
+	return newBalanceStore(k.cdc, ctx.KVStore(k.storeKey), types.MintAllowancesKeyPrefix).IterateAllBalances(cb)
+}
+
+// GetMintAllowances returns the mint allowances of a minter.
+func (k Keeper) GetMintAllowances(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	return k.mintAllowanceStore(ctx, addr).Balances(pagination)
+}
+
+// GetMintAllowance returns the mint allowance of a denom and minter.
+func (k Keeper) GetMintAllowance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return k.mintAllowanceStore(ctx, addr).Balance(denom)
+}
+
+// SetMintAllowances sets the mint allowances of a specified minter.
+// Pay attention that the sdk.NewCoins() sanitizes/removes the empty coins, hence if you need set zero amount use the slice []sdk.Coins.
+func (k Keeper) SetMintAllowances(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	mintAllowanceStore := k.mintAllowanceStore(ctx, addr)
+	for _, coin := range coins {
+		mintAllowanceStore.SetBalance(coin)
+	}
+}
+
+// AddRateExemption exempts the account from the burn rate and send commission rate of the token.
+func (k Keeper) AddRateExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is unauthorized to manage rate exemptions", sender.String())
+	}
+
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "admin is always exempted from the rates")
+	}
+
+	if !hasRates(def) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "token %s has neither burn rate nor send commission rate", denom)
+	}
+
+	if err := k.SetRateExempt(ctx, denom, addr, true); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRateExemptionAdded{
+		Account: addr.String(),
+		Denom:   denom,
+	})
+}
+
+// RemoveRateExemption removes the burn rate and send commission rate exemption of the account.
+func (k Keeper) RemoveRateExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is unauthorized to manage rate exemptions", sender.String())
+	}
+
+	if err := k.SetRateExempt(ctx, denom, addr, false); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRateExemptionRemoved{
+		Account: addr.String(),
+		Denom:   denom,
+	})
+}
+
+// UpdateCommissionRecipients sets the accounts receiving the send commission of the token proportionally to
+// their weights. Empty list resets the recipient to the admin.
+func (k Keeper) UpdateCommissionRecipients(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom string,
+	recipients []types.CommissionRecipient,
+) error {
+	if err := types.ValidateCommissionRecipients(recipients); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is unauthorized to update commission recipients", sender.String())
+	}
+
+	def.CommissionRecipients = recipients
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventCommissionRecipientsUpdated{
+		Denom:      denom,
+		Recipients: recipients,
+	})
+}
+
+// IsRateExempt checks if the account is exempted from the rates of the denom.
+func (k Keeper) IsRateExempt(ctx sdk.Context, addr sdk.AccAddress, denom string) (bool, error) {
+	key, err := types.CreateRateExemptAccountKey(denom, addr)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(ctx.KVStore(k.storeKey).Get(key), asset.StoreTrue), nil
+}
+
+// GetRateExemptAccounts returns the accounts exempted from the rates of the denom.
+func (k Keeper) GetRateExemptAccounts(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]string, *query.PageResponse, error) {
+	return k.rateExemptAccountsStore(ctx).Accounts(denom, pagination)
+}
+
+// GetAllRateExemptAccounts returns the accounts exempted from the rates of all the denoms.
+func (k Keeper) GetAllRateExemptAccounts(ctx sdk.Context, pagination *query.PageRequest) ([]types.RateExemptAccounts, *query.PageResponse, error) {
+	allAccounts, pageRes, err := k.rateExemptAccountsStore(ctx).AllAccounts(pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rateExemptAccounts := make([]types.RateExemptAccounts, 0, len(allAccounts))
+	for _, accounts := range allAccounts {
+		rateExemptAccounts = append(rateExemptAccounts, types.RateExemptAccounts(accounts))
+	}
+	return rateExemptAccounts, pageRes, nil
+}
+
+// IterateAllRateExemptAccounts iterates over all accounts exempted from the rates of all denoms and applies the provided callback.
+// If true is returned from the callback, iteration is stopped.
+func (k Keeper) IterateAllRateExemptAccounts(ctx sdk.Context, cb func(string, sdk.AccAddress) bool) error {
+	return k.rateExemptAccountsStore(ctx).IterateAllAccounts(cb)
+}
+
+// SetRateExempt exempts the account from the rates of the denom if exempt is true and removes the exemption otherwise.
+func (k Keeper) SetRateExempt(ctx sdk.Context, denom string, addr sdk.AccAddress, exempt bool) error {
+	key, err := types.CreateRateExemptAccountKey(denom, addr)
+	if err != nil {
+		return err
+	}
+
+	if exempt {
+		ctx.KVStore(k.storeKey).Set(key, asset.StoreTrue)
+	} else {
+		ctx.KVStore(k.storeKey).Delete(key)
+	}
+
+	return nil
+}
+
 // GetAccountsFrozenBalances returns the frozen balance on all the account.
 func (k Keeper) GetAccountsFrozenBalances(ctx sdk.Context, pagination *query.PageRequest) ([]types.Balance, *query.PageResponse, error) {
 	return collectBalances(k.cdc, k.frozenBalancesStore(ctx), pagination)
@@ -415,6 +928,152 @@ func (k Keeper) SetFrozenBalances(ctx sdk.Context, addr sdk.AccAddress, coins sd
 	}
 }
 
+// GetTimedFreezes returns the not yet expired timed freezes of a denom and account.
+func (k Keeper) GetTimedFreezes(ctx sdk.Context, addr sdk.AccAddress, denom string) ([]types.TimedFreeze, error) {
+	key, err := types.CreateAccountTimedFreezesKey(addr, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	timedFreezes := make([]types.TimedFreeze, 0)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), key)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, _, unlockTime, err := types.ParseTimedFreezeKey(iterator.Key()[len(types.TimedFreezesKeyPrefix):])
+		if err != nil {
+			return nil, err
+		}
+		if !unlockTime.After(ctx.BlockTime()) {
+			continue
+		}
+
+		var coin sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &coin)
+		timedFreezes = append(timedFreezes, types.TimedFreeze{
+			Account:    addr.String(),
+			Coin:       coin,
+			UnlockTime: unlockTime,
+		})
+	}
+
+	return timedFreezes, nil
+}
+
+// GetTimedFrozenBalance returns the sum of the not yet expired timed freezes of a denom and account.
+func (k Keeper) GetTimedFrozenBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
+	timedFreezes, err := k.GetTimedFreezes(ctx, addr, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	balance := sdk.NewCoin(denom, sdk.ZeroInt())
+	for _, timedFreeze := range timedFreezes {
+		balance = balance.Add(timedFreeze.Coin)
+	}
+
+	return balance, nil
+}
+
+// IterateAllTimedFreezes iterates over all timed freezes of all accounts, including the expired but not yet released
+// ones, and applies the provided callback. If true is returned from the callback, iteration is stopped.
+func (k Keeper) IterateAllTimedFreezes(ctx sdk.Context, cb func(types.TimedFreeze) bool) error {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.TimedFreezesKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr, _, unlockTime, err := types.ParseTimedFreezeKey(iterator.Key())
+		if err != nil {
+			return err
+		}
+
+		var coin sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &coin)
+
+		if cb(types.TimedFreeze{
+			Account:    addr.String(),
+			Coin:       coin,
+			UnlockTime: unlockTime,
+		}) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// SetTimedFreeze sets the timed freeze of the account, the zero amount removes it.
+func (k Keeper) SetTimedFreeze(ctx sdk.Context, timedFreeze types.TimedFreeze) error {
+	addr, err := sdk.AccAddressFromBech32(timedFreeze.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", timedFreeze.Account)
+	}
+
+	key, err := types.CreateTimedFreezeKey(addr, timedFreeze.Coin.Denom, timedFreeze.UnlockTime)
+	if err != nil {
+		return err
+	}
+	queueKey, err := types.CreateTimedFreezeQueueKey(timedFreeze.UnlockTime, addr, timedFreeze.Coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if timedFreeze.Coin.Amount.IsZero() {
+		store.Delete(key)
+		store.Delete(queueKey)
+		return nil
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&timedFreeze.Coin))
+	store.Set(queueKey, asset.StoreTrue)
+
+	return nil
+}
+
+// ReleaseExpiredTimedFreezes removes all the timed freezes with the unlock time not after the block time.
+func (k Keeper) ReleaseExpiredTimedFreezes(ctx sdk.Context) error {
+	var expired []types.TimedFreeze
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.TimedFreezeQueueKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		unlockTime, addr, denom, err := types.ParseTimedFreezeQueueKey(iterator.Key())
+		if err != nil {
+			return err
+		}
+		// the queue is ordered by the unlock time, so all the next freezes are not expired as well
+		if unlockTime.After(ctx.BlockTime()) {
+			break
+		}
+
+		timedFreeze, err := k.getTimedFreeze(ctx, addr, denom, unlockTime)
+		if err != nil {
+			return err
+		}
+		expired = append(expired, timedFreeze)
+	}
+
+	for _, timedFreeze := range expired {
+		amount := timedFreeze.Coin.Amount
+		timedFreeze.Coin.Amount = sdk.ZeroInt()
+		if err := k.SetTimedFreeze(ctx, timedFreeze); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventTimedFreezeReleased{
+			Account:    timedFreeze.Account,
+			Denom:      timedFreeze.Coin.Denom,
+			Amount:     amount,
+			UnlockTime: timedFreeze.UnlockTime,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // SetGlobalFreeze enables/disables global freeze on a fungible token depending on frozen arg.
 func (k Keeper) SetGlobalFreeze(ctx sdk.Context, denom string, frozen bool) {
 	if frozen {
@@ -435,8 +1094,8 @@ func (k Keeper) SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddre
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	if def.IsIssuer(addr) {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "issuer's balance can't be whitelisted")
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin's balance can't be whitelisted")
 	}
 
 	if err = def.CheckFeatureAllowed(sender, types.Feature_whitelisting); err != nil {
@@ -485,6 +1144,58 @@ func (k Keeper) SetWhitelistedBalances(ctx sdk.Context, addr sdk.AccAddress, coi
 	}
 }
 
+func (k Keeper) setMintAllowance(ctx sdk.Context, minter sdk.AccAddress, coin sdk.Coin) error {
+	mintAllowanceStore := k.mintAllowanceStore(ctx, minter)
+	previousAllowance := mintAllowanceStore.Balance(coin.Denom)
+	mintAllowanceStore.SetBalance(coin)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMintAllowanceChanged{
+		Account:        minter.String(),
+		Denom:          coin.Denom,
+		PreviousAmount: previousAllowance.Amount,
+		CurrentAmount:  coin.Amount,
+	})
+}
+
+func (k Keeper) spendMintAllowance(ctx sdk.Context, minter sdk.AccAddress, coin sdk.Coin) error {
+	allowance := k.GetMintAllowance(ctx, minter, coin.Denom)
+	if !allowance.IsGTE(coin) {
+		return sdkerrors.Wrapf(types.ErrMintAllowanceExceeded,
+			"mint request %s is greater than the available mint allowance %s",
+			coin.String(),
+			allowance.String(),
+		)
+	}
+
+	return k.setMintAllowance(ctx, minter, allowance.Sub(coin))
+}
+
+func (k Keeper) checkMaxSupply(ctx sdk.Context, def types.Definition, amount sdk.Int) error {
+	if !def.IsMaxSupplySet() {
+		return nil
+	}
+
+	newSupply := k.bankKeeper.GetSupply(ctx, def.Denom).Amount.Add(amount)
+	if newSupply.GT(def.MaxSupply) {
+		return sdkerrors.Wrapf(types.ErrMaxSupplyExceeded,
+			"total supply %s%s would exceed the max supply %s%s",
+			newSupply.String(), def.Denom,
+			def.MaxSupply.String(), def.Denom,
+		)
+	}
+
+	return nil
+}
+
+func (k Keeper) updateDefinition(ctx sdk.Context, def types.Definition) error {
+	subunit, issuer, err := types.DeconstructDenom(def.Denom)
+	if err != nil {
+		return err
+	}
+	k.SetDefinition(ctx, issuer, subunit, def)
+	return nil
+}
+
 func (k Keeper) mintIfReceivable(ctx sdk.Context, def types.Definition, amount sdk.Int, recipient sdk.AccAddress) error {
 	if !amount.IsPositive() {
 		return nil
@@ -526,7 +1237,7 @@ func (k Keeper) burn(ctx sdk.Context, account sdk.AccAddress, coinsToBurn sdk.Co
 }
 
 func (k Keeper) isCoinSpendable(ctx sdk.Context, addr sdk.AccAddress, def types.Definition, amount sdk.Int) error {
-	if !def.IsFeatureEnabled(types.Feature_freezing) || def.IsIssuer(addr) {
+	if !def.IsFeatureEnabled(types.Feature_freezing) || def.IsAdmin(addr) {
 		return nil
 	}
 
@@ -534,7 +1245,10 @@ func (k Keeper) isCoinSpendable(ctx sdk.Context, addr sdk.AccAddress, def types.
 		return sdkerrors.Wrapf(types.ErrGloballyFrozen, "%s is globally frozen", def.Denom)
 	}
 
-	availableBalance := k.availableBalance(ctx, addr, def.Denom)
+	availableBalance, err := k.availableBalance(ctx, addr, def.Denom)
+	if err != nil {
+		return err
+	}
 	if !availableBalance.Amount.GTE(amount) {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is not available, available %s",
 			sdk.NewCoin(def.Denom, amount), availableBalance)
@@ -542,8 +1256,59 @@ func (k Keeper) isCoinSpendable(ctx sdk.Context, addr sdk.AccAddress, def types.
 	return nil
 }
 
+func (k Keeper) checkNotBlacklisted(ctx sdk.Context, addr sdk.AccAddress, def types.Definition) error {
+	if !def.IsFeatureEnabled(types.Feature_blacklisting) || def.IsAdmin(addr) {
+		return nil
+	}
+
+	blacklisted, err := k.IsBlacklisted(ctx, addr, def.Denom)
+	if err != nil {
+		return err
+	}
+	if blacklisted {
+		return sdkerrors.Wrapf(types.ErrBlacklisted, "%s is blacklisted for %s", addr, def.Denom)
+	}
+
+	return nil
+}
+
+// rateExemptAccounts returns the set of the accounts taking part in the operations which are exempted from
+// the burn rate and send commission rate. The admin is always exempted.
+func (k Keeper) rateExemptAccounts(ctx sdk.Context, def types.Definition, inOps, outOps accountOperationMap) (map[string]bool, error) {
+	exemptAccounts := make(map[string]bool)
+	if def.HasAdmin() {
+		exemptAccounts[def.Admin] = true
+	}
+
+	if !hasRates(def) {
+		return exemptAccounts, nil
+	}
+
+	for _, ops := range []accountOperationMap{inOps, outOps} {
+		for _, account := range sortedKeys(ops) {
+			if exemptAccounts[account] {
+				continue
+			}
+			exempt, err := k.IsRateExempt(ctx, sdk.MustAccAddressFromBech32(account), def.Denom)
+			if err != nil {
+				return nil, err
+			}
+			if exempt {
+				exemptAccounts[account] = true
+			}
+		}
+	}
+
+	return exemptAccounts, nil
+}
+
+func hasRates(def types.Definition) bool {
+	return (!def.BurnRate.IsNil() && def.BurnRate.IsPositive()) ||
+		(!def.SendCommissionRate.IsNil() && def.SendCommissionRate.IsPositive())
+}
+
 func (k Keeper) isCoinReceivable(ctx sdk.Context, addr sdk.AccAddress, def types.Definition, amount sdk.Int) error {
-	if !def.IsFeatureEnabled(types.Feature_whitelisting) || def.IsIssuer(addr) {
+	if !def.IsFeatureEnabled(types.Feature_whitelisting) || def.IsAdmin(addr) {
 		return nil
 	}
 
@@ -564,17 +1329,39 @@ func (k Keeper) isSymbolDuplicated(ctx sdk.Context, symbol string, issuer sdk.Ac
 	return rawBytes != nil
 }
 
-func (k Keeper) availableBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+func (k Keeper) availableBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
 	balance := k.bankKeeper.GetBalance(ctx, addr, denom)
 	if balance.IsZero() {
-		return balance
+		return balance, nil
 	}
 
-	frozenBalance := k.GetFrozenBalance(ctx, addr, denom)
+	timedFrozenBalance, err := k.GetTimedFrozenBalance(ctx, addr, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	frozenBalance := k.GetFrozenBalance(ctx, addr, denom).Add(timedFrozenBalance)
 	if frozenBalance.IsGTE(balance) {
-		return sdk.NewCoin(denom, sdk.ZeroInt())
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+	return balance.Sub(frozenBalance), nil
+}
+
+func (k Keeper) getTimedFreeze(ctx sdk.Context, addr sdk.AccAddress, denom string, unlockTime time.Time) (types.TimedFreeze, error) {
+	key, err := types.CreateTimedFreezeKey(addr, denom, unlockTime)
+	if err != nil {
+		return types.TimedFreeze{}, err
 	}
-	return balance.Sub(frozenBalance)
+
+	coin := sdk.NewCoin(denom, sdk.ZeroInt())
+	if bz := ctx.KVStore(k.storeKey).Get(key); bz != nil {
+		k.cdc.MustUnmarshal(bz, &coin)
+	}
+
+	return types.TimedFreeze{
+		Account:    addr.String(),
+		Coin:       coin,
+		UnlockTime: unlockTime,
+	}, nil
 }
 
 func (k Keeper) getDefinitions(ctx sdk.Context, pagination *query.PageRequest) ([]types.Definition, *query.PageResponse, error) {
@@ -609,16 +1396,22 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (
 	}
 
 	return types.Token{
-		Denom:              definition.Denom,
-		Issuer:             definition.Issuer,
-		Symbol:             metadata.Symbol,
-		Precision:          uint32(precision),
-		Subunit:            subunit,
-		Description:        metadata.Description,
-		Features:           definition.Features,
-		BurnRate:           definition.BurnRate,
-		SendCommissionRate: definition.SendCommissionRate,
-		GloballyFrozen:     k.isGloballyFrozen(ctx, definition.Denom),
+		Denom:                definition.Denom,
+		Issuer:               definition.Issuer,
+		Symbol:               metadata.Symbol,
+		Precision:            uint32(precision),
+		Subunit:              subunit,
+		Description:          metadata.Description,
+		Features:             definition.Features,
+		BurnRate:             definition.BurnRate,
+		SendCommissionRate:   definition.SendCommissionRate,
+		GloballyFrozen:       k.isGloballyFrozen(ctx, definition.Denom),
+		Admin:                definition.Admin,
+		URI:                  definition.URI,
+		URIHash:              definition.URIHash,
+		MaxSupply:            definition.MaxSupply,
+		CommissionRecipients: definition.CommissionRecipients,
+		ExtensionContract:    definition.ExtensionContract,
 	}, nil
 }
 
@@ -671,7 +1464,7 @@ func (k Keeper) frozenAccountBalanceStore(ctx sdk.Context, addr sdk.AccAddress)
 	return newBalanceStore(k.cdc, ctx.KVStore(k.storeKey), types.CreateFrozenBalancesKey(addr))
 }
 
-// frozenAccountBalanceStore gets the store for the frozen balances of an account.
+// frozenAccountsBalanceStore gets the store for the frozen balances of all accounts.
 func (k Keeper) frozenAccountsBalanceStore(ctx sdk.Context) balanceStore {
 	return newBalanceStore(k.cdc, ctx.KVStore(k.storeKey), types.FrozenBalancesKeyPrefix)
 }
@@ -685,6 +1478,33 @@ func (k Keeper) whitelistedAccountBalanceStore(ctx sdk.Context, addr sdk.AccAddr
 	return newBalanceStore(k.cdc, ctx.KVStore(k.storeKey), types.CreateWhitelistedBalancesKey(addr))
 }
 
+// mintAllowanceStore gets the store for the mint allowances of a minter.
+func (k Keeper) mintAllowanceStore(ctx sdk.Context, addr sdk.AccAddress) balanceStore {
+	return newBalanceStore(k.cdc, ctx.KVStore(k.storeKey), types.CreateMintAllowancesKey(addr))
+}
+
+// blacklistedAccountsStore gets the store for the blacklisted accounts of all denoms.
+func (k Keeper) blacklistedAccountsStore(ctx sdk.Context) accountSetStore {
+	return newAccountSetStore(
+		ctx.KVStore(k.storeKey),
+		types.BlacklistedAccountsKeyPrefix,
+		"blacklist",
+		types.CreateBlacklistedAccountsKey,
+		types.ParseBlacklistedAccountKey,
+	)
+}
+
+// rateExemptAccountsStore gets the store for the rate exempt accounts of all denoms.
+func (k Keeper) rateExemptAccountsStore(ctx sdk.Context) accountSetStore {
+	return newAccountSetStore(
+		ctx.KVStore(k.storeKey),
+		types.RateExemptAccountsKeyPrefix,
+		"rate exemption",
+		types.CreateRateExemptAccountsKey,
+		types.ParseRateExemptAccountKey,
+	)
+}
+
 // logger returns the Keeper logger.
 func (k Keeper) logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
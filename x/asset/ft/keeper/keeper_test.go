@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -11,6 +12,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -48,6 +50,9 @@ func TestKeeper_Issue(t *testing.T) {
 		Precision:     8,
 		InitialAmount: sdk.NewInt(777),
 		Features:      []types.Feature{types.Feature_freezing},
+		URI:           "https://my-token-meta.invalid/1",
+		URIHash:       "content-hash",
+		MaxSupply:     sdk.NewInt(1_000_000),
 	}
 
 	denom, err := ftKeeper.Issue(ctx, settings)
@@ -78,6 +83,10 @@ func TestKeeper_Issue(t *testing.T) {
 		Features:           []types.Feature{types.Feature_freezing},
 		BurnRate:           sdk.NewDec(0),
 		SendCommissionRate: sdk.NewDec(0),
+		Admin:              settings.Issuer.String(),
+		URI:                settings.URI,
+		URIHash:            settings.URIHash,
+		MaxSupply:          settings.MaxSupply,
 	}, gotToken)
 
 	// check the metadata
@@ -399,6 +408,185 @@ func TestKeeper_Mint(t *testing.T) {
 	requireT.EqualValues(sdk.NewInt(877), totalSupply.Supply.AmountOf(mintableDenom))
 }
 
+func TestKeeper_MaxSupply(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// try to issue token with initial amount greater than max supply
+	settings := types.IssueSettings{
+		Issuer:        addr,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1001),
+		MaxSupply:     sdk.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_minting,
+			types.Feature_burning,
+		},
+	}
+	_, err := ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	settings.InitialAmount = sdk.NewInt(700)
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(1000).String(), token.MaxSupply.String())
+
+	// mint up to the max supply
+	err = ftKeeper.Mint(ctx, addr, sdk.NewCoin(denom, sdk.NewInt(300)))
+	requireT.NoError(err)
+
+	// try to mint above the max supply
+	err = ftKeeper.Mint(ctx, addr, sdk.NewCoin(denom, sdk.NewInt(1)))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	// burn and mint again
+	err = ftKeeper.Burn(ctx, addr, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.NoError(err)
+	err = ftKeeper.Mint(ctx, addr, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(1000).String(), bankKeeper.GetSupply(ctx, denom).Amount.String())
+
+	// issue token without max supply
+	settings.Symbol = "UNLIMITED"
+	settings.Subunit = "unlimited"
+	settings.MaxSupply = sdk.Int{}
+	unlimitedDenom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err = ftKeeper.GetToken(ctx, unlimitedDenom)
+	requireT.NoError(err)
+	requireT.True(token.MaxSupply.IsZero())
+
+	err = ftKeeper.Mint(ctx, addr, sdk.NewCoin(unlimitedDenom, sdk.NewInt(1_000_000)))
+	requireT.NoError(err)
+}
+
+func TestKeeper_MintAllowance(t *testing.T) {
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	minter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// issue token without minting
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "NotMintable",
+		Subunit:       "notmintable",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(777),
+	}
+	unmintableDenom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	err = ftKeeper.GrantMintAllowance(ctx, issuer, minter, sdk.NewCoin(unmintableDenom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// issue mintable token
+	settings = types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(777),
+		MaxSupply:     sdk.NewInt(1100),
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// try to grant allowance by non-admin
+	err = ftKeeper.GrantMintAllowance(ctx, randomAddr, minter, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to grant allowance to admin
+	err = ftKeeper.GrantMintAllowance(ctx, issuer, issuer, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// grant allowance
+	err = ftKeeper.GrantMintAllowance(ctx, issuer, minter, sdk.NewCoin(denom, sdk.NewInt(300)))
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(300)).String(), ftKeeper.GetMintAllowance(ctx, minter, denom).String())
+
+	allowanceEvts, err := event.FindTypedEvents[*types.EventMintAllowanceChanged](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	assertT.Equal(&types.EventMintAllowanceChanged{
+		Account:        minter.String(),
+		Denom:          denom,
+		PreviousAmount: sdk.ZeroInt(),
+		CurrentAmount:  sdk.NewInt(300),
+	}, allowanceEvts[0])
+
+	// mint within allowance
+	err = ftKeeper.Mint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(200)))
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(200).String(), bankKeeper.GetBalance(ctx, minter, denom).Amount.String())
+	requireT.Equal(sdk.NewInt(100).String(), ftKeeper.GetMintAllowance(ctx, minter, denom).Amount.String())
+
+	// try to mint above the allowance
+	err = ftKeeper.Mint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(101)))
+	requireT.ErrorIs(err, types.ErrMintAllowanceExceeded)
+
+	// try to mint above the max supply
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.NoError(err)
+	err = ftKeeper.Mint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	// the account without allowance is still unauthorized
+	err = ftKeeper.Mint(ctx, randomAddr, sdk.NewCoin(denom, sdk.NewInt(1)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	allowances, _, err := ftKeeper.GetMintAllowances(ctx, minter, nil)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))).String(), allowances.String())
+
+	// revoke allowance
+	err = ftKeeper.RevokeMintAllowance(ctx, randomAddr, minter, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.RevokeMintAllowance(ctx, issuer, minter, denom)
+	requireT.NoError(err)
+	requireT.True(ftKeeper.GetMintAllowance(ctx, minter, denom).IsZero())
+
+	// try to revoke missing allowance
+	err = ftKeeper.RevokeMintAllowance(ctx, issuer, minter, denom)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	err = ftKeeper.Mint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(1)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// grant allowance and clear the admin
+	err = ftKeeper.GrantMintAllowance(ctx, issuer, minter, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, issuer, denom))
+
+	// the allowance granted by the cleared admin can't be used anymore
+	err = ftKeeper.Mint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(1)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	requireT.Equal(sdk.NewInt(10).String(), ftKeeper.GetMintAllowance(ctx, minter, denom).Amount.String())
+}
+
 func TestKeeper_Burn(t *testing.T) {
 	requireT := require.New(t)
 
@@ -737,6 +925,59 @@ func TestKeeper_BurnRate_BankMultiSend(t *testing.T) {
 }
 
 //nolint:dupl // We don't care
+func TestKeeper_Rates_BankMultiSendIsDeterministic(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	assetKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := assetKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(10000),
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+
+	var inputs []banktypes.Input
+	var outputs []banktypes.Output
+	for i := 0; i < 10; i++ {
+		sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		requireT.NoError(bankKeeper.SendCoins(ctx, issuer, sender, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+		inputs = append(inputs, banktypes.Input{
+			Address: sender.String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(denom, 100)),
+		})
+		outputs = append(outputs, banktypes.Output{
+			Address: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(denom, 100)),
+		})
+	}
+
+	// the multi-send is executed many times to detect the random order of the map iteration
+	var expectedEvents sdk.Events
+	var expectedGas sdk.Gas
+	for i := 0; i < 20; i++ {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager()).WithGasMeter(sdk.NewInfiniteGasMeter())
+		requireT.NoError(bankKeeper.InputOutputCoins(cacheCtx, inputs, outputs))
+		if i == 0 {
+			expectedEvents = cacheCtx.EventManager().Events()
+			expectedGas = cacheCtx.GasMeter().GasConsumed()
+			continue
+		}
+		requireT.Equal(expectedEvents, cacheCtx.EventManager().Events())
+		requireT.Equal(expectedGas, cacheCtx.GasMeter().GasConsumed())
+	}
+}
+
 func TestKeeper_SendCommissionRate_BankSend(t *testing.T) {
 	requireT := require.New(t)
 
@@ -881,6 +1122,206 @@ func TestKeeper_BurnRateAndSendCommissionRate_BankSend(t *testing.T) {
 	})
 }
 
+func TestKeeper_RateExemption(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	assetKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	exempt := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// issue token without rates
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(600),
+	}
+	noRatesDenom, err := assetKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	err = assetKeeper.AddRateExemption(ctx, issuer, exempt, noRatesDenom)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// issue token with rates
+	settings = types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(600),
+		BurnRate:           sdk.MustNewDecFromStr("0.5"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.25"),
+	}
+	denom, err := assetKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	err = bankKeeper.SendCoins(ctx, issuer, exempt, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500))))
+	requireT.NoError(err)
+
+	// try to add exemption by non-admin
+	err = assetKeeper.AddRateExemption(ctx, exempt, exempt, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to exempt admin
+	err = assetKeeper.AddRateExemption(ctx, issuer, issuer, denom)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// exempt the account
+	requireT.NoError(assetKeeper.AddRateExemption(ctx, issuer, exempt, denom))
+	isExempt, err := assetKeeper.IsRateExempt(ctx, exempt, denom)
+	requireT.NoError(err)
+	requireT.True(isExempt)
+
+	accounts, _, err := assetKeeper.GetRateExemptAccounts(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Equal([]string{exempt.String()}, accounts)
+
+	// send from the exempt account (rates must not apply)
+	err = bankKeeper.SendCoins(ctx, exempt, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(err)
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    100,
+		&exempt:    400,
+		&recipient: 100,
+	})
+
+	// send to the exempt account (rates must not apply)
+	err = bankKeeper.SendCoins(ctx, recipient, exempt, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(50))))
+	requireT.NoError(err)
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    100,
+		&exempt:    450,
+		&recipient: 50,
+	})
+
+	// remove the exemption
+	err = assetKeeper.RemoveRateExemption(ctx, exempt, exempt, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	requireT.NoError(assetKeeper.RemoveRateExemption(ctx, issuer, exempt, denom))
+	isExempt, err = assetKeeper.IsRateExempt(ctx, exempt, denom)
+	requireT.NoError(err)
+	requireT.False(isExempt)
+
+	// send from the account (rates must apply)
+	err = bankKeeper.SendCoins(ctx, exempt, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(err)
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    125,
+		&exempt:    275,
+		&recipient: 150,
+	})
+}
+
+//nolint:funlen // this is complex test scenario and breaking it down is not helpful
+func TestKeeper_CommissionRecipients(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	assetKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	treasury := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	pool := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.25"),
+	}
+	denom, err := assetKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	err = bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(400))))
+	requireT.NoError(err)
+
+	recipients := []types.CommissionRecipient{
+		{Address: treasury.String(), Weight: 3},
+		{Address: pool.String(), Weight: 1},
+	}
+
+	// try to update the recipients by non-admin
+	err = assetKeeper.UpdateCommissionRecipients(ctx, holder, denom, recipients)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to update the recipients with invalid weight
+	err = assetKeeper.UpdateCommissionRecipients(ctx, issuer, denom, []types.CommissionRecipient{
+		{Address: treasury.String()},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// update the recipients
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(assetKeeper.UpdateCommissionRecipients(ctx, issuer, denom, recipients))
+	updatedEvts, err := event.FindTypedEvents[*types.EventCommissionRecipientsUpdated](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventCommissionRecipientsUpdated{
+		Denom:      denom,
+		Recipients: recipients,
+	}, updatedEvts[0])
+
+	token, err := assetKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(recipients, token.CommissionRecipients)
+
+	// send, the commission of 25 is split 3:1
+	err = bankKeeper.SendCoins(ctx, holder, receiver, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(err)
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:   600,
+		&holder:   275,
+		&receiver: 100,
+		&treasury: 19,
+		&pool:     6,
+	})
+
+	// multi-send, the commission of 38 is split 3:1
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{
+			{Address: holder.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(200)))},
+		},
+		[]banktypes.Output{
+			{Address: receiver.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(150)))},
+			{Address: issuer.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(50)))},
+		})
+	requireT.NoError(err)
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:   650,
+		&holder:   37,
+		&receiver: 250,
+		&treasury: 48,
+		&pool:     15,
+	})
+
+	// reset the recipients, the commission is sent to the admin
+	requireT.NoError(assetKeeper.UpdateCommissionRecipients(ctx, issuer, denom, nil))
+	err = bankKeeper.SendCoins(ctx, holder, receiver, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(20))))
+	requireT.NoError(err)
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:   655,
+		&holder:   12,
+		&receiver: 270,
+		&treasury: 48,
+		&pool:     15,
+	})
+}
+
 //nolint:funlen // this is complex test scenario and breaking it down is not helpful
 func TestKeeper_FreezeUnfreeze(t *testing.T) {
 	requireT := require.New(t)
@@ -1049,24 +1490,261 @@ func TestKeeper_FreezeUnfreeze(t *testing.T) {
 }
 
 //nolint:funlen // this is complex tests scenario and breaking it down is not beneficial
-func TestKeeper_GlobalFreezeUnfreeze(t *testing.T) {
+//nolint:funlen // this is complex test scenario and breaking it down is not beneficial
+func TestKeeper_TimedFreeze(t *testing.T) {
 	requireT := require.New(t)
 	assertT := assert.New(t)
 
 	testApp := simapp.New()
-	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
 
 	ftKeeper := testApp.AssetFTKeeper
 	bankKeeper := testApp.BankKeeper
 
 	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
-	freezableSettings := types.IssueSettings{
+	settings := types.IssueSettings{
 		Issuer:        issuer,
-		Symbol:        "FREEZE",
-		Subunit:       "freeze",
-		Precision:     6,
-		Description:   "FREEZE Desc",
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(666),
+		Features:      []types.Feature{types.Feature_freezing},
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(err)
+
+	firstUnlockTime := blockTime.Add(time.Hour)
+	secondUnlockTime := blockTime.Add(2 * time.Hour)
+
+	// try to freeze with the unlock time in the past
+	err = ftKeeper.TimedFreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(10)), blockTime)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to freeze from non issuer address
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = ftKeeper.TimedFreeze(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdk.NewInt(10)), firstUnlockTime)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to freeze admin balance
+	err = ftKeeper.TimedFreeze(ctx, issuer, issuer, sdk.NewCoin(denom, sdk.NewInt(10)), firstUnlockTime)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// freeze permanently and with time locks
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(10))))
+	requireT.NoError(ftKeeper.TimedFreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(20)), firstUnlockTime))
+	requireT.NoError(ftKeeper.TimedFreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(10)), firstUnlockTime))
+	requireT.NoError(ftKeeper.TimedFreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(40)), secondUnlockTime))
+
+	addedEvts, err := event.FindTypedEvents[*types.EventTimedFreezeAdded](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(addedEvts, 3)
+	assertT.EqualValues(&types.EventTimedFreezeAdded{
+		Account:    recipient.String(),
+		Denom:      denom,
+		Amount:     sdk.NewInt(40),
+		UnlockTime: secondUnlockTime,
+	}, addedEvts[2])
+
+	// permanently frozen balance is not affected
+	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(10)).String(), ftKeeper.GetFrozenBalance(ctx, recipient, denom).String())
+	timedFreezes, err := ftKeeper.GetTimedFreezes(ctx, recipient, denom)
+	requireT.NoError(err)
+	assertT.EqualValues([]types.TimedFreeze{
+		{Account: recipient.String(), Coin: sdk.NewCoin(denom, sdk.NewInt(30)), UnlockTime: firstUnlockTime},
+		{Account: recipient.String(), Coin: sdk.NewCoin(denom, sdk.NewInt(40)), UnlockTime: secondUnlockTime},
+	}, timedFreezes)
+
+	// try to send more than available
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(21))))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(20)))))
+
+	// the first lock is expired, but not released yet, so it must not be taken into account
+	ctx = ctx.WithBlockTime(firstUnlockTime)
+	timedFreezes, err = ftKeeper.GetTimedFreezes(ctx, recipient, denom)
+	requireT.NoError(err)
+	assertT.EqualValues([]types.TimedFreeze{
+		{Account: recipient.String(), Coin: sdk.NewCoin(denom, sdk.NewInt(40)), UnlockTime: secondUnlockTime},
+	}, timedFreezes)
+	err = bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(31))))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10)))))
+
+	// release the expired locks
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.ReleaseExpiredTimedFreezes(ctx))
+	releasedEvts, err := event.FindTypedEvents[*types.EventTimedFreezeReleased](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	assertT.EqualValues([]*types.EventTimedFreezeReleased{{
+		Account:    recipient.String(),
+		Denom:      denom,
+		Amount:     sdk.NewInt(30),
+		UnlockTime: firstUnlockTime,
+	}}, releasedEvts)
+
+	var storedTimedFreezes []types.TimedFreeze
+	requireT.NoError(ftKeeper.IterateAllTimedFreezes(ctx, func(timedFreeze types.TimedFreeze) bool {
+		storedTimedFreezes = append(storedTimedFreezes, timedFreeze)
+		return false
+	}))
+	assertT.EqualValues([]types.TimedFreeze{
+		{Account: recipient.String(), Coin: sdk.NewCoin(denom, sdk.NewInt(40)), UnlockTime: secondUnlockTime},
+	}, storedTimedFreezes)
+
+	// releasing again does nothing
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.ReleaseExpiredTimedFreezes(ctx))
+	assertT.Empty(ctx.EventManager().ABCIEvents())
+
+	// after the second unlock time only permanently frozen balance is locked
+	ctx = ctx.WithBlockTime(secondUnlockTime.Add(time.Second))
+	requireT.NoError(ftKeeper.ReleaseExpiredTimedFreezes(ctx))
+	timedFreezes, err = ftKeeper.GetTimedFreezes(ctx, recipient, denom)
+	requireT.NoError(err)
+	assertT.Empty(timedFreezes)
+	err = bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(61))))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(60)))))
+}
+
+//nolint:funlen // this is complex test scenario and breaking it down is not beneficial
+func TestKeeper_Blacklist(t *testing.T) {
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(666),
+		Features:      []types.Feature{types.Feature_blacklisting},
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	unblacklistableSettings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		Description:   "ABC Desc",
+		InitialAmount: sdk.NewInt(666),
+		Features:      []types.Feature{},
+	}
+
+	unblacklistableDenom, err := ftKeeper.Issue(ctx, unblacklistableSettings)
+	requireT.NoError(err)
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(
+		sdk.NewCoin(denom, sdk.NewInt(100)),
+		sdk.NewCoin(unblacklistableDenom, sdk.NewInt(100)),
+	))
+	requireT.NoError(err)
+
+	// try to blacklist for the token without the feature
+	err = ftKeeper.AddToBlacklist(ctx, issuer, recipient, unblacklistableDenom)
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to blacklist from non issuer address
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = ftKeeper.AddToBlacklist(ctx, randomAddr, recipient, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to blacklist the admin
+	err = ftKeeper.AddToBlacklist(ctx, issuer, issuer, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// blacklist the accounts
+	requireT.NoError(ftKeeper.AddToBlacklist(ctx, issuer, recipient, denom))
+	requireT.NoError(ftKeeper.AddToBlacklist(ctx, issuer, recipient2, denom))
+
+	evts, err := event.FindTypedEvents[*types.EventAddedToBlacklist](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	assertT.EqualValues([]*types.EventAddedToBlacklist{
+		{Account: recipient.String(), Denom: denom},
+		{Account: recipient2.String(), Denom: denom},
+	}, evts)
+
+	blacklisted, err := ftKeeper.IsBlacklisted(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.True(blacklisted)
+	blacklisted, err = ftKeeper.IsBlacklisted(ctx, recipient, unblacklistableDenom)
+	requireT.NoError(err)
+	requireT.False(blacklisted)
+
+	accounts, pageRes, err := ftKeeper.GetBlacklistedAccounts(ctx, denom, &query.PageRequest{CountTotal: true})
+	requireT.NoError(err)
+	assertT.EqualValues(2, pageRes.GetTotal())
+	assertT.ElementsMatch([]string{recipient.String(), recipient2.String()}, accounts)
+
+	// the blacklisted account can neither send nor receive
+	err = bankKeeper.SendCoins(ctx, recipient, issuer, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10))))
+	requireT.ErrorIs(err, types.ErrBlacklisted)
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10))))
+	requireT.ErrorIs(err, types.ErrBlacklisted)
+	coinsToSend := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10)))
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: issuer.String(), Coins: coinsToSend}},
+		[]banktypes.Output{{Address: recipient2.String(), Coins: coinsToSend}})
+	requireT.ErrorIs(err, types.ErrBlacklisted)
+
+	// other tokens are not affected
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient, issuer, sdk.NewCoins(sdk.NewCoin(unblacklistableDenom, sdk.NewInt(10)))))
+
+	// remove from the blacklist
+	requireT.NoError(ftKeeper.RemoveFromBlacklist(ctx, issuer, recipient, denom))
+	blacklisted, err = ftKeeper.IsBlacklisted(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.False(blacklisted)
+	removedEvts, err := event.FindTypedEvents[*types.EventRemovedFromBlacklist](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	assertT.EqualValues([]*types.EventRemovedFromBlacklist{{Account: recipient.String(), Denom: denom}}, removedEvts)
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient, issuer, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10)))))
+	err = bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10))))
+	requireT.ErrorIs(err, types.ErrBlacklisted)
+}
+
+func TestKeeper_GlobalFreezeUnfreeze(t *testing.T) {
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	freezableSettings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "FREEZE",
+		Subunit:       "freeze",
+		Precision:     6,
+		Description:   "FREEZE Desc",
 		InitialAmount: sdk.NewInt(777),
 		Features:      []types.Feature{types.Feature_freezing},
 	}
@@ -1396,6 +2074,51 @@ func TestKeeper_FreezeWhitelistMultiSend(t *testing.T) {
 
 // TestKeeper_AllInOne tests send and multi send with tokens that have all features enabled
 // and applied.
+func TestKeeper_FreezeSendWithNonAssetDenoms(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(666),
+		Features:      []types.Feature{types.Feature_freezing},
+	})
+	requireT.NoError(err)
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))
+	// the rules are applied in random order of denoms, so several non-asset denoms make it very likely that one of
+	// them is processed before the asset one
+	for _, nonAssetDenom := range []string{"ucore", "uatom", "uosmo", "ujuno", "uaxl"} {
+		nonAssetCoin := sdk.NewCoin(nonAssetDenom, sdk.NewInt(100))
+		requireT.NoError(testApp.FundAccount(ctx, sender, sdk.NewCoins(nonAssetCoin)))
+		coins = coins.Add(nonAssetCoin)
+	}
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, sender, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))))
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, sender, sdk.NewCoin(denom, sdk.NewInt(100))))
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	for i := 0; i < 10; i++ {
+		err := bankKeeper.SendCoins(ctx, sender, recipient, coins)
+		requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+		err = bankKeeper.InputOutputCoins(ctx,
+			[]banktypes.Input{{Address: sender.String(), Coins: coins}},
+			[]banktypes.Output{{Address: recipient.String(), Coins: coins}},
+		)
+		requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	}
+}
+
 func TestKeeper_AllInOne(t *testing.T) {
 	requireT := require.New(t)
 
@@ -1533,3 +2256,302 @@ func newBankAsserter(
 		ctx: ctx,
 	}
 }
+
+//nolint:funlen // this is complex test scenario and breaking it down is not helpful
+func TestKeeper_TransferAdmin(t *testing.T) {
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "ADMIN",
+		Subunit:            "admin",
+		Precision:          6,
+		Description:        "ADMIN Desc",
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.Feature{types.Feature_minting, types.Feature_freezing},
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), token.Admin)
+
+	// try to transfer admin from non-admin account
+	err = ftKeeper.TransferAdmin(ctx, recipient, admin, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// transfer admin
+	err = ftKeeper.TransferAdmin(ctx, issuer, admin, denom)
+	requireT.NoError(err)
+
+	adminTransferredEvts, err := event.FindTypedEvents[*types.EventAdminTransferred](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(adminTransferredEvts, 1)
+	assertT.EqualValues(&types.EventAdminTransferred{
+		Denom:         denom,
+		PreviousAdmin: issuer.String(),
+		CurrentAdmin:  admin.String(),
+	}, adminTransferredEvts[0])
+
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(admin.String(), token.Admin)
+	requireT.Equal(issuer.String(), token.Issuer)
+
+	// issuer is not able to use privileged operations anymore
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.TransferAdmin(ctx, issuer, issuer, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// new admin is able to use privileged operations
+	err = ftKeeper.Mint(ctx, admin, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(10).String(), bankKeeper.GetBalance(ctx, admin, denom).Amount.String())
+
+	// issuer balance might be frozen now
+	err = ftKeeper.Freeze(ctx, admin, issuer, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.NoError(err)
+	// but admin balance can't be frozen
+	err = ftKeeper.Freeze(ctx, admin, admin, sdk.NewCoin(denom, sdk.NewInt(1)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// send commission goes to the admin
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(20).String(), bankKeeper.GetBalance(ctx, admin, denom).Amount.String())
+	requireT.Equal(sdk.NewInt(890).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+}
+
+func TestKeeper_ClearAdmin(t *testing.T) {
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "ADMIN",
+		Subunit:            "admin",
+		Precision:          6,
+		Description:        "ADMIN Desc",
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.Feature{types.Feature_minting},
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// try to clear admin from non-admin account
+	err = ftKeeper.ClearAdmin(ctx, recipient, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// clear admin
+	err = ftKeeper.ClearAdmin(ctx, issuer, denom)
+	requireT.NoError(err)
+
+	adminClearedEvts, err := event.FindTypedEvents[*types.EventAdminCleared](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(adminClearedEvts, 1)
+	assertT.EqualValues(&types.EventAdminCleared{
+		Denom:         denom,
+		PreviousAdmin: issuer.String(),
+	}, adminClearedEvts[0])
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Empty(token.Admin)
+
+	// nobody is able to use privileged operations anymore
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.TransferAdmin(ctx, issuer, recipient, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.ClearAdmin(ctx, issuer, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// send commission is not charged if there is no admin
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(900).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+	requireT.Equal(sdk.NewInt(100).String(), bankKeeper.GetBalance(ctx, recipient, denom).Amount.String())
+}
+
+func TestKeeper_Clawback(t *testing.T) {
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "CLAW",
+		Subunit:       "claw",
+		Precision:     6,
+		Description:   "CLAW Desc",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_freezing,
+			types.Feature_whitelisting,
+			types.Feature_clawback,
+		},
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	settings.Symbol = "NOCLAW"
+	settings.Subunit = "noclaw"
+	settings.Features = nil
+	unclawbackableDenom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(
+		sdk.NewCoin(denom, sdk.NewInt(100)),
+		sdk.NewCoin(unclawbackableDenom, sdk.NewInt(100)),
+	)))
+
+	// freeze the whole balance and globally freeze the token
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom))
+
+	// try to clawback the token with disabled feature
+	err = ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(unclawbackableDenom, sdk.NewInt(10)))
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to clawback from non-admin account
+	err = ftKeeper.Clawback(ctx, recipient, recipient, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to clawback more than the balance
+	err = ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(101)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// clawback ignores freezing, whitelisting and rates
+	err = ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(40)))
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(60).String(), bankKeeper.GetBalance(ctx, recipient, denom).Amount.String())
+	requireT.Equal(sdk.NewInt(940).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+
+	clawbackEvts, err := event.FindTypedEvents[*types.EventAmountClawedBack](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(clawbackEvts, 1)
+	assertT.EqualValues(&types.EventAmountClawedBack{
+		Account: recipient.String(),
+		Denom:   denom,
+		Amount:  sdk.NewInt(40),
+	}, clawbackEvts[0])
+
+	// try to clawback from module account
+	moduleAddr := testApp.AccountKeeper.GetModuleAccount(ctx, distributiontypes.ModuleName).GetAddress()
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, moduleAddr, sdk.NewCoin(denom, sdk.NewInt(10))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, moduleAddr, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10)))))
+	err = ftKeeper.Clawback(ctx, issuer, moduleAddr, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	requireT.Equal(sdk.NewInt(10).String(), bankKeeper.GetBalance(ctx, moduleAddr, denom).Amount.String())
+}
+
+func TestKeeper_UpdateMetadata(t *testing.T) {
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "META",
+		Subunit:       "meta",
+		Precision:     6,
+		Description:   "META Desc",
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_metadata_updating},
+		URI:           "https://my-token-meta.invalid/1",
+		URIHash:       "content-hash",
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	settings.Symbol = "IMMUTABLE"
+	settings.Subunit = "immutable"
+	settings.Features = nil
+	immutableDenom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// try to update metadata of the token with disabled feature
+	err = ftKeeper.UpdateMetadata(ctx, issuer, immutableDenom, "new desc", "", "")
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to update metadata from non-admin account
+	err = ftKeeper.UpdateMetadata(ctx, randomAddr, denom, "new desc", "", "")
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to update with too long description
+	err = ftKeeper.UpdateMetadata(ctx, issuer, denom, strings.Repeat("x", types.MaxDescriptionLength+1), "", "")
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// update metadata
+	err = ftKeeper.UpdateMetadata(ctx, issuer, denom, "new desc", "https://my-token-meta.invalid/2", "new-content-hash")
+	requireT.NoError(err)
+
+	metadataUpdatedEvts, err := event.FindTypedEvents[*types.EventMetadataUpdated](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(metadataUpdatedEvts, 1)
+	assertT.EqualValues(&types.EventMetadataUpdated{
+		Denom:       denom,
+		Description: "new desc",
+		URI:         "https://my-token-meta.invalid/2",
+		URIHash:     "new-content-hash",
+	}, metadataUpdatedEvts[0])
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("new desc", token.Description)
+	requireT.Equal("https://my-token-meta.invalid/2", token.URI)
+	requireT.Equal("new-content-hash", token.URIHash)
+
+	metadata, found := bankKeeper.GetDenomMetaData(ctx, denom)
+	requireT.True(found)
+	requireT.Equal("new desc", metadata.Description)
+	requireT.Equal(settings.Precision, metadata.DenomUnits[1].Exponent)
+}
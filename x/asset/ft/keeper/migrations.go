@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// In version 2 the admin role has been introduced, so the admin of all the existing tokens is set to the issuer.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var definitions []types.Definition
+	m.keeper.IterateAllDefinitions(ctx, func(def types.Definition) bool {
+		if def.Admin == "" {
+			definitions = append(definitions, def)
+		}
+		return false
+	})
+
+	for _, def := range definitions {
+		def.Admin = def.Issuer
+		if err := m.keeper.updateDefinition(ctx, def); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestMigrator_Migrate1to2(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "MIGRATE",
+		Subunit:       "migrate",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	// store the definition the way it was stored before the admin was introduced
+	def, err := ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	def.Admin = ""
+	ftKeeper.SetDefinition(ctx, issuer, "migrate", def)
+
+	requireT.NoError(keeper.NewMigrator(ftKeeper).Migrate1to2(ctx))
+
+	def, err = ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), def.Admin)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,9 +19,21 @@ type MsgKeeper interface {
 	Burn(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error
 	Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
 	Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	TimedFreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin, unlockTime time.Time) error
 	GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 	GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 	SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error
+	Clawback(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	UpdateMetadata(ctx sdk.Context, sender sdk.AccAddress, denom, description, uri, uriHash string) error
+	AddToBlacklist(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	RemoveFromBlacklist(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	GrantMintAllowance(ctx sdk.Context, sender, minter sdk.AccAddress, coin sdk.Coin) error
+	RevokeMintAllowance(ctx sdk.Context, sender, minter sdk.AccAddress, denom string) error
+	AddRateExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	RemoveRateExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	UpdateCommissionRecipients(ctx sdk.Context, sender sdk.AccAddress, denom string, recipients []types.CommissionRecipient) error
 }
 
 // MsgServer serves grpc tx requests for assets module.
@@ -36,12 +49,12 @@ func NewMsgServer(keeper MsgKeeper) MsgServer {
 }
 
 // Issue defines a tx handler to issue a new fungible token.
-func (ms MsgServer) Issue(ctx context.Context, req *types.MsgIssue) (*types.EmptyResponse, error) {
+func (ms MsgServer) Issue(ctx context.Context, req *types.MsgIssue) (*types.MsgIssueResponse, error) {
 	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid issuer in MsgIssue")
 	}
-	_, err = ms.keeper.Issue(sdk.UnwrapSDKContext(ctx), types.IssueSettings{
+	denom, err := ms.keeper.Issue(sdk.UnwrapSDKContext(ctx), types.IssueSettings{
 		Issuer:             issuer,
 		Symbol:             req.Symbol,
 		Subunit:            req.Subunit,
@@ -51,12 +64,18 @@ func (ms MsgServer) Issue(ctx context.Context, req *types.MsgIssue) (*types.Empt
 		Features:           req.Features,
 		BurnRate:           req.BurnRate,
 		SendCommissionRate: req.SendCommissionRate,
+		URI:                req.URI,
+		URIHash:            req.URIHash,
+		MaxSupply:          req.MaxSupply,
+		ExtensionContract:  req.ExtensionContract,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.EmptyResponse{}, nil
+	return &types.MsgIssueResponse{
+		Denom: denom,
+	}, nil
 }
 
 // Mint mints new fungible tokens.
@@ -133,6 +152,27 @@ func (ms MsgServer) Unfreeze(goCtx context.Context, req *types.MsgUnfreeze) (*ty
 	return &types.EmptyResponse{}, nil
 }
 
+// TimedFreeze freezes part of the fungible tokens in an account until the unlock time.
+func (ms MsgServer) TimedFreeze(goCtx context.Context, req *types.MsgTimedFreeze) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.TimedFreeze(ctx, sender, account, req.Coin, req.UnlockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // GloballyFreeze globally freezes fungible token.
 func (ms MsgServer) GloballyFreeze(goCtx context.Context, req *types.MsgGloballyFreeze) (*types.EmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -183,3 +223,211 @@ func (ms MsgServer) SetWhitelistedLimit(goCtx context.Context, req *types.MsgSet
 
 	return &types.EmptyResponse{}, nil
 }
+
+// TransferAdmin transfers the admin role of the fungible token to another account.
+func (ms MsgServer) TransferAdmin(goCtx context.Context, req *types.MsgTransferAdmin) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.TransferAdmin(ctx, sender, account, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClearAdmin removes the admin of the fungible token.
+func (ms MsgServer) ClearAdmin(goCtx context.Context, req *types.MsgClearAdmin) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.ClearAdmin(ctx, sender, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// Clawback returns coins from an account to the admin.
+func (ms MsgServer) Clawback(goCtx context.Context, req *types.MsgClawback) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.Clawback(ctx, sender, account, req.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateMetadata updates the metadata of the fungible token.
+func (ms MsgServer) UpdateMetadata(goCtx context.Context, req *types.MsgUpdateMetadata) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.UpdateMetadata(ctx, sender, req.Denom, req.Description, req.URI, req.URIHash); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// AddToBlacklist blacklists the account for the fungible token.
+func (ms MsgServer) AddToBlacklist(goCtx context.Context, req *types.MsgAddToBlacklist) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.AddToBlacklist(ctx, sender, account, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveFromBlacklist removes the account from the blacklist of the fungible token.
+func (ms MsgServer) RemoveFromBlacklist(goCtx context.Context, req *types.MsgRemoveFromBlacklist) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.RemoveFromBlacklist(ctx, sender, account, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// GrantMintAllowance sets the mint allowance of the minter for the fungible token.
+func (ms MsgServer) GrantMintAllowance(goCtx context.Context, req *types.MsgGrantMintAllowance) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	minter, err := sdk.AccAddressFromBech32(req.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid minter address")
+	}
+
+	if err := ms.keeper.GrantMintAllowance(ctx, sender, minter, req.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RevokeMintAllowance removes the mint allowance of the minter for the fungible token.
+func (ms MsgServer) RevokeMintAllowance(goCtx context.Context, req *types.MsgRevokeMintAllowance) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	minter, err := sdk.AccAddressFromBech32(req.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid minter address")
+	}
+
+	if err := ms.keeper.RevokeMintAllowance(ctx, sender, minter, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// AddRateExemption exempts the account from the rates of the fungible token.
+func (ms MsgServer) AddRateExemption(goCtx context.Context, req *types.MsgAddRateExemption) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.AddRateExemption(ctx, sender, account, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveRateExemption removes the rate exemption of the account for the fungible token.
+func (ms MsgServer) RemoveRateExemption(goCtx context.Context, req *types.MsgRemoveRateExemption) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.RemoveRateExemption(ctx, sender, account, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateCommissionRecipients sets the send commission recipients of the fungible token.
+func (ms MsgServer) UpdateCommissionRecipients(
+	goCtx context.Context,
+	req *types.MsgUpdateCommissionRecipients,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.UpdateCommissionRecipients(ctx, sender, req.Denom, req.Recipients); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
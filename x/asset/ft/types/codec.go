@@ -14,9 +14,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgBurn{},
 		&MsgFreeze{},
 		&MsgUnfreeze{},
+		&MsgTimedFreeze{},
 		&MsgGloballyFreeze{},
 		&MsgGloballyUnfreeze{},
 		&MsgSetWhitelistedLimit{},
+		&MsgTransferAdmin{},
+		&MsgClearAdmin{},
+		&MsgClawback{},
+		&MsgUpdateMetadata{},
+		&MsgAddToBlacklist{},
+		&MsgRemoveFromBlacklist{},
+		&MsgGrantMintAllowance{},
+		&MsgRevokeMintAllowance{},
+		&MsgAddRateExemption{},
+		&MsgRemoveRateExemption{},
+		&MsgUpdateCommissionRecipients{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
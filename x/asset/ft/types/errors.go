@@ -19,4 +19,12 @@ var (
 	ErrGloballyFrozen = sdkerrors.Register(ModuleName, 6, "token is globally frozen")
 	// ErrWhitelistedLimitExceeded is returned when new balance after receiving coins exceeds the whitelisted limit.
 	ErrWhitelistedLimitExceeded = sdkerrors.Register(ModuleName, 7, "whitelisted limit exceeded")
+	// ErrBlacklisted is returned when blacklisted account sends or receives the token.
+	ErrBlacklisted = sdkerrors.Register(ModuleName, 8, "account is blacklisted")
+	// ErrMaxSupplyExceeded is returned when minting would make the total supply exceed the max supply.
+	ErrMaxSupplyExceeded = sdkerrors.Register(ModuleName, 9, "max supply exceeded")
+	// ErrMintAllowanceExceeded is returned when the minter tries to mint more than its mint allowance.
+	ErrMintAllowanceExceeded = sdkerrors.Register(ModuleName, 10, "mint allowance exceeded")
+	// ErrExtensionRejected is returned when the extension contract of the token rejects the transfer or fails.
+	ErrExtensionRejected = sdkerrors.Register(ModuleName, 11, "transfer rejected by the extension")
 )
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExtensionSudoMsg is the message sent to the extension contract of the token using the sudo call.
+//
+//nolint:tagliatelle // the contract uses snake case
+type ExtensionSudoMsg struct {
+	ExtensionTransfer *ExtensionTransferMsg `json:"extension_transfer,omitempty"`
+}
+
+// ExtensionTransferMsg contains the transfer checked by the extension contract.
+// The contract rejects the transfer by returning an error.
+type ExtensionTransferMsg struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Amount sdk.Coin `json:"amount"`
+}
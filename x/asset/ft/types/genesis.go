@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // DefaultGenesis returns the default Token genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -27,9 +32,67 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, timedFreeze := range gs.TimedFreezes {
+		if err := timedFreeze.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, blacklistedAccounts := range gs.BlacklistedAccounts {
+		if _, _, err := DeconstructDenom(blacklistedAccounts.Denom); err != nil {
+			return err
+		}
+		for _, account := range blacklistedAccounts.Accounts {
+			if _, err := sdk.AccAddressFromBech32(account); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid blacklisted account %s", account)
+			}
+		}
+	}
+
+	for _, rateExemptAccounts := range gs.RateExemptAccounts {
+		if _, _, err := DeconstructDenom(rateExemptAccounts.Denom); err != nil {
+			return err
+		}
+		for _, account := range rateExemptAccounts.Accounts {
+			if _, err := sdk.AccAddressFromBech32(account); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid rate exempt account %s", account)
+			}
+		}
+	}
+
+	for _, allowance := range gs.MintAllowances {
+		if _, err := sdk.AccAddressFromBech32(allowance.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter %s", allowance.Address)
+		}
+		if err := ValidateAssetCoins(allowance.Coins); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
+// Validate checks all the fields are valid.
+func (timedFreeze TimedFreeze) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(timedFreeze.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", timedFreeze.Account)
+	}
+
+	if !timedFreeze.Coin.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "timed freeze amount %s must be positive", timedFreeze.Coin)
+	}
+
+	if err := ValidateAssetCoins(sdk.NewCoins(timedFreeze.Coin)); err != nil {
+		return err
+	}
+
+	if timedFreeze.UnlockTime.Unix() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "unlock time must be set")
+	}
+
+	return nil
+}
+
 // Validate checks all the fields are valid.
 func (token Token) Validate() error {
 	_, _, err := DeconstructDenom(token.Denom)
@@ -49,9 +112,31 @@ func (token Token) Validate() error {
 		return err
 	}
 
+	if err := ValidateMetadata(token.Description, token.URI, token.URIHash); err != nil {
+		return err
+	}
+
+	if token.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(token.Admin); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin %s", token.Admin)
+		}
+	}
+
 	if err := ValidateSendCommissionRate(token.SendCommissionRate); err != nil {
 		return err
 	}
 
+	if err := ValidateMaxSupply(token.MaxSupply, sdk.ZeroInt()); err != nil {
+		return err
+	}
+
+	if err := ValidateCommissionRecipients(token.CommissionRecipients); err != nil {
+		return err
+	}
+
+	if err := ValidateExtensionContract(token.Features, token.ExtensionContract); err != nil {
+		return err
+	}
+
 	return ValidateBurnRate(token.BurnRate)
 }
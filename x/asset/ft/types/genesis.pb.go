@@ -35,6 +35,14 @@ type GenesisState struct {
 	FrozenBalances []Balance `protobuf:"bytes,3,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances"`
 	// whitelisted_balances contains the whitelisted balances on all of the accounts
 	WhitelistedBalances []Balance `protobuf:"bytes,4,rep,name=whitelisted_balances,json=whitelistedBalances,proto3" json:"whitelisted_balances"`
+	// timed_freezes contains the timed freezes on all of the accounts
+	TimedFreezes []TimedFreeze `protobuf:"bytes,5,rep,name=timed_freezes,json=timedFreezes,proto3" json:"timed_freezes"`
+	// blacklisted_accounts contains the blacklisted accounts of all the denoms
+	BlacklistedAccounts []BlacklistedAccounts `protobuf:"bytes,6,rep,name=blacklisted_accounts,json=blacklistedAccounts,proto3" json:"blacklisted_accounts"`
+	// mint_allowances contains the mint allowances of all the minters
+	MintAllowances []Balance `protobuf:"bytes,7,rep,name=mint_allowances,json=mintAllowances,proto3" json:"mint_allowances"`
+	// rate_exempt_accounts contains the accounts exempted from the burn rate and send commission rate of all the denoms
+	RateExemptAccounts []RateExemptAccounts `protobuf:"bytes,8,rep,name=rate_exempt_accounts,json=rateExemptAccounts,proto3" json:"rate_exempt_accounts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -98,6 +106,144 @@ func (m *GenesisState) GetWhitelistedBalances() []Balance {
 	return nil
 }
 
+func (m *GenesisState) GetTimedFreezes() []TimedFreeze {
+	if m != nil {
+		return m.TimedFreezes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlacklistedAccounts() []BlacklistedAccounts {
+	if m != nil {
+		return m.BlacklistedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintAllowances() []Balance {
+	if m != nil {
+		return m.MintAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateExemptAccounts() []RateExemptAccounts {
+	if m != nil {
+		return m.RateExemptAccounts
+	}
+	return nil
+}
+
+// BlacklistedAccounts defines a denom and the accounts blacklisted for it used in the module genesis state.
+type BlacklistedAccounts struct {
+	// denom is the denom of the blacklist.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// accounts are the blacklisted accounts.
+	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *BlacklistedAccounts) Reset()         { *m = BlacklistedAccounts{} }
+func (m *BlacklistedAccounts) String() string { return proto.CompactTextString(m) }
+func (*BlacklistedAccounts) ProtoMessage()    {}
+func (*BlacklistedAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d281657d6c91cb92, []int{1}
+}
+func (m *BlacklistedAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlacklistedAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlacklistedAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlacklistedAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlacklistedAccounts.Merge(m, src)
+}
+func (m *BlacklistedAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlacklistedAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlacklistedAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlacklistedAccounts proto.InternalMessageInfo
+
+func (m *BlacklistedAccounts) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BlacklistedAccounts) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// RateExemptAccounts defines a denom and the accounts exempted from its rates used in the module genesis state.
+type RateExemptAccounts struct {
+	// denom is the denom of the exemption list.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// accounts are the exempted accounts.
+	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *RateExemptAccounts) Reset()         { *m = RateExemptAccounts{} }
+func (m *RateExemptAccounts) String() string { return proto.CompactTextString(m) }
+func (*RateExemptAccounts) ProtoMessage()    {}
+func (*RateExemptAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d281657d6c91cb92, []int{2}
+}
+func (m *RateExemptAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateExemptAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateExemptAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateExemptAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateExemptAccounts.Merge(m, src)
+}
+func (m *RateExemptAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateExemptAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateExemptAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateExemptAccounts proto.InternalMessageInfo
+
+func (m *RateExemptAccounts) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateExemptAccounts) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used module genesis genesis state.
 type Balance struct {
 	// address is the address of the balance holder.
@@ -110,7 +256,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d281657d6c91cb92, []int{1}
+	return fileDescriptor_d281657d6c91cb92, []int{3}
 }
 func (m *Balance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,38 +301,49 @@ func (m *Balance) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.asset.ft.v1.GenesisState")
+	proto.RegisterType((*BlacklistedAccounts)(nil), "coreum.asset.ft.v1.BlacklistedAccounts")
+	proto.RegisterType((*RateExemptAccounts)(nil), "coreum.asset.ft.v1.RateExemptAccounts")
 	proto.RegisterType((*Balance)(nil), "coreum.asset.ft.v1.Balance")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/genesis.proto", fileDescriptor_d281657d6c91cb92) }
 
 var fileDescriptor_d281657d6c91cb92 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6e, 0xea, 0x30,
-	0x14, 0xc6, 0x13, 0xe0, 0x82, 0xae, 0xb9, 0xba, 0x95, 0x5c, 0x86, 0x94, 0x4a, 0x01, 0x31, 0xb1,
-	0xd4, 0x6e, 0x60, 0x68, 0xe7, 0x20, 0xb5, 0x52, 0xa5, 0x4a, 0x15, 0x65, 0xea, 0x52, 0x39, 0x89,
-	0x09, 0x11, 0x24, 0x46, 0xb1, 0xa1, 0x7f, 0x1e, 0xa0, 0x73, 0xdf, 0xa1, 0x5b, 0x9f, 0x84, 0x91,
-	0xb1, 0x53, 0x5b, 0xc1, 0x8b, 0x54, 0xb1, 0x1d, 0x81, 0x04, 0x43, 0xa7, 0xc4, 0x39, 0xdf, 0xf7,
-	0x3b, 0xc7, 0xf9, 0x0e, 0x68, 0xfa, 0x2c, 0xa5, 0xb3, 0x18, 0x13, 0xce, 0xa9, 0xc0, 0x43, 0x81,
-	0xe7, 0x0e, 0x0e, 0x69, 0x42, 0x79, 0xc4, 0xd1, 0x34, 0x65, 0x82, 0x41, 0xa8, 0x14, 0x48, 0x2a,
-	0xd0, 0x50, 0xa0, 0xb9, 0x53, 0xaf, 0x85, 0x2c, 0x64, 0xb2, 0x8c, 0xb3, 0x37, 0xa5, 0xac, 0xdb,
-	0x3e, 0xe3, 0x31, 0xe3, 0xd8, 0x23, 0x9c, 0xe2, 0xb9, 0xe3, 0x51, 0x41, 0x1c, 0xec, 0xb3, 0x28,
-	0xd9, 0xd4, 0x77, 0x7a, 0x09, 0x36, 0xa6, 0x79, 0xbd, 0xb1, 0xa7, 0x3e, 0x25, 0x29, 0x89, 0xf5,
-	0x28, 0xad, 0xb7, 0x02, 0xf8, 0x77, 0xa9, 0x86, 0xbb, 0x15, 0x44, 0x50, 0x78, 0x0e, 0xca, 0x4a,
-	0x60, 0x99, 0x4d, 0xb3, 0x5d, 0xed, 0xd4, 0xd1, 0xee, 0xb0, 0xe8, 0x46, 0x2a, 0xdc, 0xd2, 0xe2,
-	0xb3, 0x61, 0xf4, 0xb5, 0x1e, 0x9e, 0x81, 0xb2, 0x6c, 0xcd, 0xad, 0x42, 0xb3, 0xd8, 0xae, 0x76,
-	0x8e, 0xf6, 0x39, 0x07, 0x99, 0x22, 0x37, 0x2a, 0x39, 0xbc, 0x02, 0x07, 0xc3, 0x94, 0x3d, 0xd3,
-	0xe4, 0xde, 0x23, 0x13, 0x92, 0xf8, 0x94, 0x5b, 0x45, 0x49, 0x38, 0xde, 0x47, 0x70, 0x95, 0x46,
-	0x33, 0xfe, 0x2b, 0xa7, 0xfe, 0xc8, 0xe1, 0x00, 0xd4, 0x1e, 0x46, 0x91, 0xa0, 0x93, 0x88, 0x0b,
-	0x1a, 0x6c, 0x80, 0xa5, 0xdf, 0x02, 0x0f, 0xb7, 0xec, 0x39, 0xb5, 0xf5, 0x62, 0x82, 0x8a, 0x3e,
-	0x40, 0x0b, 0x54, 0x48, 0x10, 0xa4, 0x94, 0xab, 0x3f, 0xf4, 0xb7, 0x9f, 0x1f, 0x21, 0x01, 0x7f,
-	0xb2, 0x68, 0xb6, 0xef, 0x9f, 0x85, 0x87, 0xb2, 0xf0, 0x90, 0x0e, 0x0f, 0xf5, 0x58, 0x94, 0xb8,
-	0xa7, 0x59, 0xab, 0xf7, 0xaf, 0x46, 0x3b, 0x8c, 0xc4, 0x68, 0xe6, 0x21, 0x9f, 0xc5, 0x58, 0x27,
-	0xad, 0x1e, 0x27, 0x3c, 0x18, 0x63, 0xf1, 0x34, 0xa5, 0x5c, 0x1a, 0x78, 0x5f, 0x91, 0xdd, 0xeb,
-	0xc5, 0xca, 0x36, 0x97, 0x2b, 0xdb, 0xfc, 0x5e, 0xd9, 0xe6, 0xeb, 0xda, 0x36, 0x96, 0x6b, 0xdb,
-	0xf8, 0x58, 0xdb, 0xc6, 0x5d, 0x77, 0x0b, 0xd5, 0x93, 0x97, 0xbc, 0x60, 0xb3, 0x24, 0x20, 0x22,
-	0x62, 0x09, 0xd6, 0x5b, 0xf0, 0xb8, 0xd9, 0x03, 0xc9, 0xf6, 0xca, 0x72, 0x09, 0xba, 0x3f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x08, 0x16, 0xe5, 0x63, 0xb3, 0x02, 0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0xd6, 0x3f, 0x9b, 0x37, 0x40, 0xf2, 0x7a, 0x08, 0x45, 0x4a, 0xab, 0x1e, 0xa0,
+	0x17, 0x62, 0xba, 0x1d, 0xe0, 0xba, 0x4e, 0x74, 0xd2, 0x24, 0x24, 0x54, 0x76, 0xe2, 0x40, 0x71,
+	0x92, 0xb7, 0x5d, 0xd4, 0x26, 0xae, 0xe2, 0xb7, 0xdd, 0xd8, 0x07, 0xe0, 0xcc, 0xe7, 0xe0, 0x93,
+	0xec, 0xb8, 0x23, 0x27, 0x40, 0xed, 0xe7, 0x40, 0x42, 0xb1, 0xdd, 0xa6, 0x22, 0x39, 0x4c, 0x3b,
+	0xb5, 0xce, 0xfb, 0x3c, 0x3f, 0x3f, 0xb6, 0xfc, 0x90, 0x96, 0x2f, 0x12, 0x98, 0x47, 0x8c, 0x4b,
+	0x09, 0xc8, 0x46, 0xc8, 0x16, 0x5d, 0x36, 0x86, 0x18, 0x64, 0x28, 0xdd, 0x59, 0x22, 0x50, 0x50,
+	0xaa, 0x15, 0xae, 0x52, 0xb8, 0x23, 0x74, 0x17, 0xdd, 0x46, 0x7d, 0x2c, 0xc6, 0x42, 0x8d, 0x59,
+	0xfa, 0x4f, 0x2b, 0x1b, 0x8e, 0x2f, 0x64, 0x24, 0x24, 0xf3, 0xb8, 0x04, 0xb6, 0xe8, 0x7a, 0x80,
+	0xbc, 0xcb, 0x7c, 0x11, 0xc6, 0xd9, 0x3c, 0xb7, 0x17, 0x8a, 0x09, 0xac, 0xe7, 0xcd, 0x82, 0xf9,
+	0x8c, 0x27, 0x3c, 0x32, 0x51, 0xda, 0x7f, 0xcb, 0xe4, 0xe0, 0x4c, 0x87, 0xfb, 0x88, 0x1c, 0x81,
+	0xbe, 0x25, 0x55, 0x2d, 0xb0, 0xad, 0x96, 0xd5, 0xd9, 0x3f, 0x6a, 0xb8, 0xf9, 0xb0, 0xee, 0x07,
+	0xa5, 0xe8, 0x95, 0x6f, 0x7f, 0x35, 0x4b, 0x03, 0xa3, 0xa7, 0x6f, 0x48, 0x55, 0x6d, 0x2d, 0xed,
+	0x47, 0xad, 0x9d, 0xce, 0xfe, 0xd1, 0xb3, 0x22, 0xe7, 0x45, 0xaa, 0x58, 0x1b, 0xb5, 0x9c, 0x9e,
+	0x93, 0xa7, 0xa3, 0x44, 0xdc, 0x40, 0x3c, 0xf4, 0xf8, 0x94, 0xc7, 0x3e, 0x48, 0x7b, 0x47, 0x11,
+	0x9e, 0x17, 0x11, 0x7a, 0x5a, 0x63, 0x18, 0x4f, 0xb4, 0xd3, 0x7c, 0x94, 0xf4, 0x82, 0xd4, 0xaf,
+	0x2e, 0x43, 0x84, 0x69, 0x28, 0x11, 0x82, 0x0c, 0x58, 0xbe, 0x2f, 0xf0, 0x70, 0xcb, 0xbe, 0xa1,
+	0x9e, 0x93, 0xc7, 0x18, 0x46, 0x10, 0x0c, 0x47, 0x09, 0xc0, 0x0d, 0x48, 0xbb, 0xa2, 0x70, 0xcd,
+	0xc2, 0x13, 0xa6, 0xc2, 0xbe, 0xd2, 0x19, 0xe4, 0x01, 0x66, 0x9f, 0x24, 0xfd, 0x42, 0xea, 0xde,
+	0x94, 0xfb, 0x13, 0x93, 0x90, 0xfb, 0xbe, 0x98, 0xc7, 0x28, 0xed, 0xaa, 0x42, 0xbe, 0x2c, 0x4c,
+	0x98, 0xe9, 0x4f, 0x8c, 0x7c, 0x9d, 0xd6, 0xcb, 0x8f, 0xd2, 0xfb, 0x8c, 0xc2, 0x18, 0x87, 0x7c,
+	0x3a, 0x15, 0x57, 0xfa, 0xf8, 0xb5, 0x7b, 0xdf, 0x67, 0xea, 0x3c, 0xd9, 0x18, 0xe9, 0x67, 0x52,
+	0x4f, 0x38, 0xc2, 0x10, 0xae, 0x21, 0x9a, 0x61, 0x96, 0x76, 0x57, 0x01, 0x5f, 0x14, 0x01, 0x07,
+	0x1c, 0xe1, 0x9d, 0x92, 0xff, 0x17, 0x96, 0x26, 0xb9, 0x49, 0xfb, 0x8c, 0x1c, 0x16, 0x9c, 0x8e,
+	0xd6, 0x49, 0x25, 0x80, 0x58, 0x44, 0xea, 0x11, 0xee, 0x0d, 0xf4, 0x82, 0x36, 0xc8, 0xee, 0x26,
+	0x40, 0xfa, 0xc6, 0xf6, 0x06, 0x9b, 0x75, 0xbb, 0x4f, 0x68, 0x7e, 0xe3, 0x07, 0x70, 0xbe, 0x59,
+	0xa4, 0x66, 0xae, 0x84, 0xda, 0xa4, 0xc6, 0x83, 0x20, 0x01, 0x29, 0x8d, 0x7f, 0xbd, 0xa4, 0x9c,
+	0x54, 0xd2, 0x16, 0x6e, 0x3f, 0xf5, 0xb4, 0xa7, 0x6e, 0xda, 0x53, 0xd7, 0xf4, 0xd4, 0x3d, 0x15,
+	0x61, 0xdc, 0x7b, 0x9d, 0x1e, 0xfd, 0xc7, 0xef, 0x66, 0x67, 0x1c, 0xe2, 0xe5, 0xdc, 0x73, 0x7d,
+	0x11, 0x31, 0x53, 0x6a, 0xfd, 0xf3, 0x4a, 0x06, 0x13, 0x86, 0x5f, 0x67, 0x20, 0x95, 0x41, 0x0e,
+	0x34, 0xb9, 0xf7, 0xfe, 0x76, 0xe9, 0x58, 0x77, 0x4b, 0xc7, 0xfa, 0xb3, 0x74, 0xac, 0xef, 0x2b,
+	0xa7, 0x74, 0xb7, 0x72, 0x4a, 0x3f, 0x57, 0x4e, 0xe9, 0xd3, 0xf1, 0x16, 0xea, 0x54, 0xdd, 0x7f,
+	0x5f, 0xcc, 0xe3, 0x80, 0x63, 0x28, 0x62, 0x66, 0x0a, 0x7f, 0x9d, 0x55, 0x5e, 0xb1, 0xbd, 0xaa,
+	0xea, 0xfb, 0xf1, 0xbf, 0x01, 0x00, 0x50, 0x9a, 0x22, 0xc7, 0x9e, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -209,6 +366,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RateExemptAccounts) > 0 {
+		for iNdEx := len(m.RateExemptAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateExemptAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MintAllowances) > 0 {
+		for iNdEx := len(m.MintAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BlacklistedAccounts) > 0 {
+		for iNdEx := len(m.BlacklistedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklistedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TimedFreezes) > 0 {
+		for iNdEx := len(m.TimedFreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimedFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.WhitelistedBalances) > 0 {
 		for iNdEx := len(m.WhitelistedBalances) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -264,6 +477,84 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlacklistedAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlacklistedAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistedAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateExemptAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateExemptAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateExemptAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Balance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -345,6 +636,68 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TimedFreezes) > 0 {
+		for _, e := range m.TimedFreezes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlacklistedAccounts) > 0 {
+		for _, e := range m.BlacklistedAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintAllowances) > 0 {
+		for _, e := range m.MintAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateExemptAccounts) > 0 {
+		for _, e := range m.RateExemptAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlacklistedAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateExemptAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -537,6 +890,370 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedFreezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimedFreezes = append(m.TimedFreezes, TimedFreeze{})
+			if err := m.TimedFreezes[len(m.TimedFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklistedAccounts = append(m.BlacklistedAccounts, BlacklistedAccounts{})
+			if err := m.BlacklistedAccounts[len(m.BlacklistedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAllowances = append(m.MintAllowances, Balance{})
+			if err := m.MintAllowances[len(m.MintAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateExemptAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateExemptAccounts = append(m.RateExemptAccounts, RateExemptAccounts{})
+			if err := m.RateExemptAccounts[len(m.RateExemptAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlacklistedAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlacklistedAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlacklistedAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateExemptAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateExemptAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateExemptAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,9 +2,11 @@ package types
 
 import (
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/CoreumFoundation/coreum/pkg/store"
 )
@@ -35,6 +37,16 @@ var (
 	GlobalFreezeKeyPrefix = []byte{0x04}
 	// WhitelistedBalancesKeyPrefix defines the key prefix to track whitelisted balances.
 	WhitelistedBalancesKeyPrefix = []byte{0x05}
+	// TimedFreezesKeyPrefix defines the key prefix to track timed freezes of the accounts.
+	TimedFreezesKeyPrefix = []byte{0x06}
+	// TimedFreezeQueueKeyPrefix defines the key prefix to track timed freezes ordered by the unlock time.
+	TimedFreezeQueueKeyPrefix = []byte{0x07}
+	// BlacklistedAccountsKeyPrefix defines the key prefix to track blacklisted accounts.
+	BlacklistedAccountsKeyPrefix = []byte{0x08}
+	// MintAllowancesKeyPrefix defines the key prefix to track mint allowances.
+	MintAllowancesKeyPrefix = []byte{0x09}
+	// RateExemptAccountsKeyPrefix defines the key prefix to track accounts exempted from the rates.
+	RateExemptAccountsKeyPrefix = []byte{0x0a}
 )
 
 // CreateTokenKey constructs the key for the fungible token.
@@ -67,6 +79,115 @@ func CreateWhitelistedBalancesKey(addr []byte) []byte {
 	return store.JoinKeys(WhitelistedBalancesKeyPrefix, address.MustLengthPrefix(addr))
 }
 
+// CreateMintAllowancesKey creates the prefix for a minter's mint allowances.
+func CreateMintAllowancesKey(addr []byte) []byte {
+	return store.JoinKeys(MintAllowancesKeyPrefix, address.MustLengthPrefix(addr))
+}
+
+// CreateAccountTimedFreezesKey creates the prefix for an account's timed freezes of the denom.
+func CreateAccountTimedFreezesKey(addr sdk.AccAddress, denom string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength(addr, []byte(denom))
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(TimedFreezesKeyPrefix, compositeKey), nil
+}
+
+// CreateTimedFreezeKey creates the key for an account's timed freeze of the denom expiring at the unlock time.
+func CreateTimedFreezeKey(addr sdk.AccAddress, denom string, unlockTime time.Time) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength(addr, []byte(denom), sdk.FormatTimeBytes(unlockTime))
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(TimedFreezesKeyPrefix, compositeKey), nil
+}
+
+// ParseTimedFreezeKey parses timed freeze key back to account address, denom and unlock time.
+// The key must not contain the TimedFreezesKeyPrefix.
+func ParseTimedFreezeKey(key []byte) (sdk.AccAddress, string, time.Time, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return nil, "", time.Time{}, err
+	}
+	if len(parsedKeys) != 3 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "timed freeze key must be composed of 3 length prefixed keys")
+		return nil, "", time.Time{}, err
+	}
+	unlockTime, err := sdk.ParseTimeBytes(parsedKeys[2])
+	if err != nil {
+		return nil, "", time.Time{}, sdkerrors.Wrapf(ErrInvalidKey, "invalid unlock time in the timed freeze key: %s", err)
+	}
+
+	return parsedKeys[0], string(parsedKeys[1]), unlockTime, nil
+}
+
+// CreateTimedFreezeQueueKey creates the key for the timed freeze queue. The unlock time is the first part of the key,
+// so the queue is iterated in the order of the unlock time.
+func CreateTimedFreezeQueueKey(unlockTime time.Time, addr sdk.AccAddress, denom string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength(sdk.FormatTimeBytes(unlockTime), addr, []byte(denom))
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(TimedFreezeQueueKeyPrefix, compositeKey), nil
+}
+
+// ParseTimedFreezeQueueKey parses timed freeze queue key back to unlock time, account address and denom.
+// The key must not contain the TimedFreezeQueueKeyPrefix.
+func ParseTimedFreezeQueueKey(key []byte) (time.Time, sdk.AccAddress, string, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return time.Time{}, nil, "", err
+	}
+	if len(parsedKeys) != 3 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "timed freeze queue key must be composed of 3 length prefixed keys")
+		return time.Time{}, nil, "", err
+	}
+	unlockTime, err := sdk.ParseTimeBytes(parsedKeys[0])
+	if err != nil {
+		return time.Time{}, nil, "", sdkerrors.Wrapf(ErrInvalidKey, "invalid unlock time in the timed freeze queue key: %s", err)
+	}
+
+	return unlockTime, parsedKeys[1], string(parsedKeys[2]), nil
+}
+
+// CreateBlacklistedAccountsKey creates the prefix for the blacklisted accounts of the denom.
+func CreateBlacklistedAccountsKey(denom string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(denom))
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(BlacklistedAccountsKeyPrefix, compositeKey), nil
+}
+
+// CreateBlacklistedAccountKey creates the key for the blacklisted account of the denom.
+func CreateBlacklistedAccountKey(denom string, addr sdk.AccAddress) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(denom), addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(BlacklistedAccountsKeyPrefix, compositeKey), nil
+}
+
+// ParseBlacklistedAccountKey parses blacklisted account key back to denom and account address.
+// The key must not contain the BlacklistedAccountsKeyPrefix.
+func ParseBlacklistedAccountKey(key []byte) (string, sdk.AccAddress, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(parsedKeys) != 2 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "blacklisted account key must be composed of 2 length prefixed keys")
+		return "", nil, err
+	}
+
+	return string(parsedKeys[0]), parsedKeys[1], nil
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the prefix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -83,3 +204,38 @@ func AddressFromBalancesStore(key []byte) (sdk.AccAddress, error) {
 	}
 	return key[1 : bound+1], nil
 }
+
+// CreateRateExemptAccountsKey creates the prefix for the accounts exempted from the rates of the denom.
+func CreateRateExemptAccountsKey(denom string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(denom))
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(RateExemptAccountsKeyPrefix, compositeKey), nil
+}
+
+// CreateRateExemptAccountKey creates the key for the account exempted from the rates of the denom.
+func CreateRateExemptAccountKey(denom string, addr sdk.AccAddress) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(denom), addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(RateExemptAccountsKeyPrefix, compositeKey), nil
+}
+
+// ParseRateExemptAccountKey parses rate exempt account key back to denom and account address.
+// The key must not contain the RateExemptAccountsKeyPrefix.
+func ParseRateExemptAccountKey(key []byte) (string, sdk.AccAddress, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(parsedKeys) != 2 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "rate exempt account key must be composed of 2 length prefixed keys")
+		return "", nil, err
+	}
+
+	return string(parsedKeys[0]), parsedKeys[1], nil
+}
@@ -11,15 +11,25 @@ var (
 	_ sdk.Msg = &MsgBurn{}
 	_ sdk.Msg = &MsgFreeze{}
 	_ sdk.Msg = &MsgUnfreeze{}
+	_ sdk.Msg = &MsgTimedFreeze{}
 	_ sdk.Msg = &MsgGloballyFreeze{}
 	_ sdk.Msg = &MsgGloballyUnfreeze{}
 	_ sdk.Msg = &MsgSetWhitelistedLimit{}
+	_ sdk.Msg = &MsgTransferAdmin{}
+	_ sdk.Msg = &MsgClearAdmin{}
+	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgUpdateMetadata{}
+	_ sdk.Msg = &MsgAddToBlacklist{}
+	_ sdk.Msg = &MsgRemoveFromBlacklist{}
+	_ sdk.Msg = &MsgGrantMintAllowance{}
+	_ sdk.Msg = &MsgRevokeMintAllowance{}
+	_ sdk.Msg = &MsgAddRateExemption{}
+	_ sdk.Msg = &MsgRemoveRateExemption{}
+	_ sdk.Msg = &MsgUpdateCommissionRecipients{}
 )
 
 // ValidateBasic validates the message.
 func (msg MsgIssue) ValidateBasic() error {
-	const maxDescriptionLength = 200
-
 	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer %s", msg.Issuer)
 	}
@@ -49,11 +59,15 @@ func (msg MsgIssue) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInput, "invalid initial amount %s, can't be negative", msg.InitialAmount.String())
 	}
 
-	if len(msg.Description) > maxDescriptionLength {
-		return sdkerrors.Wrapf(ErrInvalidInput, "invalid description %q, the length must be less than %d", msg.Description, maxDescriptionLength)
+	if err := ValidateMaxSupply(msg.MaxSupply, msg.InitialAmount); err != nil {
+		return err
 	}
 
-	return nil
+	if err := ValidateExtensionContract(msg.Features, msg.ExtensionContract); err != nil {
+		return err
+	}
+
+	return ValidateMetadata(msg.Description, msg.URI, msg.URIHash)
 }
 
 // GetSigners returns the message signers.
@@ -113,15 +127,10 @@ func (msg MsgFreeze) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	_, issuer, err := DeconstructDenom(msg.Coin.Denom)
-	if err != nil {
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
 		return err
 	}
 
-	if issuer.String() == msg.Account {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "issuer's balance can't be frozen")
-	}
-
 	return msg.Coin.Validate()
 }
 
@@ -156,6 +165,34 @@ func (msg MsgUnfreeze) GetSigners() []sdk.AccAddress {
 	}
 }
 
+// ValidateBasic checks that message fields are valid.
+func (msg MsgTimedFreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	if msg.UnlockTime.Unix() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "unlock time must be set")
+	}
+
+	return msg.Coin.Validate()
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgTimedFreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
 // ValidateBasic checks that message fields are valid.
 func (msg MsgGloballyFreeze) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
@@ -206,20 +243,264 @@ func (msg MsgSetWhitelistedLimit) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	_, issuer, err := DeconstructDenom(msg.Coin.Denom)
-	if err != nil {
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	return msg.Coin.Validate()
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgSetWhitelistedLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgTransferAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgTransferAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgClearAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
 		return err
 	}
 
-	if issuer.String() == msg.Account {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "issuer's balance can't be whitelisted")
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgClearAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if msg.Sender == msg.Account {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "sender can't clawback from itself")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
 	}
 
 	return msg.Coin.Validate()
 }
 
 // GetSigners returns the required signers of this message type.
-func (msg MsgSetWhitelistedLimit) GetSigners() []sdk.AccAddress {
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgUpdateMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateMetadata(msg.Description, msg.URI, msg.URIHash)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgUpdateMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgAddToBlacklist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgAddToBlacklist) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgRemoveFromBlacklist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgRemoveFromBlacklist) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgGrantMintAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid minter address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	if err := msg.Coin.Validate(); err != nil {
+		return err
+	}
+
+	if !msg.Coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "mint allowance amount should be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgGrantMintAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgRevokeMintAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid minter address")
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgRevokeMintAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgAddRateExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgAddRateExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgRemoveRateExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgRemoveRateExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgUpdateCommissionRecipients) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateCommissionRecipients(msg.Recipients)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgUpdateCommissionRecipients) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{
 		sdk.MustAccAddressFromBech32(msg.Sender),
 	}
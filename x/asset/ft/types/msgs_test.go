@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -80,6 +82,18 @@ func TestMsgIssue_ValidateBasic(t *testing.T) {
 	msg = msgF()
 	msg.SendCommissionRate = sdk.MustNewDecFromStr("-0.1")
 	requireT.Error(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.MaxSupply = sdk.NewInt(777)
+	requireT.NoError(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.MaxSupply = sdk.NewInt(776)
+	requireT.Error(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.MaxSupply = sdk.NewInt(-1)
+	requireT.Error(msg.ValidateBasic())
 }
 
 func TestMsgFreeze_ValidateBasic(t *testing.T) {
@@ -124,6 +138,7 @@ func TestMsgFreeze_ValidateBasic(t *testing.T) {
 			expectedError: sdkerrors.ErrInvalidAddress,
 		},
 		{
+			// the admin might be different from the issuer, so this check is done by the keeper
 			name: "issuer freezing",
 			message: types.MsgFreeze{
 				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
@@ -133,7 +148,92 @@ func TestMsgFreeze_ValidateBasic(t *testing.T) {
 					Amount: sdk.NewInt(100),
 				},
 			},
-			expectedError: sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgTimedFreeze_ValidateBasic(t *testing.T) {
+	unlockTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name          string
+		message       types.MsgTimedFreeze
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgTimedFreeze{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+				UnlockTime: unlockTime,
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgTimedFreeze{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+				UnlockTime: unlockTime,
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgTimedFreeze{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+				UnlockTime: unlockTime,
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgTimedFreeze{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc",
+					Amount: sdk.NewInt(100),
+				},
+				UnlockTime: unlockTime,
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "missing unlock time",
+			message: types.MsgTimedFreeze{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: types.ErrInvalidInput,
 		},
 	}
 
@@ -373,6 +473,7 @@ func TestMsgSetWhitelistedLimit_ValidateBasic(t *testing.T) {
 			expectedErrorString: "invalid denom",
 		},
 		{
+			// the admin might be different from the issuer, so this check is done by the keeper
 			name: "issuer whitelisting",
 			message: types.MsgSetWhitelistedLimit{
 				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
@@ -382,7 +483,6 @@ func TestMsgSetWhitelistedLimit_ValidateBasic(t *testing.T) {
 					Amount: sdk.NewInt(100),
 				},
 			},
-			expectedError: sdkerrors.ErrUnauthorized,
 		},
 	}
 
@@ -402,3 +502,643 @@ func TestMsgSetWhitelistedLimit_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgTransferAdmin_ValidateBasic(t *testing.T) {
+	type M = types.MsgTransferAdmin
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	newAdmin := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender:  acc.String(),
+			Account: newAdmin.String(),
+			Denom:   "abc" + "-" + acc.String(),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid account address",
+			modifyMsg:   func(m M) M { m.Account = "invalid account"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Denom = "abc"; return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgClearAdmin_ValidateBasic(t *testing.T) {
+	type M = types.MsgClearAdmin
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender: acc.String(),
+			Denom:  "abc" + "-" + acc.String(),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Denom = "abc"; return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgClawback_ValidateBasic(t *testing.T) {
+	type M = types.MsgClawback
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender:  acc.String(),
+			Account: account.String(),
+			Coin:    sdk.NewCoin("abc"+"-"+acc.String(), sdk.NewInt(100)),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid account address",
+			modifyMsg:   func(m M) M { m.Account = "invalid account"; return m },
+			expectError: true,
+		},
+		{
+			name:        "clawback from sender",
+			modifyMsg:   func(m M) M { m.Account = m.Sender; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Coin.Denom = "abc"; return m },
+			expectError: true,
+		},
+		{
+			name:        "negative amount",
+			modifyMsg:   func(m M) M { m.Coin.Amount = sdk.NewInt(-100); return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgUpdateMetadata_ValidateBasic(t *testing.T) {
+	type M = types.MsgUpdateMetadata
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender:      acc.String(),
+			Denom:       "abc" + "-" + acc.String(),
+			Description: "ABC Desc",
+			URI:         "https://my-token-meta.invalid/1",
+			URIHash:     "content-hash",
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:      "empty metadata",
+			modifyMsg: func(m M) M { m.Description = ""; m.URI = ""; m.URIHash = ""; return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Denom = "abc"; return m },
+			expectError: true,
+		},
+		{
+			name:        "too long description",
+			modifyMsg:   func(m M) M { m.Description = strings.Repeat("x", types.MaxDescriptionLength+1); return m },
+			expectError: true,
+		},
+		{
+			name:        "too long URI",
+			modifyMsg:   func(m M) M { m.URI = strings.Repeat("x", types.MaxURILength+1); return m },
+			expectError: true,
+		},
+		{
+			name:        "too long URI hash",
+			modifyMsg:   func(m M) M { m.URIHash = strings.Repeat("x", types.MaxURIHashLength+1); return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+//nolint:dupl // tests for add and remove from blacklist are identical, but merging them is not beneficial
+func TestMsgAddToBlacklist_ValidateBasic(t *testing.T) {
+	type M = types.MsgAddToBlacklist
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender:  acc.String(),
+			Account: account.String(),
+			Denom:   "abc" + "-" + acc.String(),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid account address",
+			modifyMsg:   func(m M) M { m.Account = "invalid account"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Denom = "abc"; return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+//nolint:dupl // tests for add and remove from blacklist are identical, but merging them is not beneficial
+func TestMsgRemoveFromBlacklist_ValidateBasic(t *testing.T) {
+	type M = types.MsgRemoveFromBlacklist
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender:  acc.String(),
+			Account: account.String(),
+			Denom:   "abc" + "-" + acc.String(),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid account address",
+			modifyMsg:   func(m M) M { m.Account = "invalid account"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Denom = "abc"; return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgGrantMintAllowance_ValidateBasic(t *testing.T) {
+	type M = types.MsgGrantMintAllowance
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	minter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender: acc.String(),
+			Minter: minter.String(),
+			Coin:   sdk.NewCoin("abc"+"-"+acc.String(), sdk.NewInt(100)),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid minter address",
+			modifyMsg:   func(m M) M { m.Minter = "invalid minter"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Coin.Denom = "abc"; return m },
+			expectError: true,
+		},
+		{
+			name:        "zero amount",
+			modifyMsg:   func(m M) M { m.Coin.Amount = sdk.ZeroInt(); return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgRevokeMintAllowance_ValidateBasic(t *testing.T) {
+	type M = types.MsgRevokeMintAllowance
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	minter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender: acc.String(),
+			Minter: minter.String(),
+			Denom:  "abc" + "-" + acc.String(),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid minter address",
+			modifyMsg:   func(m M) M { m.Minter = "invalid minter"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Denom = "abc"; return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgAddRateExemption_ValidateBasic(t *testing.T) {
+	type M = types.MsgAddRateExemption
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender:  acc.String(),
+			Account: account.String(),
+			Denom:   "abc" + "-" + acc.String(),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid account address",
+			modifyMsg:   func(m M) M { m.Account = "invalid account"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Denom = "abc"; return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgRemoveRateExemption_ValidateBasic(t *testing.T) {
+	type M = types.MsgRemoveRateExemption
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender:  acc.String(),
+			Account: account.String(),
+			Denom:   "abc" + "-" + acc.String(),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid account address",
+			modifyMsg:   func(m M) M { m.Account = "invalid account"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Denom = "abc"; return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgUpdateCommissionRecipients_ValidateBasic(t *testing.T) {
+	type M = types.MsgUpdateCommissionRecipients
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender: acc.String(),
+			Denom:  "abc" + "-" + acc.String(),
+			Recipients: []types.CommissionRecipient{
+				{Address: recipient1.String(), Weight: 3},
+				{Address: recipient2.String(), Weight: 1},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:      "empty recipients",
+			modifyMsg: func(m M) M { m.Recipients = nil; return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Denom = "abc"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid recipient address",
+			modifyMsg:   func(m M) M { m.Recipients[0].Address = "invalid recipient"; return m },
+			expectError: true,
+		},
+		{
+			name:        "zero weight",
+			modifyMsg:   func(m M) M { m.Recipients[1].Weight = 0; return m },
+			expectError: true,
+		},
+		{
+			name:        "duplicated recipient",
+			modifyMsg:   func(m M) M { m.Recipients[1].Address = recipient1.String(); return m },
+			expectError: true,
+		},
+		{
+			name: "too many recipients",
+			modifyMsg: func(m M) M {
+				m.Recipients = nil
+				for i := 0; i <= types.MaxCommissionRecipients; i++ {
+					m.Recipients = append(m.Recipients, types.CommissionRecipient{
+						Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+						Weight:  1,
+					})
+				}
+				return m
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
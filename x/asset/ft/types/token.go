@@ -29,6 +29,16 @@ const (
 	denomSeparator = "-"
 	// MaxPrecision used when issuing a token.
 	MaxPrecision = 20
+	// MaxDescriptionLength is the max length of the token description.
+	MaxDescriptionLength = 200
+	// MaxURILength is the max length of the token URI.
+	MaxURILength = 256
+	// MaxURIHashLength is the max length of the token URI hash.
+	MaxURIHashLength = 128
+	// MaxCommissionRecipients is the max number of the send commission recipients of the token.
+	MaxCommissionRecipients = 10
+	// ExtensionGasLimit is the max amount of gas the extension contract may consume to check a single transfer.
+	ExtensionGasLimit = 400_000
 )
 
 func init() {
@@ -47,6 +57,10 @@ type IssueSettings struct {
 	Features           []Feature
 	BurnRate           sdk.Dec
 	SendCommissionRate sdk.Dec
+	URI                string
+	URIHash            string
+	MaxSupply          sdk.Int
+	ExtensionContract  string
 }
 
 // BuildDenom builds the denom string from the symbol and issuer address.
@@ -124,6 +138,85 @@ func ValidatePrecision(precision uint32) error {
 	return nil
 }
 
+// ValidateMetadata checks the provided description, URI and URI hash are valid.
+func ValidateMetadata(description, uri, uriHash string) error {
+	if len(description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid description %q, the length must be less than %d", description, MaxDescriptionLength)
+	}
+
+	if len(uri) > MaxURILength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must be less than or equal %d", uri, MaxURILength)
+	}
+
+	if len(uriHash) > MaxURIHashLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", uriHash, MaxURIHashLength)
+	}
+
+	return nil
+}
+
+// ValidateMaxSupply checks the provided max supply is valid and is not lower than the initial amount.
+// Nil or zero max supply means the supply is unlimited.
+func ValidateMaxSupply(maxSupply, initialAmount sdk.Int) error {
+	if maxSupply.IsNil() || maxSupply.IsZero() {
+		return nil
+	}
+
+	if maxSupply.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid max supply %s, can't be negative", maxSupply.String())
+	}
+
+	if !initialAmount.IsNil() && initialAmount.GT(maxSupply) {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"initial amount %s is greater than max supply %s",
+			initialAmount.String(),
+			maxSupply.String(),
+		)
+	}
+
+	return nil
+}
+
+// ValidateCommissionRecipients checks the provided send commission recipients are valid.
+// Empty list is valid and means the send commission is sent to the admin.
+func ValidateCommissionRecipients(recipients []CommissionRecipient) error {
+	if len(recipients) > MaxCommissionRecipients {
+		return sdkerrors.Wrapf(ErrInvalidInput, "the number of commission recipients must be less than or equal %d", MaxCommissionRecipients)
+	}
+
+	addresses := make(map[string]struct{}, len(recipients))
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid commission recipient %s", recipient.Address)
+		}
+		if recipient.Weight == 0 {
+			return sdkerrors.Wrapf(ErrInvalidInput, "weight of the commission recipient %s must be positive", recipient.Address)
+		}
+		if _, ok := addresses[recipient.Address]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated commission recipient %s", recipient.Address)
+		}
+		addresses[recipient.Address] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateExtensionContract checks the extension contract is set if and only if the extension feature is enabled.
+func ValidateExtensionContract(features []Feature, extensionContract string) error {
+	if !lo.Contains(features, Feature_extension) {
+		if extensionContract != "" {
+			return sdkerrors.Wrap(ErrInvalidInput, "extension contract can be set only if the extension feature is enabled")
+		}
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(extensionContract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid extension contract %s", extensionContract)
+	}
+	return nil
+}
+
 // ValidateSymbol checks the provided symbol is valid.
 func ValidateSymbol(symbol string) error {
 	if lo.Contains(reserved, strings.ToLower(symbol)) {
@@ -158,12 +251,12 @@ func (def Definition) CheckFeatureAllowed(addr sdk.AccAddress, feature Feature)
 // IsFeatureAllowed returns true if feature is allowed for the address.
 func (def Definition) IsFeatureAllowed(addr sdk.Address, feature Feature) bool {
 	featureEnabled := def.IsFeatureEnabled(feature)
-	// issuer can use any enabled feature and burning even if it is disabled
-	if def.IsIssuer(addr) {
+	// admin can use any enabled feature and burning even if it is disabled
+	if def.IsAdmin(addr) {
 		return featureEnabled || feature == Feature_burning
 	}
 
-	// non-issuer can use only burning and only if it is enabled
+	// non-admin can use only burning and only if it is enabled
 	return featureEnabled && feature == Feature_burning
 }
 
@@ -177,6 +270,32 @@ func (def Definition) IsIssuer(addr sdk.Address) bool {
 	return def.Issuer == addr.String()
 }
 
+// IsAdmin returns true if the addr is the admin.
+func (def Definition) IsAdmin(addr sdk.Address) bool {
+	return def.Admin != "" && def.Admin == addr.String()
+}
+
+// IsMaxSupplySet returns true if the max supply of the token is limited.
+func (def Definition) IsMaxSupplySet() bool {
+	return !def.MaxSupply.IsNil() && def.MaxSupply.IsPositive()
+}
+
+// HasAdmin returns true if the admin of the token is set.
+func (def Definition) HasAdmin() bool {
+	return def.Admin != ""
+}
+
+// IsTransferRestricted returns true if the transfers of the token might be rejected or charged on top of the sent
+// amount by the features or rates of the token.
+func (def Definition) IsTransferRestricted() bool {
+	return def.IsFeatureEnabled(Feature_freezing) ||
+		def.IsFeatureEnabled(Feature_whitelisting) ||
+		def.IsFeatureEnabled(Feature_blacklisting) ||
+		def.IsFeatureEnabled(Feature_extension) ||
+		(!def.BurnRate.IsNil() && def.BurnRate.IsPositive()) ||
+		(!def.SendCommissionRate.IsNil() && def.SendCommissionRate.IsPositive())
+}
+
 // ValidateBurnRate checks that the provided burn rate is valid.
 func ValidateBurnRate(burnRate sdk.Dec) error {
 	if err := validateRate(burnRate); err != nil {
@@ -5,19 +5,23 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,10 +33,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Feature int32
 
 const (
-	Feature_minting      Feature = 0
-	Feature_burning      Feature = 1
-	Feature_freezing     Feature = 2
-	Feature_whitelisting Feature = 3
+	Feature_minting           Feature = 0
+	Feature_burning           Feature = 1
+	Feature_freezing          Feature = 2
+	Feature_whitelisting      Feature = 3
+	Feature_clawback          Feature = 4
+	Feature_metadata_updating Feature = 5
+	Feature_blacklisting      Feature = 6
+	Feature_extension         Feature = 7
 )
 
 var Feature_name = map[int32]string{
@@ -40,13 +48,21 @@ var Feature_name = map[int32]string{
 	1: "burning",
 	2: "freezing",
 	3: "whitelisting",
+	4: "clawback",
+	5: "metadata_updating",
+	6: "blacklisting",
+	7: "extension",
 }
 
 var Feature_value = map[string]int32{
-	"minting":      0,
-	"burning":      1,
-	"freezing":     2,
-	"whitelisting": 3,
+	"minting":           0,
+	"burning":           1,
+	"freezing":          2,
+	"whitelisting":      3,
+	"clawback":          4,
+	"metadata_updating": 5,
+	"blacklisting":      6,
+	"extension":         7,
 }
 
 func (x Feature) String() string {
@@ -68,6 +84,19 @@ type Definition struct {
 	// send_commission_rate is a number between 0 and 1 which will be multiplied by send amount to determine
 	// amount sent to the token issuer account.
 	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
+	// admin is the account allowed to perform privileged operations on the token.
+	// It is set to the issuer on issuance and might be transferred or cleared later.
+	Admin   string `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty"`
+	URI     string `protobuf:"bytes,7,opt,name=uri,proto3" json:"uri,omitempty"`
+	URIHash string `protobuf:"bytes,8,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// max_supply is the maximum total supply of the token, zero means the supply is unlimited.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// commission_recipients are the accounts receiving the send commission proportionally to their weights.
+	// If the list is empty, the send commission is sent to the admin.
+	CommissionRecipients []CommissionRecipient `protobuf:"bytes,10,rep,name=commission_recipients,json=commissionRecipients,proto3" json:"commission_recipients"`
+	// extension_contract is the address of the smart contract called to check every transfer of the token.
+	// It is set only if the extension feature is enabled.
+	ExtensionContract string `protobuf:"bytes,11,opt,name=extension_contract,json=extensionContract,proto3" json:"extension_contract,omitempty"`
 }
 
 func (m *Definition) Reset()         { *m = Definition{} }
@@ -119,6 +148,16 @@ type Token struct {
 	// send_commission_rate is a number between 0 and 1 which will be multiplied by send amount to determine
 	// amount sent to the token issuer account.
 	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
+	// admin is the account allowed to perform privileged operations on the token.
+	Admin   string `protobuf:"bytes,11,opt,name=admin,proto3" json:"admin,omitempty"`
+	URI     string `protobuf:"bytes,12,opt,name=uri,proto3" json:"uri,omitempty"`
+	URIHash string `protobuf:"bytes,13,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// max_supply is the maximum total supply of the token, zero means the supply is unlimited.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// commission_recipients are the accounts receiving the send commission proportionally to their weights.
+	CommissionRecipients []CommissionRecipient `protobuf:"bytes,15,rep,name=commission_recipients,json=commissionRecipients,proto3" json:"commission_recipients"`
+	// extension_contract is the address of the smart contract called to check every transfer of the token.
+	ExtensionContract string `protobuf:"bytes,16,opt,name=extension_contract,json=extensionContract,proto3" json:"extension_contract,omitempty"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
@@ -154,48 +193,185 @@ func (m *Token) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Token proto.InternalMessageInfo
 
+// CommissionRecipient defines the account receiving the part of the send commission proportional to its weight.
+type CommissionRecipient struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight  uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *CommissionRecipient) Reset()         { *m = CommissionRecipient{} }
+func (m *CommissionRecipient) String() string { return proto.CompactTextString(m) }
+func (*CommissionRecipient) ProtoMessage()    {}
+func (*CommissionRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{2}
+}
+func (m *CommissionRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionRecipient.Merge(m, src)
+}
+func (m *CommissionRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionRecipient proto.InternalMessageInfo
+
+func (m *CommissionRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CommissionRecipient) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// TimedFreeze defines an amount of fungible token frozen on the account until the unlock time.
+type TimedFreeze struct {
+	Account    string     `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Coin       types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+	UnlockTime time.Time  `protobuf:"bytes,3,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+}
+
+func (m *TimedFreeze) Reset()         { *m = TimedFreeze{} }
+func (m *TimedFreeze) String() string { return proto.CompactTextString(m) }
+func (*TimedFreeze) ProtoMessage()    {}
+func (*TimedFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{3}
+}
+func (m *TimedFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimedFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimedFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimedFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimedFreeze.Merge(m, src)
+}
+func (m *TimedFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimedFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimedFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimedFreeze proto.InternalMessageInfo
+
+func (m *TimedFreeze) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *TimedFreeze) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *TimedFreeze) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("coreum.asset.ft.v1.Feature", Feature_name, Feature_value)
 	proto.RegisterType((*Definition)(nil), "coreum.asset.ft.v1.Definition")
 	proto.RegisterType((*Token)(nil), "coreum.asset.ft.v1.Token")
+	proto.RegisterType((*CommissionRecipient)(nil), "coreum.asset.ft.v1.CommissionRecipient")
+	proto.RegisterType((*TimedFreeze)(nil), "coreum.asset.ft.v1.TimedFreeze")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/token.proto", fileDescriptor_fe80c7a2c55589e7) }
 
 var fileDescriptor_fe80c7a2c55589e7 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xb5, 0x63, 0x92, 0x38, 0xd3, 0x52, 0xaa, 0x51, 0x54, 0x59, 0x05, 0x39, 0x51, 0x17, 0x10,
-	0x21, 0xe1, 0x51, 0xe8, 0x02, 0x89, 0x65, 0x53, 0x65, 0x83, 0xd8, 0x58, 0xac, 0xd8, 0x04, 0x3f,
-	0xae, 0xdd, 0x51, 0xed, 0x99, 0x68, 0x66, 0x1c, 0x48, 0xbf, 0x80, 0x25, 0x9f, 0xd0, 0x0f, 0xe0,
-	0x2b, 0x58, 0x75, 0xd9, 0x25, 0x62, 0x51, 0xa1, 0x64, 0xc3, 0x67, 0xa0, 0x19, 0xbb, 0x0f, 0xc4,
-	0x8a, 0x88, 0xae, 0xec, 0x73, 0xee, 0xf5, 0xf1, 0xb9, 0xf7, 0xe8, 0x22, 0x3f, 0xe1, 0x02, 0xaa,
-	0x92, 0x44, 0x52, 0x82, 0x22, 0x99, 0x22, 0x8b, 0x31, 0x51, 0xfc, 0x14, 0x58, 0x30, 0x17, 0x5c,
-	0x71, 0x8c, 0xeb, 0x7a, 0x60, 0xea, 0x41, 0xa6, 0x82, 0xc5, 0x78, 0xbf, 0x9f, 0xf3, 0x9c, 0x9b,
-	0x32, 0xd1, 0x6f, 0x75, 0xe7, 0xbe, 0x9f, 0x70, 0x59, 0x72, 0x49, 0xe2, 0x48, 0x02, 0x59, 0x8c,
-	0x63, 0x50, 0xd1, 0x98, 0x24, 0x9c, 0x36, 0x4a, 0x07, 0x5f, 0x5b, 0x08, 0x1d, 0x43, 0x46, 0x19,
-	0x55, 0x94, 0x33, 0xdc, 0x47, 0xed, 0x14, 0x18, 0x2f, 0x3d, 0x7b, 0x68, 0x8f, 0x7a, 0x61, 0x0d,
-	0xf0, 0x1e, 0xea, 0x50, 0x29, 0x2b, 0x10, 0x5e, 0xcb, 0xd0, 0x0d, 0xc2, 0xaf, 0x90, 0x9b, 0x41,
-	0xa4, 0x2a, 0x01, 0xd2, 0x73, 0x86, 0xce, 0x68, 0xe7, 0xe5, 0xe3, 0xe0, 0x6f, 0x67, 0xc1, 0xb4,
-	0xee, 0x09, 0x6f, 0x9a, 0xf1, 0x1b, 0xd4, 0x8b, 0x2b, 0xc1, 0x66, 0x22, 0x52, 0xe0, 0x3d, 0xd0,
-	0x9a, 0x47, 0xc1, 0xc5, 0xd5, 0xc0, 0xfa, 0x71, 0x35, 0x78, 0x9a, 0x53, 0x75, 0x52, 0xc5, 0x41,
-	0xc2, 0x4b, 0xd2, 0x78, 0xaf, 0x1f, 0x2f, 0x64, 0x7a, 0x4a, 0xd4, 0x72, 0x0e, 0x32, 0x38, 0x86,
-	0x24, 0x74, 0xb5, 0x40, 0x18, 0x29, 0xc0, 0x1f, 0x50, 0x5f, 0x02, 0x4b, 0x67, 0x09, 0x2f, 0x4b,
-	0x2a, 0x25, 0xe5, 0x8d, 0x6e, 0x7b, 0x23, 0x5d, 0xac, 0xb5, 0x26, 0x37, 0x52, 0xfa, 0x0f, 0xaf,
-	0xdd, 0xcf, 0xe7, 0x03, 0xeb, 0xd7, 0xf9, 0xc0, 0x3a, 0xf8, 0xe6, 0xa0, 0xf6, 0x3b, 0x1d, 0xc4,
-	0x3f, 0x6e, 0x6a, 0x0f, 0x75, 0xe4, 0xb2, 0x8c, 0x79, 0xe1, 0x39, 0x35, 0x5f, 0x23, 0xec, 0xa1,
-	0xae, 0xac, 0xe2, 0x8a, 0x51, 0x55, 0xaf, 0x21, 0xbc, 0x86, 0xf8, 0x09, 0xea, 0xcd, 0x05, 0x24,
-	0x54, 0x9b, 0x30, 0xa3, 0x3c, 0x0c, 0x6f, 0x09, 0x3c, 0x44, 0x5b, 0x29, 0xc8, 0x44, 0xd0, 0xb9,
-	0x8e, 0xcd, 0xeb, 0x98, 0x6f, 0xef, 0x52, 0xf8, 0x19, 0x7a, 0x94, 0x17, 0x3c, 0x8e, 0x8a, 0x62,
-	0x39, 0xcb, 0x04, 0x3f, 0x03, 0xe6, 0x75, 0x87, 0xf6, 0xc8, 0x0d, 0x77, 0xae, 0xe9, 0xa9, 0x61,
-	0xff, 0x08, 0xd1, 0xdd, 0x38, 0xc4, 0xde, 0x3d, 0x85, 0x88, 0xfe, 0x7f, 0x88, 0xcf, 0x27, 0xa8,
-	0xdb, 0x4c, 0x83, 0xb7, 0x50, 0xb7, 0xa4, 0x4c, 0x51, 0x96, 0xef, 0x5a, 0x1a, 0x68, 0x3f, 0x1a,
-	0xd8, 0x78, 0x1b, 0xb9, 0x99, 0x00, 0x38, 0xd3, 0xa8, 0x85, 0x77, 0xd1, 0xf6, 0xc7, 0x13, 0xaa,
-	0xa0, 0xa0, 0xd2, 0x34, 0x3b, 0x47, 0x6f, 0x2f, 0x56, 0xbe, 0x7d, 0xb9, 0xf2, 0xed, 0x9f, 0x2b,
-	0xdf, 0xfe, 0xb2, 0xf6, 0xad, 0xcb, 0xb5, 0x6f, 0x7d, 0x5f, 0xfb, 0xd6, 0xfb, 0xc3, 0x3b, 0x26,
-	0x27, 0x66, 0x91, 0x53, 0x5e, 0xb1, 0x34, 0xd2, 0xb1, 0x90, 0xe6, 0xb0, 0x3f, 0xdd, 0x9e, 0xb6,
-	0x71, 0x1d, 0x77, 0xcc, 0x39, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x2a, 0xa0, 0x2e, 0x15,
-	0xfa, 0x03, 0x00, 0x00,
+	// 839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4d, 0x6f, 0xdb, 0x36,
+	0x18, 0xc7, 0xad, 0xfa, 0x4d, 0x7e, 0x94, 0xa4, 0x2e, 0xe7, 0x16, 0x6a, 0x36, 0xd8, 0x41, 0x0e,
+	0x6d, 0x30, 0xa0, 0x14, 0x92, 0x1c, 0x06, 0xec, 0x98, 0x74, 0xd9, 0x82, 0xa1, 0x17, 0x2d, 0xbd,
+	0xec, 0xe2, 0x51, 0x14, 0x6d, 0x13, 0x96, 0x48, 0x41, 0x24, 0xf3, 0xd2, 0x4f, 0xb0, 0xdd, 0xfa,
+	0x11, 0x0a, 0xec, 0xcb, 0xf4, 0xd8, 0xe3, 0xb0, 0x43, 0x36, 0x38, 0x87, 0xed, 0x4b, 0x0c, 0x18,
+	0x48, 0xc9, 0x6e, 0x86, 0x06, 0xe8, 0x5a, 0x34, 0x27, 0xeb, 0xff, 0x3c, 0x0f, 0xff, 0x7c, 0xfb,
+	0xd1, 0x0f, 0x0c, 0xa9, 0x2c, 0x99, 0xc9, 0x23, 0xa2, 0x14, 0xd3, 0xd1, 0x44, 0x47, 0xa7, 0xbb,
+	0x91, 0x96, 0x73, 0x26, 0x70, 0x51, 0x4a, 0x2d, 0x11, 0xaa, 0xf2, 0xd8, 0xe5, 0xf1, 0x44, 0xe3,
+	0xd3, 0xdd, 0xcd, 0xc1, 0x54, 0x4e, 0xa5, 0x4b, 0x47, 0xf6, 0xab, 0xaa, 0xdc, 0x1c, 0x4d, 0xa5,
+	0x9c, 0x66, 0x2c, 0x72, 0x2a, 0x31, 0x93, 0x48, 0xf3, 0x9c, 0x29, 0x4d, 0xf2, 0xa2, 0x2e, 0x18,
+	0x52, 0xa9, 0x72, 0xa9, 0xa2, 0x84, 0x28, 0x16, 0x9d, 0xee, 0x26, 0x4c, 0x93, 0xdd, 0x88, 0x4a,
+	0x5e, 0x4f, 0xb5, 0xfd, 0x57, 0x0b, 0xe0, 0x29, 0x9b, 0x70, 0xc1, 0x35, 0x97, 0x02, 0x0d, 0xa0,
+	0x9d, 0x32, 0x21, 0xf3, 0xd0, 0xdb, 0xf2, 0x76, 0x7a, 0x71, 0x25, 0xd0, 0x03, 0xe8, 0x70, 0xa5,
+	0x0c, 0x2b, 0xc3, 0x3b, 0x2e, 0x5c, 0x2b, 0xf4, 0x15, 0xf8, 0x13, 0x46, 0xb4, 0x29, 0x99, 0x0a,
+	0x9b, 0x5b, 0xcd, 0x9d, 0x8d, 0xbd, 0xcf, 0xf1, 0xbb, 0x4b, 0xc7, 0x47, 0x55, 0x4d, 0xbc, 0x2a,
+	0x46, 0xdf, 0x43, 0x2f, 0x31, 0xa5, 0x18, 0x97, 0x44, 0xb3, 0xb0, 0x65, 0x3d, 0x0f, 0xf0, 0xeb,
+	0xcb, 0x51, 0xe3, 0xf7, 0xcb, 0xd1, 0xa3, 0x29, 0xd7, 0x33, 0x93, 0x60, 0x2a, 0xf3, 0xa8, 0x5e,
+	0x7b, 0xf5, 0xf3, 0x44, 0xa5, 0xf3, 0x48, 0x5f, 0x14, 0x4c, 0xe1, 0xa7, 0x8c, 0xc6, 0xbe, 0x35,
+	0x88, 0x89, 0x66, 0xe8, 0x27, 0x18, 0x28, 0x26, 0xd2, 0x31, 0x95, 0x79, 0xce, 0x95, 0xe2, 0xb2,
+	0xf6, 0x6d, 0x7f, 0x94, 0x2f, 0xb2, 0x5e, 0x87, 0x2b, 0x2b, 0x37, 0xc3, 0x00, 0xda, 0x24, 0xcd,
+	0xb9, 0x08, 0x3b, 0xd5, 0xa9, 0x38, 0x81, 0x1e, 0x42, 0xd3, 0x94, 0x3c, 0xec, 0xba, 0x69, 0xba,
+	0x8b, 0xcb, 0x51, 0xf3, 0x79, 0x7c, 0x1c, 0xdb, 0x18, 0x7a, 0x04, 0xbe, 0x29, 0xf9, 0x78, 0x46,
+	0xd4, 0x2c, 0xf4, 0x5d, 0x3e, 0x58, 0x5c, 0x8e, 0xba, 0xcf, 0xe3, 0xe3, 0xef, 0x88, 0x9a, 0xc5,
+	0x5d, 0x53, 0x72, 0xfb, 0x81, 0x9e, 0x01, 0xe4, 0xe4, 0x7c, 0xac, 0x4c, 0x51, 0x64, 0x17, 0x61,
+	0xef, 0x83, 0x17, 0x7c, 0x2c, 0x74, 0xdc, 0xcb, 0xc9, 0xf9, 0x0f, 0xce, 0x00, 0x25, 0x70, 0xff,
+	0xfa, 0x21, 0x30, 0xca, 0x0b, 0xce, 0x84, 0x56, 0x21, 0x6c, 0x35, 0x77, 0x82, 0xbd, 0xc7, 0x37,
+	0x5d, 0xce, 0xb5, 0xad, 0x2e, 0xeb, 0x0f, 0x5a, 0x76, 0x09, 0xf1, 0x80, 0xbe, 0x9b, 0x52, 0xe8,
+	0x09, 0x20, 0x76, 0xae, 0x99, 0x70, 0x53, 0x50, 0x29, 0x74, 0x49, 0xa8, 0x0e, 0x03, 0x77, 0x30,
+	0xf7, 0x56, 0x99, 0xc3, 0x3a, 0xf1, 0xb5, 0xff, 0xf3, 0xab, 0x51, 0xe3, 0xef, 0x57, 0xa3, 0xc6,
+	0xf6, 0x3f, 0x6d, 0x68, 0x9f, 0x58, 0xc8, 0x3f, 0x10, 0xb2, 0x07, 0xd0, 0x51, 0x17, 0x79, 0x22,
+	0xb3, 0xb0, 0x59, 0xc5, 0x2b, 0x85, 0x42, 0xe8, 0x2a, 0x93, 0x18, 0xc1, 0x75, 0x45, 0x50, 0xbc,
+	0x94, 0xe8, 0x0b, 0xe8, 0x15, 0x76, 0xf3, 0x76, 0x21, 0x8e, 0x82, 0xf5, 0xf8, 0x6d, 0x00, 0x6d,
+	0x41, 0x90, 0x32, 0x45, 0x4b, 0x5e, 0x58, 0xe2, 0xeb, 0x2b, 0xbd, 0x1e, 0x42, 0x8f, 0xe1, 0xee,
+	0x34, 0x93, 0x09, 0xc9, 0xb2, 0x8b, 0xf1, 0xa4, 0x94, 0x2f, 0x98, 0x70, 0x97, 0xec, 0xc7, 0x1b,
+	0xcb, 0xf0, 0x91, 0x8b, 0xfe, 0x87, 0x7f, 0xff, 0xa3, 0xf9, 0xef, 0xdd, 0x12, 0xff, 0xf0, 0xe9,
+	0xf9, 0x0f, 0x6e, 0xe0, 0x7f, 0xed, 0x3d, 0xfc, 0xaf, 0xff, 0x6f, 0xfe, 0x37, 0x6e, 0x8d, 0xff,
+	0xbb, 0xb7, 0xcd, 0x7f, 0xff, 0xfd, 0xfc, 0x7f, 0x0b, 0x9f, 0xdd, 0x30, 0x97, 0xc5, 0x98, 0xa4,
+	0x69, 0xc9, 0x94, 0xaa, 0x9f, 0xc3, 0x52, 0x5a, 0xf0, 0xcf, 0x18, 0x9f, 0xce, 0xb4, 0x7b, 0x10,
+	0xeb, 0x71, 0xad, 0xb6, 0x7f, 0xf5, 0x20, 0x38, 0xe1, 0x39, 0x4b, 0x8f, 0x4a, 0xc6, 0x5e, 0x30,
+	0xe7, 0x40, 0xa9, 0x34, 0x42, 0xaf, 0x1c, 0x2a, 0x89, 0xf6, 0xa1, 0x65, 0xff, 0xea, 0xdd, 0xf8,
+	0x60, 0xef, 0x21, 0xae, 0xce, 0x0f, 0xdb, 0x5e, 0x80, 0xeb, 0x5e, 0x80, 0x0f, 0x25, 0x17, 0xf5,
+	0x86, 0x5d, 0x31, 0xfa, 0x06, 0x02, 0x23, 0x32, 0x49, 0xe7, 0x63, 0xdb, 0x4b, 0xdc, 0xa3, 0x0b,
+	0xf6, 0x36, 0x71, 0xd5, 0x68, 0xf0, 0xb2, 0xd1, 0xe0, 0x93, 0x65, 0xa3, 0x39, 0xf0, 0xed, 0xe0,
+	0x97, 0x7f, 0x8c, 0xbc, 0x18, 0xaa, 0x81, 0x36, 0xf5, 0xe5, 0x2f, 0x1e, 0x74, 0x6b, 0xf0, 0x51,
+	0x00, 0xdd, 0x9c, 0x0b, 0xcd, 0xc5, 0xb4, 0xdf, 0xb0, 0xc2, 0xa2, 0x6b, 0x85, 0x87, 0xd6, 0xc0,
+	0x9f, 0xd8, 0x5d, 0x58, 0x75, 0x07, 0xf5, 0x61, 0xed, 0x6c, 0xc6, 0x35, 0xcb, 0xb8, 0x72, 0xc5,
+	0x4d, 0x9b, 0xa7, 0x19, 0x39, 0x4b, 0x08, 0x9d, 0xf7, 0x5b, 0xe8, 0x3e, 0xdc, 0xcb, 0x99, 0x26,
+	0x29, 0xd1, 0x64, 0x6c, 0x8a, 0x94, 0xb8, 0xa2, 0xb6, 0x1d, 0x96, 0x64, 0x84, 0xce, 0x97, 0xc3,
+	0x3a, 0x68, 0x1d, 0x7a, 0xab, 0xab, 0xe8, 0x77, 0x0f, 0x9e, 0xbd, 0x5e, 0x0c, 0xbd, 0x37, 0x8b,
+	0xa1, 0xf7, 0xe7, 0x62, 0xe8, 0xbd, 0xbc, 0x1a, 0x36, 0xde, 0x5c, 0x0d, 0x1b, 0xbf, 0x5d, 0x0d,
+	0x1b, 0x3f, 0xee, 0x5f, 0x83, 0xec, 0xd0, 0xc1, 0x71, 0x24, 0x8d, 0xb0, 0xce, 0x52, 0x44, 0x75,
+	0x97, 0x3e, 0x7f, 0xdb, 0xa7, 0x1d, 0x75, 0x49, 0xc7, 0x1d, 0xc2, 0xfe, 0xbf, 0x03, 0x00, 0x52,
+	0xda, 0x18, 0xc0, 0xc7, 0x07, 0x00, 0x00,
 }
 
 func (m *Definition) Marshal() (dAtA []byte, err error) {
@@ -218,6 +394,58 @@ func (m *Definition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtensionContract) > 0 {
+		i -= len(m.ExtensionContract)
+		copy(dAtA[i:], m.ExtensionContract)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.ExtensionContract)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CommissionRecipients) > 0 {
+		for iNdEx := len(m.CommissionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size := m.SendCommissionRate.Size()
 		i -= size
@@ -293,6 +521,60 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtensionContract) > 0 {
+		i -= len(m.ExtensionContract)
+		copy(dAtA[i:], m.ExtensionContract)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.ExtensionContract)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.CommissionRecipients) > 0 {
+		for iNdEx := len(m.CommissionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	{
 		size := m.SendCommissionRate.Size()
 		i -= size
@@ -384,6 +666,89 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommissionRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimedFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimedFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimedFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintToken(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovToken(v)
 	base := offset
@@ -420,6 +785,30 @@ func (m *Definition) Size() (n int) {
 	n += 1 + l + sovToken(uint64(l))
 	l = m.SendCommissionRate.Size()
 	n += 1 + l + sovToken(uint64(l))
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if len(m.CommissionRecipients) > 0 {
+		for _, e := range m.CommissionRecipients {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	l = len(m.ExtensionContract)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
 	return n
 }
 
@@ -466,6 +855,63 @@ func (m *Token) Size() (n int) {
 	n += 1 + l + sovToken(uint64(l))
 	l = m.SendCommissionRate.Size()
 	n += 1 + l + sovToken(uint64(l))
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if len(m.CommissionRecipients) > 0 {
+		for _, e := range m.CommissionRecipients {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	l = len(m.ExtensionContract)
+	if l > 0 {
+		n += 2 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *CommissionRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovToken(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *TimedFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovToken(uint64(l))
 	return n
 }
 
@@ -705,32 +1151,228 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipToken(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthToken
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Token) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionRecipients = append(m.CommissionRecipients, CommissionRecipient{})
+			if err := m.CommissionRecipients[len(m.CommissionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1091,6 +1733,451 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionRecipients = append(m.CommissionRecipients, CommissionRecipient{})
+			if err := m.CommissionRecipients[len(m.CommissionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimedFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimedFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimedFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -343,6 +343,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 	type fields struct {
 		Denom              string
 		Issuer             string
+		Admin              string
 		Features           []types.Feature
 		BurnRate           sdk.Dec
 		SendCommissionRate sdk.Dec
@@ -361,6 +362,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_enabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_minting,
 				},
@@ -375,6 +377,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "burning_feature_always_enabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_burning,
 				},
@@ -389,6 +392,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "burning_feature_enabled_for_non_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 			},
 			args: args{
 				addr:    issuer,
@@ -400,6 +404,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_disabled_for_non_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_minting,
 				},
@@ -419,6 +424,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_disabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 			},
 			args: args{
 				addr:    issuer,
@@ -431,6 +437,60 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 				t.FailNow()
 			},
 		},
+		{
+			name: "minting_feature_enabled_for_transferred_admin",
+			fields: fields{
+				Issuer: issuer.String(),
+				Admin:  nonIssuer.String(),
+				Features: []types.Feature{
+					types.Feature_minting,
+				},
+			},
+			args: args{
+				addr:    nonIssuer,
+				feature: types.Feature_minting,
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "minting_feature_disabled_for_issuer_after_admin_transfer",
+			fields: fields{
+				Issuer: issuer.String(),
+				Admin:  nonIssuer.String(),
+				Features: []types.Feature{
+					types.Feature_minting,
+				},
+			},
+			args: args{
+				addr:    issuer,
+				feature: types.Feature_minting,
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				if assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized) {
+					return
+				}
+				t.FailNow()
+			},
+		},
+		{
+			name: "minting_feature_disabled_for_issuer_with_cleared_admin",
+			fields: fields{
+				Issuer: issuer.String(),
+				Features: []types.Feature{
+					types.Feature_minting,
+				},
+			},
+			args: args{
+				addr:    issuer,
+				feature: types.Feature_minting,
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				if assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized) {
+					return
+				}
+				t.FailNow()
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -438,6 +498,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			def := types.Definition{
 				Denom:              tt.fields.Denom,
 				Issuer:             tt.fields.Issuer,
+				Admin:              tt.fields.Admin,
 				Features:           tt.fields.Features,
 				BurnRate:           tt.fields.BurnRate,
 				SendCommissionRate: tt.fields.SendCommissionRate,
@@ -1,6 +1,8 @@
 package cli_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
@@ -56,3 +58,52 @@ func TestCmdTxBurn(t *testing.T) {
 	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxBurn(), args)
 	requireT.NoError(err)
 }
+
+func TestCmdTxMintBatch(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0.0",
+		types.ClassFeature_freezing,
+	)
+	dir := t.TempDir()
+
+	jsonFile := filepath.Join(dir, "tokens.json")
+	requireT.NoError(os.WriteFile(jsonFile, []byte(`[
+		{"id": "nft-1", "uri": "https://my-nft-meta.invalid/1", "uri_hash": "content-hash", "data": "raw data"},
+		{"id": "nft-2"}
+	]`), 0o600))
+	args := []string{classID, jsonFile}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxMintBatch(), args)
+	requireT.NoError(err)
+
+	csvFile := filepath.Join(dir, "tokens.csv")
+	requireT.NoError(os.WriteFile(csvFile, []byte("id,uri,uri_hash,data\nnft-3,https://my-nft-meta.invalid/3,content-hash,raw data\nnft-4,,\n"), 0o600))
+	args = []string{classID, csvFile}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxMintBatch(), args)
+	requireT.NoError(err)
+
+	// the frozen query fails if the nft doesn't exist
+	for _, id := range []string{"nft-1", "nft-2", "nft-3", "nft-4"} {
+		var resp types.QueryFrozenResponse
+		buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryFrozen(), []string{classID, id, "--output", "json"})
+		requireT.NoError(err)
+		requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+		requireT.False(resp.Frozen)
+	}
+}
@@ -1,12 +1,17 @@
 package cli
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/pkg/errors"
@@ -39,6 +44,8 @@ func GetTxCmd() *cobra.Command {
 		CmdTxUnfreeze(),
 		CmdTxWhitelist(),
 		CmdTxUnwhitelist(),
+		CmdTxSell(),
+		CmdTxMintBatch(),
 	)
 
 	return cmd
@@ -369,3 +376,163 @@ $ %s tx %s unwhitelist abc-%[3]s id1 %[3]s --from [sender]
 
 	return cmd
 }
+
+// CmdTxSell returns Sell cobra command.
+func CmdTxSell() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell [class-id] [id] [buyer] [price] --from [seller]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Sell a non-fungible token to the buyer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sell a non-fungible token to the buyer, the royalty is sent to the class issuer.
+The transaction must be signed by both the seller and the buyer.
+
+Example:
+$ %s tx %s sell abc-%[3]s id1 %[3]s 100000ucore --from [seller] --generate-only
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			seller := clientCtx.GetFromAddress()
+			classID := args[0]
+			ID := args[1]
+			buyer := args[2]
+			price, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return errors.Wrap(err, "invalid price")
+			}
+
+			msg := &types.MsgSell{
+				Seller:  seller.String(),
+				Buyer:   buyer,
+				ClassID: classID,
+				ID:      ID,
+				Price:   price,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxMintBatch returns MintBatch cobra command.
+func CmdTxMintBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-batch [class-id] [file] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Mint multiple non-fungible tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint multiple non-fungible tokens listed in the JSON or CSV file. If any of the tokens can't be minted, none of them is minted.
+The JSON file must contain the list of the tokens:
+[{"id": "id1", "uri": "https://my-nft-meta.invalid/1", "uri_hash": "e000624", "data": "raw data"}]
+The CSV file must contain a row for each token (the header row is optional):
+id,uri,uri_hash,data
+id1,https://my-nft-meta.invalid/1,e000624,raw data
+
+Example:
+$ %s tx %s mint-batch abc-%s tokens.csv --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			items, err := readMintBatchFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgMintBatch{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Items:   items,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// mintBatchFileItem defines the token listed in the file provided to the mint-batch command.
+//
+//nolint:tagliatelle // we keep the names same as in the proto definition
+type mintBatchFileItem struct {
+	ID      string `json:"id"`
+	URI     string `json:"uri"`
+	URIHash string `json:"uri_hash"`
+	Data    string `json:"data"`
+}
+
+func readMintBatchFile(path string) ([]types.MintBatchItem, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't read file %q", path)
+	}
+
+	var fileItems []mintBatchFileItem
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		reader := csv.NewReader(strings.NewReader(string(content)))
+		reader.FieldsPerRecord = -1
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't parse CSV file %q", path)
+		}
+		for i, record := range records {
+			if i == 0 && len(record) > 0 && record[0] == "id" {
+				continue
+			}
+			if len(record) < 3 || len(record) > 4 {
+				return nil, errors.Errorf("invalid row %d, the format must be id,uri,uri_hash[,data]", i+1)
+			}
+			fileItem := mintBatchFileItem{
+				ID:      record[0],
+				URI:     record[1],
+				URIHash: record[2],
+			}
+			if len(record) == 4 {
+				fileItem.Data = record[3]
+			}
+			fileItems = append(fileItems, fileItem)
+		}
+	} else if err := json.Unmarshal(content, &fileItems); err != nil {
+		return nil, errors.Wrapf(err, "can't parse JSON file %q", path)
+	}
+
+	items := make([]types.MintBatchItem, 0, len(fileItems))
+	for _, fileItem := range fileItems {
+		item := types.MintBatchItem{
+			ID:      fileItem.ID,
+			URI:     fileItem.URI,
+			URIHash: fileItem.URIHash,
+		}
+		if fileItem.Data != "" {
+			item.Data, err = codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte(fileItem.Data)})
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
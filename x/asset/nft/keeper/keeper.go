@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/pkg/errors"
 
@@ -255,6 +256,20 @@ func (k Keeper) Mint(ctx sdk.Context, settings types.MintSettings) error {
 	return nil
 }
 
+// MintBatch mints multiple non-fungible tokens. If any of the tokens can't be minted, none of them is minted.
+func (k Keeper) MintBatch(ctx sdk.Context, settings []types.MintSettings) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, s := range settings {
+		if err := k.Mint(cacheCtx, s); err != nil {
+			return sdkerrors.Wrapf(err, "can't mint ID %q", s.ID)
+		}
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
 // Burn burns non-fungible token.
 func (k Keeper) Burn(ctx sdk.Context, owner sdk.AccAddress, classID, id string) error {
 	ndfd, err := k.GetClassDefinition(ctx, classID)
@@ -670,6 +685,68 @@ func (k Keeper) SetWhitelisting(ctx sdk.Context, classID, nftID string, account
 	return nil
 }
 
+// Sell transfers the non-fungible token from the seller to the buyer in exchange for the price.
+// The royalty, calculated from the price using the royalty rate of the class, is sent to the class issuer
+// and the rest of the price to the seller.
+func (k Keeper) Sell(ctx sdk.Context, seller, buyer sdk.AccAddress, classID, nftID string, price sdk.Coin) error {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
+	}
+
+	if !k.nftKeeper.GetOwner(ctx, classID, nftID).Equals(seller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only owner can sell the nft")
+	}
+
+	// the seller is the owner, so the issuer is allowed to sell the NFT the same way it is allowed to send it
+	if err := k.isNFTSendable(ctx, classID, nftID); err != nil {
+		return err
+	}
+
+	if err := k.isNFTReceivable(ctx, classID, nftID, buyer); err != nil {
+		return err
+	}
+
+	royalty := sdk.NewCoin(price.Denom, sdk.ZeroInt())
+	if !classDefinition.IsIssuer(seller) && !classDefinition.RoyaltyRate.IsNil() {
+		royalty.Amount = classDefinition.RoyaltyRate.MulInt(price.Amount).Ceil().RoundInt()
+	}
+
+	// the royalty and the payment are sent in a single multi-send, so the burn rate and send commission of the
+	// fungible token used as the price are charged once for the whole price.
+	var outputs []banktypes.Output
+	if royalty.IsPositive() {
+		outputs = append(outputs, banktypes.Output{Address: classDefinition.Issuer, Coins: sdk.NewCoins(royalty)})
+	}
+	if sellerAmount := price.Sub(royalty); sellerAmount.IsPositive() {
+		outputs = append(outputs, banktypes.Output{Address: seller.String(), Coins: sdk.NewCoins(sellerAmount)})
+	}
+	if len(outputs) > 0 {
+		inputs := []banktypes.Input{{Address: buyer.String(), Coins: sdk.NewCoins(price)}}
+		if err := k.bankKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+			return sdkerrors.Wrapf(err, "can't send payment from %s", buyer.String())
+		}
+	}
+
+	if err := k.nftKeeper.Transfer(ctx, classID, nftID, buyer); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSold{
+		ClassId:          classID,
+		Id:               nftID,
+		Seller:           seller.String(),
+		Buyer:            buyer.String(),
+		Price:            price,
+		Royalty:          royalty,
+		RoyaltyRecipient: classDefinition.Issuer,
+	})
+}
+
 func (k Keeper) isNFTSendable(ctx sdk.Context, classID, nftID string) error {
 	classDefinition, err := k.GetClassDefinition(ctx, classID)
 	// we return nil here, since we want the original tests of the nft module to pass, but they
@@ -692,6 +769,10 @@ func (k Keeper) isNFTSendable(ctx sdk.Context, classID, nftID string) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft with classID:%s and ID:%s has sending disabled", classID, nftID)
 	}
 
+	return k.checkNotFrozen(ctx, classID, nftID)
+}
+
+func (k Keeper) checkNotFrozen(ctx sdk.Context, classID, nftID string) error {
 	frozen, err := k.IsFrozen(ctx, classID, nftID)
 	if err != nil {
 		if errors.Is(err, types.ErrFeatureDisabled) {
@@ -16,6 +16,7 @@ import (
 	"github.com/CoreumFoundation/coreum/pkg/config/constant"
 	"github.com/CoreumFoundation/coreum/testutil/event"
 	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
 )
 
@@ -151,6 +152,100 @@ func TestKeeper_Mint(t *testing.T) {
 	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
 }
 
+func TestKeeper_MintBatch(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 10_000_000),
+	}
+	nftKeeper.SetParams(ctx, nftParams)
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, addr, sdk.NewCoins(sdk.NewCoin(nftParams.MintFee.Denom, nftParams.MintFee.Amount.MulRaw(3)))))
+	classSettings := types.IssueClassSettings{
+		Issuer: addr,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_burning,
+		},
+	}
+
+	classID, err := nftKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+
+	dataValue, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte("metadata")})
+	requireT.NoError(err)
+	settings := []types.MintSettings{
+		{
+			Sender:  addr,
+			ClassID: classID,
+			ID:      "my-id-1",
+			URI:     "https://my-nft-meta.invalid/1",
+			URIHash: "content-hash",
+			Data:    dataValue,
+		},
+		{
+			Sender:  addr,
+			ClassID: classID,
+			ID:      "my-id-2",
+		},
+	}
+
+	requireT.NoError(nftKeeper.MintBatch(ctx, settings))
+	for _, s := range settings {
+		nft, found := testApp.NFTKeeper.GetNFT(ctx, classID, s.ID)
+		requireT.True(found)
+		requireT.Equal(s.URI, nft.Uri)
+		requireT.Equal(addr, testApp.NFTKeeper.GetOwner(ctx, classID, s.ID))
+	}
+
+	// check that mint fee was taken for each NFT
+	balance := bankKeeper.GetBalance(ctx, addr, constant.DenomDev)
+	requireT.Equal(nftParams.MintFee.Amount.String(), balance.Amount.String())
+
+	// mint the batch containing already minted NFT, nothing should be minted
+	err = nftKeeper.MintBatch(ctx, []types.MintSettings{
+		{
+			Sender:  addr,
+			ClassID: classID,
+			ID:      "my-id-3",
+		},
+		settings[0],
+	})
+	requireT.True(types.ErrInvalidInput.Is(err))
+	requireT.False(testApp.NFTKeeper.HasNFT(ctx, classID, "my-id-3"))
+	balance = bankKeeper.GetBalance(ctx, addr, constant.DenomDev)
+	requireT.Equal(nftParams.MintFee.Amount.String(), balance.Amount.String())
+
+	// mint the batch containing burnt NFT, nothing should be minted
+	requireT.NoError(nftKeeper.Burn(ctx, addr, classID, settings[1].ID))
+	err = nftKeeper.MintBatch(ctx, []types.MintSettings{
+		{
+			Sender:  addr,
+			ClassID: classID,
+			ID:      "my-id-3",
+		},
+		settings[1],
+	})
+	requireT.True(types.ErrInvalidInput.Is(err))
+	requireT.False(testApp.NFTKeeper.HasNFT(ctx, classID, "my-id-3"))
+
+	// try to mint from not issuer account
+	err = nftKeeper.MintBatch(ctx, []types.MintSettings{
+		{
+			Sender:  sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+			ClassID: classID,
+			ID:      "my-id-3",
+		},
+	})
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+	requireT.False(testApp.NFTKeeper.HasNFT(ctx, classID, "my-id-3"))
+}
+
 func TestKeeper_Burn(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
@@ -726,3 +821,184 @@ func TestKeeper_Whitelist_NonExistent(t *testing.T) {
 	requireT.Error(err)
 	requireT.True(types.ErrNFTNotFound.Is(err))
 }
+
+//nolint:funlen // this is complex test scenario and breaking it down is not beneficial
+func TestKeeper_Sell(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+		},
+		RoyaltyRate: sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+
+	nftID := "my-id"
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      nftID,
+	}))
+
+	seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	buyer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, seller, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1000))))
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1000))))
+
+	// the issuer sells without royalty
+	price := sdk.NewInt64Coin(constant.DenomDev, 500)
+	requireT.NoError(assetNFTKeeper.Sell(ctx, issuer, seller, classID, nftID, price))
+	requireT.Equal(seller.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+	requireT.Equal(price.String(), bankKeeper.GetBalance(ctx, issuer, constant.DenomDev).String())
+
+	// not owner can't sell
+	err = assetNFTKeeper.Sell(ctx, buyer, seller, classID, nftID, price)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// frozen NFT can't be sold
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, nftID))
+	err = assetNFTKeeper.Sell(ctx, seller, buyer, classID, nftID, price)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+	requireT.NoError(assetNFTKeeper.Unfreeze(ctx, issuer, classID, nftID))
+
+	// buyer can't pay more than it has, the cached context is used since the failed tx is reverted
+	cacheCtx, _ := ctx.CacheContext()
+	err = assetNFTKeeper.Sell(cacheCtx, seller, buyer, classID, nftID, sdk.NewInt64Coin(constant.DenomDev, 1001))
+	requireT.True(sdkerrors.ErrInsufficientFunds.Is(err))
+
+	// the royalty is sent to the issuer
+	price = sdk.NewInt64Coin(constant.DenomDev, 333)
+	requireT.NoError(assetNFTKeeper.Sell(ctx, seller, buyer, classID, nftID, price))
+	requireT.Equal(buyer.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+	// 333 * 0.1 rounded up
+	requireT.Equal(sdk.NewInt(534), bankKeeper.GetBalance(ctx, issuer, constant.DenomDev).Amount)
+	requireT.Equal(sdk.NewInt(799), bankKeeper.GetBalance(ctx, seller, constant.DenomDev).Amount)
+	requireT.Equal(sdk.NewInt(667), bankKeeper.GetBalance(ctx, buyer, constant.DenomDev).Amount)
+
+	soldEvents, err := event.FindTypedEvents[*types.EventSold](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(soldEvents, 2)
+	requireT.Equal(types.EventSold{
+		ClassId:          classID,
+		Id:               nftID,
+		Seller:           seller.String(),
+		Buyer:            buyer.String(),
+		Price:            price,
+		Royalty:          sdk.NewInt64Coin(constant.DenomDev, 34),
+		RoyaltyRecipient: issuer.String(),
+	}, *soldEvents[1])
+}
+
+func TestKeeper_SellWithDisabledSending(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	assetNFTKeeper.SetParams(ctx, types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	})
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_disable_sending,
+		},
+	})
+	requireT.NoError(err)
+
+	nftID := "my-id"
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      nftID,
+	}))
+
+	seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	buyer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, seller, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1000))))
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1000))))
+
+	// the issuer is allowed to sell
+	price := sdk.NewInt64Coin(constant.DenomDev, 1)
+	requireT.NoError(assetNFTKeeper.Sell(ctx, issuer, seller, classID, nftID, price))
+	requireT.Equal(seller.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+
+	// the holder can neither send nor sell
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.Transfer(ctx, classID, nftID, buyer)))
+	err = assetNFTKeeper.Sell(ctx, seller, buyer, classID, nftID, price)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+	requireT.Equal(seller.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+}
+
+func TestKeeper_SellForFTWithBurnRate(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	assetNFTKeeper.SetParams(ctx, types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	})
+	ftKeeper.SetParams(ctx, assetfttypes.Params{
+		IssueFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	})
+
+	ftIssuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        ftIssuer,
+		Symbol:        "PAY",
+		Subunit:       "pay",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		BurnRate:      sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:      issuer,
+		Symbol:      "symbol",
+		RoyaltyRate: sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+
+	seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	buyer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	nftID := "my-id"
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      nftID,
+	}))
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, nftID, seller))
+	requireT.NoError(bankKeeper.SendCoins(ctx, ftIssuer, buyer, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	requireT.NoError(assetNFTKeeper.Sell(ctx, seller, buyer, classID, nftID, sdk.NewInt64Coin(denom, 15)))
+
+	// 15 * 0.1 rounded up
+	requireT.Equal(sdk.NewInt(2), bankKeeper.GetBalance(ctx, issuer, denom).Amount)
+	requireT.Equal(sdk.NewInt(13), bankKeeper.GetBalance(ctx, seller, denom).Amount)
+	// the burn rate is charged once for the whole price, 15 * 0.1 rounded up,
+	// while charging it separately for the royalty and the payment would cost 1 + 2
+	requireT.Equal(sdk.NewInt(100-15-2), bankKeeper.GetBalance(ctx, buyer, denom).Amount)
+}
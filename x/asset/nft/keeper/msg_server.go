@@ -20,6 +20,8 @@ type MsgKeeper interface {
 	Unfreeze(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string) error
 	AddToWhitelist(ctx sdk.Context, classID, nftID string, sender, account sdk.AccAddress) error
 	RemoveFromWhitelist(ctx sdk.Context, classID, nftID string, sender, account sdk.AccAddress) error
+	Sell(ctx sdk.Context, seller, buyer sdk.AccAddress, classID, nftID string, price sdk.Coin) error
+	MintBatch(ctx sdk.Context, settings []types.MintSettings) error
 }
 
 // MsgServer serves grpc tx requests for assets module.
@@ -35,13 +37,13 @@ func NewMsgServer(keeper MsgKeeper) MsgServer {
 }
 
 // IssueClass issues new non-fungible token class.
-func (ms MsgServer) IssueClass(ctx context.Context, req *types.MsgIssueClass) (*types.EmptyResponse, error) {
+func (ms MsgServer) IssueClass(ctx context.Context, req *types.MsgIssueClass) (*types.MsgIssueClassResponse, error) {
 	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid issuer in MsgIssueClass")
 	}
 
-	if _, err := ms.keeper.IssueClass(
+	classID, err := ms.keeper.IssueClass(
 		sdk.UnwrapSDKContext(ctx),
 		types.IssueClassSettings{
 			Issuer:      issuer,
@@ -54,11 +56,14 @@ func (ms MsgServer) IssueClass(ctx context.Context, req *types.MsgIssueClass) (*
 			Features:    req.Features,
 			RoyaltyRate: req.RoyaltyRate,
 		},
-	); err != nil {
+	)
+	if err != nil {
 		return nil, err
 	}
 
-	return &types.EmptyResponse{}, nil
+	return &types.MsgIssueClassResponse{
+		ID: classID,
+	}, nil
 }
 
 // Mint mints non-fungible token.
@@ -170,3 +175,48 @@ func (ms MsgServer) RemoveFromWhitelist(ctx context.Context, req *types.MsgRemov
 
 	return &types.EmptyResponse{}, nil
 }
+
+// Sell sells the non-fungible token to the buyer.
+func (ms MsgServer) Sell(ctx context.Context, req *types.MsgSell) (*types.EmptyResponse, error) {
+	seller, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid seller")
+	}
+
+	buyer, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid buyer")
+	}
+
+	if err := ms.keeper.Sell(sdk.UnwrapSDKContext(ctx), seller, buyer, req.ClassID, req.ID, req.Price); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// MintBatch mints multiple non-fungible tokens.
+func (ms MsgServer) MintBatch(ctx context.Context, req *types.MsgMintBatch) (*types.EmptyResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	settings := make([]types.MintSettings, 0, len(req.Items))
+	for _, item := range req.Items {
+		settings = append(settings, types.MintSettings{
+			Sender:  owner,
+			ClassID: req.ClassID,
+			ID:      item.ID,
+			URI:     item.URI,
+			URIHash: item.URIHash,
+			Data:    item.Data,
+		})
+	}
+
+	if err := ms.keeper.MintBatch(sdk.UnwrapSDKContext(ctx), settings); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
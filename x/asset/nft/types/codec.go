@@ -18,6 +18,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUnfreeze{},
 		&MsgAddToWhitelist{},
 		&MsgRemoveFromWhitelist{},
+		&MsgSell{},
+		&MsgMintBatch{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
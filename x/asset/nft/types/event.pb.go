@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -366,50 +367,150 @@ func (m *EventRemovedFromWhitelist) GetAccount() string {
 	return ""
 }
 
+// EventSold is emitted on MsgSell.
+type EventSold struct {
+	ClassId          string     `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Id               string     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Seller           string     `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	Buyer            string     `protobuf:"bytes,4,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Price            types.Coin `protobuf:"bytes,5,opt,name=price,proto3" json:"price"`
+	Royalty          types.Coin `protobuf:"bytes,6,opt,name=royalty,proto3" json:"royalty"`
+	RoyaltyRecipient string     `protobuf:"bytes,7,opt,name=royalty_recipient,json=royaltyRecipient,proto3" json:"royalty_recipient,omitempty"`
+}
+
+func (m *EventSold) Reset()         { *m = EventSold{} }
+func (m *EventSold) String() string { return proto.CompactTextString(m) }
+func (*EventSold) ProtoMessage()    {}
+func (*EventSold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef75aa7da633196, []int{5}
+}
+func (m *EventSold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSold.Merge(m, src)
+}
+func (m *EventSold) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSold) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSold proto.InternalMessageInfo
+
+func (m *EventSold) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *EventSold) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventSold) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventSold) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *EventSold) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *EventSold) GetRoyalty() types.Coin {
+	if m != nil {
+		return m.Royalty
+	}
+	return types.Coin{}
+}
+
+func (m *EventSold) GetRoyaltyRecipient() string {
+	if m != nil {
+		return m.RoyaltyRecipient
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventClassIssued)(nil), "coreum.asset.nft.v1.EventClassIssued")
 	proto.RegisterType((*EventFrozen)(nil), "coreum.asset.nft.v1.EventFrozen")
 	proto.RegisterType((*EventUnfrozen)(nil), "coreum.asset.nft.v1.EventUnfrozen")
 	proto.RegisterType((*EventAddedToWhitelist)(nil), "coreum.asset.nft.v1.EventAddedToWhitelist")
 	proto.RegisterType((*EventRemovedFromWhitelist)(nil), "coreum.asset.nft.v1.EventRemovedFromWhitelist")
+	proto.RegisterType((*EventSold)(nil), "coreum.asset.nft.v1.EventSold")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/event.proto", fileDescriptor_fef75aa7da633196) }
 
 var fileDescriptor_fef75aa7da633196 = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x6d, 0xd2, 0xad, 0xe9, 0x5c, 0x98, 0x90, 0x19, 0xc8, 0x9b, 0x44, 0x52, 0x7a, 0x98, 0x76,
-	0x21, 0xd1, 0x80, 0x2b, 0x07, 0xb6, 0x51, 0x91, 0xcb, 0x04, 0x16, 0x15, 0x12, 0x42, 0x2a, 0x6e,
-	0xec, 0x36, 0x16, 0x4d, 0x5c, 0xd9, 0x4e, 0xa1, 0xfc, 0x0a, 0x7e, 0xd6, 0x8e, 0x3b, 0x22, 0x0e,
-	0x11, 0x4a, 0xc5, 0xff, 0x40, 0x76, 0x32, 0xd4, 0xc3, 0x2e, 0x93, 0x7a, 0xca, 0xf7, 0xbd, 0xf7,
-	0xf2, 0x3e, 0xf9, 0xd9, 0x1f, 0x08, 0x12, 0x21, 0x59, 0x91, 0x45, 0x44, 0x29, 0xa6, 0xa3, 0x7c,
-	0xaa, 0xa3, 0xe5, 0x69, 0xc4, 0x96, 0x2c, 0xd7, 0xe1, 0x42, 0x0a, 0x2d, 0xe0, 0xc3, 0x5a, 0x10,
-	0x5a, 0x41, 0x98, 0x4f, 0x75, 0xb8, 0x3c, 0x3d, 0x3a, 0x98, 0x89, 0x99, 0xb0, 0x7c, 0x64, 0xaa,
-	0x5a, 0x7a, 0xf4, 0xe4, 0x36, 0x2f, 0xf3, 0x87, 0xa5, 0x07, 0x7f, 0x5d, 0xf0, 0xe0, 0x8d, 0x71,
-	0x3e, 0x9f, 0x13, 0xa5, 0x62, 0xa5, 0x0a, 0x46, 0xe1, 0x63, 0xe0, 0x72, 0x8a, 0x9c, 0xbe, 0x73,
-	0xb2, 0x77, 0xd6, 0xa9, 0xca, 0xc0, 0x8d, 0x2f, 0xb0, 0xcb, 0x0d, 0xde, 0xe1, 0x46, 0x21, 0x91,
-	0x6b, 0x38, 0xdc, 0x74, 0x06, 0x57, 0xab, 0x6c, 0x22, 0xe6, 0xa8, 0x5d, 0xe3, 0x75, 0x07, 0x21,
-	0xd8, 0xc9, 0x49, 0xc6, 0xd0, 0x8e, 0x45, 0x6d, 0x0d, 0xfb, 0xa0, 0x47, 0x99, 0x4a, 0x24, 0x5f,
-	0x68, 0x2e, 0x72, 0xb4, 0x6b, 0xa9, 0x4d, 0x08, 0x1e, 0x82, 0x76, 0x21, 0x39, 0xea, 0xd8, 0xf1,
-	0x5e, 0x55, 0x06, 0xed, 0x11, 0x8e, 0xb1, 0xc1, 0xe0, 0x31, 0xe8, 0x16, 0x92, 0x8f, 0x53, 0xa2,
-	0x52, 0xe4, 0x59, 0xbe, 0x57, 0x95, 0x81, 0x37, 0xc2, 0xf1, 0x5b, 0xa2, 0x52, 0xec, 0x15, 0x92,
-	0x9b, 0x02, 0xbe, 0x02, 0xdd, 0x29, 0x23, 0xba, 0x90, 0x4c, 0xa1, 0x6e, 0xbf, 0x7d, 0xb2, 0xff,
-	0xfc, 0x69, 0x78, 0x4b, 0x64, 0xa1, 0x3d, 0xf4, 0xb0, 0x56, 0xe2, 0xff, 0xbf, 0xc0, 0xf7, 0xe0,
-	0x9e, 0x14, 0x2b, 0x32, 0xd7, 0xab, 0xb1, 0x24, 0x9a, 0xa1, 0x3d, 0x3b, 0x2a, 0xbc, 0x2a, 0x83,
-	0xd6, 0xef, 0x32, 0x38, 0x9e, 0x71, 0x9d, 0x16, 0x93, 0x30, 0x11, 0x59, 0x94, 0x08, 0x95, 0x09,
-	0xd5, 0x7c, 0x9e, 0x29, 0xfa, 0x35, 0xd2, 0xab, 0x05, 0x53, 0xe1, 0x05, 0x4b, 0x70, 0xaf, 0xf1,
-	0xc0, 0x44, 0xb3, 0xc1, 0x25, 0xe8, 0xd9, 0x98, 0x87, 0x52, 0xfc, 0x60, 0xe6, 0x8c, 0xdd, 0xc4,
-	0xcc, 0x1e, 0xdf, 0xe4, 0x8c, 0x3d, 0xdb, 0xc7, 0x14, 0xee, 0xdb, 0xf0, 0xeb, 0x80, 0x4d, 0xe8,
-	0x07, 0x60, 0x57, 0x7c, 0xcb, 0x99, 0x6c, 0xb2, 0xad, 0x9b, 0xc1, 0x3b, 0x70, 0xdf, 0xfa, 0x8d,
-	0xf2, 0xe9, 0x96, 0x1c, 0x3f, 0x83, 0x47, 0xd6, 0xf1, 0x35, 0xa5, 0x8c, 0x7e, 0x10, 0x1f, 0x53,
-	0xae, 0xd9, 0x9c, 0x2b, 0x7d, 0x17, 0x67, 0x04, 0x3c, 0x92, 0x24, 0xa2, 0xc8, 0x75, 0xe3, 0x7d,
-	0xd3, 0x0e, 0xbe, 0x80, 0x43, 0xeb, 0x8e, 0x59, 0x26, 0x96, 0x8c, 0x0e, 0xa5, 0xc8, 0xb6, 0x3b,
-	0xe1, 0xec, 0xf2, 0xaa, 0xf2, 0x9d, 0xeb, 0xca, 0x77, 0xfe, 0x54, 0xbe, 0xf3, 0x73, 0xed, 0xb7,
-	0xae, 0xd7, 0x7e, 0xeb, 0xd7, 0xda, 0x6f, 0x7d, 0x7a, 0xb9, 0x71, 0x61, 0xe7, 0xf6, 0x15, 0x0c,
-	0x45, 0x91, 0x53, 0x62, 0x5e, 0x5b, 0xd4, 0xac, 0xc7, 0xf7, 0x8d, 0x05, 0xb1, 0x57, 0x38, 0xe9,
-	0xd8, 0x05, 0x79, 0xf1, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x74, 0x63, 0x4f, 0xd7, 0x8d, 0x03, 0x00,
-	0x00,
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0x13, 0x31,
+	0x10, 0xcd, 0x47, 0x9b, 0x4d, 0x1d, 0xa8, 0x8a, 0x29, 0x68, 0x5b, 0x89, 0x4d, 0xc9, 0xa1, 0xaa,
+	0x84, 0xf0, 0x2a, 0x05, 0x0e, 0x1c, 0x38, 0xd0, 0x96, 0x88, 0x5c, 0x2a, 0x30, 0x54, 0x48, 0x08,
+	0xa9, 0x38, 0xbb, 0x93, 0xc6, 0x62, 0x77, 0x1d, 0xd9, 0xde, 0x40, 0xb8, 0xf2, 0x07, 0xf8, 0x59,
+	0x3d, 0xf6, 0x88, 0x38, 0x44, 0x28, 0x15, 0xff, 0x03, 0xf9, 0x23, 0x55, 0x0f, 0x3d, 0x50, 0xa9,
+	0xa7, 0x78, 0x66, 0xde, 0xbe, 0x27, 0xbf, 0xbc, 0x31, 0x6a, 0x27, 0x42, 0x42, 0x99, 0xc7, 0x4c,
+	0x29, 0xd0, 0x71, 0x31, 0xd4, 0xf1, 0xa4, 0x1b, 0xc3, 0x04, 0x0a, 0x4d, 0xc6, 0x52, 0x68, 0x81,
+	0xef, 0x3a, 0x00, 0xb1, 0x00, 0x52, 0x0c, 0x35, 0x99, 0x74, 0x37, 0xd7, 0x4f, 0xc4, 0x89, 0xb0,
+	0xf3, 0xd8, 0x9c, 0x1c, 0x74, 0x33, 0x4a, 0x84, 0xca, 0x85, 0x8a, 0x07, 0x4c, 0x41, 0x3c, 0xe9,
+	0x0e, 0x40, 0xb3, 0x6e, 0x9c, 0x08, 0x5e, 0xf8, 0xf9, 0x83, 0xab, 0xb4, 0x0c, 0xa3, 0x1d, 0x77,
+	0xfe, 0xd6, 0xd0, 0xda, 0x2b, 0xa3, 0xbc, 0x9f, 0x31, 0xa5, 0xfa, 0x4a, 0x95, 0x90, 0xe2, 0xfb,
+	0xa8, 0xc6, 0xd3, 0xb0, 0xba, 0x55, 0xdd, 0x59, 0xd9, 0x6b, 0xcc, 0x67, 0xed, 0x5a, 0xff, 0x80,
+	0xd6, 0xb8, 0xe9, 0x37, 0xb8, 0x41, 0xc8, 0xb0, 0x66, 0x66, 0xd4, 0x57, 0xa6, 0xaf, 0xa6, 0xf9,
+	0x40, 0x64, 0x61, 0xdd, 0xf5, 0x5d, 0x85, 0x31, 0x5a, 0x2a, 0x58, 0x0e, 0xe1, 0x92, 0xed, 0xda,
+	0x33, 0xde, 0x42, 0xad, 0x14, 0x54, 0x22, 0xf9, 0x58, 0x73, 0x51, 0x84, 0xcb, 0x76, 0x74, 0xb9,
+	0x85, 0x37, 0x50, 0xbd, 0x94, 0x3c, 0x6c, 0x58, 0xf9, 0x60, 0x3e, 0x6b, 0xd7, 0x8f, 0x68, 0x9f,
+	0x9a, 0x1e, 0xde, 0x46, 0xcd, 0x52, 0xf2, 0xe3, 0x11, 0x53, 0xa3, 0x30, 0xb0, 0xf3, 0xd6, 0x7c,
+	0xd6, 0x0e, 0x8e, 0x68, 0xff, 0x35, 0x53, 0x23, 0x1a, 0x94, 0x92, 0x9b, 0x03, 0x7e, 0x81, 0x9a,
+	0x43, 0x60, 0xba, 0x94, 0xa0, 0xc2, 0xe6, 0x56, 0x7d, 0x67, 0x75, 0xf7, 0x21, 0xb9, 0xc2, 0x52,
+	0x62, 0x2f, 0xdd, 0x73, 0x48, 0x7a, 0xf1, 0x09, 0x7e, 0x8b, 0x6e, 0x49, 0x31, 0x65, 0x99, 0x9e,
+	0x1e, 0x4b, 0xa6, 0x21, 0x5c, 0xb1, 0x52, 0xe4, 0x74, 0xd6, 0xae, 0xfc, 0x9e, 0xb5, 0xb7, 0x4f,
+	0xb8, 0x1e, 0x95, 0x03, 0x92, 0x88, 0x3c, 0xf6, 0xe6, 0xbb, 0x9f, 0xc7, 0x2a, 0xfd, 0x12, 0xeb,
+	0xe9, 0x18, 0x14, 0x39, 0x80, 0x84, 0xb6, 0x3c, 0x07, 0x65, 0x1a, 0x3a, 0x87, 0xa8, 0x65, 0x6d,
+	0xee, 0x49, 0xf1, 0x1d, 0xcc, 0x1d, 0x9b, 0x89, 0xd1, 0x3e, 0x5e, 0xf8, 0x4c, 0x03, 0x5b, 0xf7,
+	0x53, 0xbc, 0x6a, 0xcd, 0x77, 0x06, 0x1b, 0xd3, 0xd7, 0xd1, 0xb2, 0xf8, 0x5a, 0x80, 0xf4, 0xde,
+	0xba, 0xa2, 0xf3, 0x06, 0xdd, 0xb6, 0x7c, 0x47, 0xc5, 0xf0, 0x86, 0x18, 0x3f, 0xa1, 0x7b, 0x96,
+	0xf1, 0x65, 0x9a, 0x42, 0xfa, 0x5e, 0x7c, 0x18, 0x71, 0x0d, 0x19, 0x57, 0xfa, 0x3a, 0xcc, 0x21,
+	0x0a, 0x58, 0x92, 0x88, 0xb2, 0xd0, 0x9e, 0x7b, 0x51, 0x76, 0x3e, 0xa3, 0x0d, 0xcb, 0x4e, 0x21,
+	0x17, 0x13, 0x48, 0x7b, 0x52, 0xe4, 0x37, 0xac, 0xf0, 0xa3, 0x86, 0x56, 0xac, 0xc4, 0x3b, 0x91,
+	0xa5, 0xd7, 0xa1, 0x34, 0xe9, 0x85, 0x2c, 0xbb, 0xf0, 0xc3, 0x57, 0xc6, 0xa6, 0x41, 0x39, 0x05,
+	0xe9, 0xe3, 0xeb, 0x0a, 0xfc, 0x0c, 0x2d, 0x8f, 0x25, 0x4f, 0xc0, 0x26, 0xb7, 0xb5, 0xbb, 0x41,
+	0xdc, 0x7f, 0x4f, 0xcc, 0xfe, 0x11, 0xbf, 0x7f, 0x64, 0x5f, 0xf0, 0x62, 0x6f, 0xc9, 0xe4, 0x85,
+	0x3a, 0x34, 0x7e, 0x8e, 0x02, 0x1f, 0x87, 0xb0, 0xf1, 0x7f, 0x1f, 0x2e, 0xf0, 0xf8, 0x11, 0xba,
+	0x73, 0x91, 0x46, 0x48, 0xf8, 0x98, 0x43, 0xa1, 0x5d, 0xfa, 0xe9, 0x9a, 0x1f, 0xd0, 0x45, 0x7f,
+	0xef, 0xf0, 0x74, 0x1e, 0x55, 0xcf, 0xe6, 0x51, 0xf5, 0xcf, 0x3c, 0xaa, 0xfe, 0x3c, 0x8f, 0x2a,
+	0x67, 0xe7, 0x51, 0xe5, 0xd7, 0x79, 0x54, 0xf9, 0xf8, 0xf4, 0x52, 0x6c, 0xf7, 0xed, 0x2e, 0xf4,
+	0x44, 0x59, 0xa4, 0xcc, 0xec, 0x5c, 0xec, 0x1f, 0x89, 0x6f, 0x97, 0x9e, 0x09, 0x1b, 0xe4, 0x41,
+	0xc3, 0x3e, 0x13, 0x4f, 0xfe, 0x0d, 0x00, 0x3e, 0xdc, 0x7d, 0x0c, 0xb3, 0x04, 0x00, 0x00,
 }
 
 func (m *EventClassIssued) Marshal() (dAtA []byte, err error) {
@@ -688,6 +789,84 @@ func (m *EventRemovedFromWhitelist) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoyaltyRecipient) > 0 {
+		i -= len(m.RoyaltyRecipient)
+		copy(dAtA[i:], m.RoyaltyRecipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RoyaltyRecipient)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -829,6 +1008,39 @@ func (m *EventRemovedFromWhitelist) Size() (n int) {
 	return n
 }
 
+func (m *EventSold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Royalty.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.RoyaltyRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1796,6 +2008,282 @@ func (m *EventRemovedFromWhitelist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
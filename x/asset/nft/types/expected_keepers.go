@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/CoreumFoundation/coreum/x/nft"
 )
@@ -14,6 +15,7 @@ type NFTKeeper interface {
 	HasNFT(ctx sdk.Context, classID, id string) bool
 	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
 	Burn(ctx sdk.Context, classID, nftID string) error
+	Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error
 	GetOwner(ctx sdk.Context, classID, nftID string) sdk.AccAddress
 }
 
@@ -21,4 +23,6 @@ type NFTKeeper interface {
 type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error
 }
@@ -13,6 +13,8 @@ var (
 	_ sdk.Msg = &MsgUnfreeze{}
 	_ sdk.Msg = &MsgAddToWhitelist{}
 	_ sdk.Msg = &MsgRemoveFromWhitelist{}
+	_ sdk.Msg = &MsgSell{}
+	_ sdk.Msg = &MsgMintBatch{}
 )
 
 // Constraints.
@@ -22,6 +24,7 @@ const (
 	MaxURILength              = 256
 	MaxURIHashLength          = 128
 	MaxDataSize               = 5 * 1024 // 5KB
+	MaxMintBatchSize          = 500
 )
 
 // ValidateBasic checks that message fields are valid.
@@ -231,3 +234,96 @@ func (msg *MsgRemoveFromWhitelist) GetSigners() []sdk.AccAddress {
 		sdk.MustAccAddressFromBech32(msg.Sender),
 	}
 }
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgSell) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller account %s", msg.Seller)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer account %s", msg.Buyer)
+	}
+
+	if msg.Seller == msg.Buyer {
+		return sdkerrors.Wrap(ErrInvalidInput, "seller and buyer must be different accounts")
+	}
+
+	if err := ValidateTokenID(msg.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if err := msg.Price.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid price: %s", err)
+	}
+
+	if !msg.Price.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "price must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgSell) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Seller),
+		sdk.MustAccAddressFromBech32(msg.Buyer),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgMintBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if len(msg.Items) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "no tokens to mint")
+	}
+
+	if len(msg.Items) > MaxMintBatchSize {
+		return sdkerrors.Wrapf(ErrInvalidInput, "too many tokens to mint, the max is %d", MaxMintBatchSize)
+	}
+
+	ids := make(map[string]struct{}, len(msg.Items))
+	for _, item := range msg.Items {
+		if err := ValidateTokenID(item.ID); err != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+		}
+
+		if _, ok := ids[item.ID]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "ID %q is minted more than once", item.ID)
+		}
+		ids[item.ID] = struct{}{}
+
+		if err := ValidateData(item.Data); err != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+		}
+
+		if len(item.URI) > MaxURILength {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must be less than or equal %d", len(item.URI), MaxURILength)
+		}
+
+		if len(item.URIHash) > MaxURIHashLength {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", len(item.URIHash), MaxURIHashLength)
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgMintBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
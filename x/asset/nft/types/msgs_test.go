@@ -2,10 +2,12 @@ package types_test
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 	"testing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -312,6 +314,153 @@ func TestMsgMint_ValidateBasic(t *testing.T) {
 }
 
 //nolint:dupl // test case duplicates are ok
+func TestMsgMintBatch_ValidateBasic(t *testing.T) {
+	requireT := require.New(t)
+
+	dataString := "metadata"
+	dataValue, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte(dataString)})
+	requireT.NoError(err)
+
+	validMessage := func() *types.MsgMintBatch {
+		return &types.MsgMintBatch{
+			Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			Items: []types.MintBatchItem{
+				{
+					ID:      "my-id-1",
+					URI:     "https://my.invalid/1",
+					URIHash: "content-hash",
+					Data:    dataValue,
+				},
+				{
+					ID: "my-id-2",
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgMintBatch
+		expectedError error
+	}{
+		{
+			name:        "valid msg",
+			messageFunc: validMessage,
+		},
+		{
+			name: "valid msg with max batch size",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage()
+				msg.Items = make([]types.MintBatchItem, 0, types.MaxMintBatchSize)
+				for i := 0; i < types.MaxMintBatchSize; i++ {
+					msg.Items = append(msg.Items, types.MintBatchItem{ID: fmt.Sprintf("my-id-%d", i)})
+				}
+				return msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage()
+				msg.Sender = invalidAccount
+				return msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage()
+				msg.ClassID = "x"
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "no items",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage()
+				msg.Items = nil
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too many items",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage()
+				msg.Items = make([]types.MintBatchItem, 0, types.MaxMintBatchSize+1)
+				for i := 0; i <= types.MaxMintBatchSize; i++ {
+					msg.Items = append(msg.Items, types.MintBatchItem{ID: fmt.Sprintf("my-id-%d", i)})
+				}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage()
+				msg.Items[1].ID = invalidNFTID
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated id",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage()
+				msg.Items[1].ID = msg.Items[0].ID
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid uri",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage()
+				msg.Items[1].URI = string(make([]byte, 257))
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid uri hash",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage()
+				msg.Items[1].URIHash = strings.Repeat("x", 129)
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid data - too long",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage()
+				msg.Items[1].Data = &codectypes.Any{
+					TypeUrl: "/" + proto.MessageName((*types.DataBytes)(nil)),
+					Value:   bytes.Repeat([]byte{0x01}, types.MaxDataSize+1),
+				}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
 func TestMsgBurn_ValidateBasic(t *testing.T) {
 	validMessage := types.MsgBurn{
 		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
@@ -640,3 +789,103 @@ func TestMsgRemoveFromWhitelist_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+//nolint:funlen // many test cases
+func TestMsgSell_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgSell{
+		Seller:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Buyer:   "devcore1wuh6up6ejgwd8uhc7uz2upystsdw6n2wkfhur0",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:      "my-id",
+		Price:   sdk.NewInt64Coin(constant.DenomDev, 100),
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgSell
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgSell {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid seller",
+			messageFunc: func() *types.MsgSell {
+				msg := validMessage
+				msg.Seller = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid buyer",
+			messageFunc: func() *types.MsgSell {
+				msg := validMessage
+				msg.Buyer = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "seller is buyer",
+			messageFunc: func() *types.MsgSell {
+				msg := validMessage
+				msg.Buyer = msg.Seller
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgSell {
+				msg := validMessage
+				msg.ID = invalidNFTID
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgSell {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid price denom",
+			messageFunc: func() *types.MsgSell {
+				msg := validMessage
+				msg.Price = sdk.Coin{Denom: "1", Amount: sdk.NewInt(100)}
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "zero price",
+			messageFunc: func() *types.MsgSell {
+				msg := validMessage
+				msg.Price = sdk.NewInt64Coin(constant.DenomDev, 0)
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
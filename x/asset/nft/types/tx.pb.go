@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -317,6 +318,170 @@ func (m *MsgRemoveFromWhitelist) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveFromWhitelist proto.InternalMessageInfo
 
+// MsgSell defines message for the Sell method.
+// It must be signed by both the seller and the buyer.
+type MsgSell struct {
+	Seller  string      `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	Buyer   string      `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	ClassID string      `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	ID      string      `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Price   types1.Coin `protobuf:"bytes,5,opt,name=price,proto3" json:"price"`
+}
+
+func (m *MsgSell) Reset()         { *m = MsgSell{} }
+func (m *MsgSell) String() string { return proto.CompactTextString(m) }
+func (*MsgSell) ProtoMessage()    {}
+func (*MsgSell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e850acc149a7cfa7, []int{7}
+}
+func (m *MsgSell) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSell.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSell.Merge(m, src)
+}
+func (m *MsgSell) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSell) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSell proto.InternalMessageInfo
+
+// MsgMintBatch defines message for the MintBatch method.
+// If any of the tokens can't be minted, none of them is minted.
+type MsgMintBatch struct {
+	Sender  string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ClassID string          `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Items   []MintBatchItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
+}
+
+func (m *MsgMintBatch) Reset()         { *m = MsgMintBatch{} }
+func (m *MsgMintBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgMintBatch) ProtoMessage()    {}
+func (*MsgMintBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e850acc149a7cfa7, []int{8}
+}
+func (m *MsgMintBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintBatch.Merge(m, src)
+}
+func (m *MsgMintBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintBatch proto.InternalMessageInfo
+
+// MintBatchItem defines a single non-fungible token minted by the MsgMintBatch.
+type MintBatchItem struct {
+	ID      string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	URI     string     `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	URIHash string     `protobuf:"bytes,3,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	Data    *types.Any `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MintBatchItem) Reset()         { *m = MintBatchItem{} }
+func (m *MintBatchItem) String() string { return proto.CompactTextString(m) }
+func (*MintBatchItem) ProtoMessage()    {}
+func (*MintBatchItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e850acc149a7cfa7, []int{9}
+}
+func (m *MintBatchItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintBatchItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintBatchItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintBatchItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintBatchItem.Merge(m, src)
+}
+func (m *MintBatchItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintBatchItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintBatchItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintBatchItem proto.InternalMessageInfo
+
+// MsgIssueClassResponse defines the response of the IssueClass method.
+type MsgIssueClassResponse struct {
+	// id is the id of the issued class.
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgIssueClassResponse) Reset()         { *m = MsgIssueClassResponse{} }
+func (m *MsgIssueClassResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueClassResponse) ProtoMessage()    {}
+func (*MsgIssueClassResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e850acc149a7cfa7, []int{10}
+}
+func (m *MsgIssueClassResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIssueClassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIssueClassResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIssueClassResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueClassResponse.Merge(m, src)
+}
+func (m *MsgIssueClassResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIssueClassResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueClassResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIssueClassResponse proto.InternalMessageInfo
+
 type EmptyResponse struct {
 }
 
@@ -324,7 +489,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e850acc149a7cfa7, []int{7}
+	return fileDescriptor_e850acc149a7cfa7, []int{11}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,57 +526,72 @@ func init() {
 	proto.RegisterType((*MsgUnfreeze)(nil), "coreum.asset.nft.v1.MsgUnfreeze")
 	proto.RegisterType((*MsgAddToWhitelist)(nil), "coreum.asset.nft.v1.MsgAddToWhitelist")
 	proto.RegisterType((*MsgRemoveFromWhitelist)(nil), "coreum.asset.nft.v1.MsgRemoveFromWhitelist")
+	proto.RegisterType((*MsgSell)(nil), "coreum.asset.nft.v1.MsgSell")
+	proto.RegisterType((*MsgMintBatch)(nil), "coreum.asset.nft.v1.MsgMintBatch")
+	proto.RegisterType((*MintBatchItem)(nil), "coreum.asset.nft.v1.MintBatchItem")
+	proto.RegisterType((*MsgIssueClassResponse)(nil), "coreum.asset.nft.v1.MsgIssueClassResponse")
 	proto.RegisterType((*EmptyResponse)(nil), "coreum.asset.nft.v1.EmptyResponse")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/tx.proto", fileDescriptor_e850acc149a7cfa7) }
 
 var fileDescriptor_e850acc149a7cfa7 = []byte{
-	// 694 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6b, 0xdb, 0x4c,
-	0x10, 0xb5, 0x6c, 0xc7, 0x76, 0xc6, 0x5f, 0xf2, 0x51, 0x25, 0x04, 0x25, 0xa4, 0xb2, 0xeb, 0x43,
-	0x30, 0x94, 0x4a, 0x24, 0xed, 0xb5, 0x87, 0x38, 0xa9, 0x89, 0xa1, 0x82, 0x76, 0x49, 0x28, 0x94,
-	0x42, 0x58, 0x4b, 0x6b, 0x59, 0xd4, 0xd2, 0x1a, 0xed, 0x2a, 0x44, 0xbd, 0xf7, 0xd2, 0x53, 0x7f,
-	0x56, 0x4e, 0x25, 0x97, 0x42, 0xe9, 0xc1, 0xb4, 0xce, 0x5f, 0xe8, 0x0f, 0x28, 0xda, 0x95, 0x53,
-	0xa7, 0xc8, 0x44, 0x17, 0xd3, 0x93, 0x77, 0xe6, 0x3d, 0xbf, 0x19, 0x3d, 0x66, 0x67, 0x61, 0xd7,
-	0xa6, 0x21, 0x89, 0x7c, 0x13, 0x33, 0x46, 0xb8, 0x19, 0x0c, 0xb8, 0x79, 0xb1, 0x6f, 0xf2, 0x4b,
-	0x63, 0x1c, 0x52, 0x4e, 0xd5, 0x0d, 0x89, 0x1a, 0x02, 0x35, 0x82, 0x01, 0x37, 0x2e, 0xf6, 0x77,
-	0x36, 0x5d, 0xea, 0x52, 0x81, 0x9b, 0xc9, 0x49, 0x52, 0x77, 0xb6, 0x5d, 0x4a, 0xdd, 0x11, 0x31,
-	0x45, 0xd4, 0x8f, 0x06, 0x26, 0x0e, 0xe2, 0x14, 0x7a, 0x98, 0x55, 0x23, 0x11, 0x93, 0x70, 0x23,
-	0xb3, 0x85, 0x78, 0x4c, 0x98, 0x24, 0xb4, 0x7e, 0x15, 0x61, 0xcd, 0x62, 0x6e, 0x8f, 0xb1, 0x88,
-	0x1c, 0x8d, 0x30, 0x63, 0xea, 0x16, 0x54, 0xbc, 0x24, 0x0a, 0x35, 0xa5, 0xa9, 0xb4, 0x57, 0x51,
-	0x1a, 0x25, 0x79, 0x16, 0xfb, 0x7d, 0x3a, 0xd2, 0x8a, 0x32, 0x2f, 0x23, 0x55, 0x85, 0x72, 0x80,
-	0x7d, 0xa2, 0x95, 0x44, 0x56, 0x9c, 0xd5, 0x26, 0xd4, 0x1d, 0xc2, 0xec, 0xd0, 0x1b, 0x73, 0x8f,
-	0x06, 0x5a, 0x59, 0x40, 0xf3, 0x29, 0x75, 0x1b, 0x4a, 0x51, 0xe8, 0x69, 0x2b, 0x09, 0xd2, 0xa9,
-	0x4e, 0x27, 0x8d, 0xd2, 0x19, 0xea, 0xa1, 0x24, 0xa7, 0xee, 0x41, 0x2d, 0x0a, 0xbd, 0xf3, 0x21,
-	0x66, 0x43, 0xad, 0x22, 0xf0, 0xfa, 0x74, 0xd2, 0xa8, 0x9e, 0xa1, 0xde, 0x09, 0x66, 0x43, 0x54,
-	0x8d, 0x42, 0x2f, 0x39, 0xa8, 0x6d, 0x28, 0x3b, 0x98, 0x63, 0xad, 0xda, 0x54, 0xda, 0xf5, 0x83,
-	0x4d, 0x43, 0x9a, 0x64, 0xcc, 0x4c, 0x32, 0x0e, 0x83, 0x18, 0x09, 0x86, 0xfa, 0x1c, 0x6a, 0x03,
-	0x82, 0x79, 0x14, 0x12, 0xa6, 0xd5, 0x9a, 0xa5, 0xf6, 0xfa, 0xc1, 0x23, 0x23, 0xc3, 0x7d, 0x43,
-	0x18, 0xd0, 0x95, 0x4c, 0x74, 0xfb, 0x17, 0xf5, 0x35, 0xfc, 0x17, 0xd2, 0x18, 0x8f, 0x78, 0x7c,
-	0x1e, 0x62, 0x4e, 0xb4, 0x55, 0xd1, 0x94, 0x71, 0x35, 0x69, 0x14, 0xbe, 0x4f, 0x1a, 0x7b, 0xae,
-	0xc7, 0x87, 0x51, 0xdf, 0xb0, 0xa9, 0x6f, 0xda, 0x94, 0xf9, 0x94, 0xa5, 0x3f, 0x4f, 0x98, 0xf3,
-	0x3e, 0xf5, 0xfa, 0x98, 0xd8, 0xa8, 0x9e, 0x6a, 0x20, 0xcc, 0x49, 0xeb, 0x8b, 0x02, 0x55, 0x8b,
-	0xb9, 0x96, 0x17, 0x70, 0x61, 0x2c, 0x09, 0x9c, 0x3f, 0x86, 0xcb, 0x28, 0xf1, 0xc1, 0x4e, 0x1a,
-	0x3a, 0xf7, 0x1c, 0x69, 0xb9, 0xf4, 0x41, 0x34, 0xd9, 0x3b, 0x46, 0x55, 0x01, 0xf6, 0x1c, 0x75,
-	0x0b, 0x8a, 0x9e, 0x23, 0xed, 0xef, 0x54, 0xa6, 0x93, 0x46, 0xb1, 0x77, 0x8c, 0x8a, 0x9e, 0x33,
-	0xb3, 0xb8, 0x7c, 0x8f, 0xc5, 0x2b, 0x39, 0x2c, 0xae, 0xdc, 0x67, 0x71, 0x0b, 0x8b, 0xef, 0xe9,
-	0x44, 0x61, 0xb0, 0xac, 0xef, 0x69, 0xd9, 0xb0, 0x6a, 0x31, 0xb7, 0x1b, 0x12, 0xf2, 0x81, 0x2c,
-	0xad, 0x08, 0x81, 0xba, 0xc5, 0xdc, 0xb3, 0x60, 0xb0, 0xdc, 0x32, 0x1f, 0x15, 0x78, 0x60, 0x31,
-	0xf7, 0xd0, 0x71, 0x4e, 0xe9, 0x9b, 0xa1, 0xc7, 0xc9, 0xc8, 0x63, 0xcb, 0x9b, 0x04, 0x0d, 0xaa,
-	0xd8, 0xb6, 0x69, 0x14, 0xf0, 0xf4, 0x2a, 0xce, 0xc2, 0xd6, 0x27, 0x05, 0xb6, 0x2c, 0xe6, 0x22,
-	0xe2, 0xd3, 0x0b, 0xd2, 0x0d, 0xa9, 0xff, 0x2f, 0x9b, 0xf9, 0x1f, 0xd6, 0x5e, 0xf8, 0x63, 0x1e,
-	0x23, 0xc2, 0xc6, 0x34, 0x60, 0xe4, 0xe0, 0x6b, 0x19, 0x4a, 0x16, 0x73, 0xd5, 0x53, 0x80, 0xb9,
-	0x05, 0xd5, 0xca, 0xbc, 0xbb, 0x77, 0x96, 0xd8, 0x4e, 0x36, 0xe7, 0x8e, 0xba, 0x7a, 0x02, 0x65,
-	0x71, 0xff, 0x76, 0x17, 0xe9, 0x25, 0x68, 0x5e, 0x25, 0x31, 0xf9, 0x0b, 0x95, 0x12, 0x34, 0x97,
-	0xd2, 0x4b, 0xa8, 0xa4, 0x03, 0xae, 0x2f, 0xd2, 0x92, 0x78, 0x2e, 0xb5, 0x57, 0x50, 0xbb, 0x9d,
-	0xe4, 0xe6, 0x22, 0xbd, 0x19, 0x23, 0x97, 0xe2, 0x3b, 0x58, 0xff, 0x6b, 0x66, 0xf7, 0x16, 0xe9,
-	0xde, 0xe5, 0xe5, 0x52, 0x1f, 0xc0, 0x46, 0xd6, 0x24, 0x3e, 0x5e, 0x54, 0x22, 0x83, 0x9c, 0xa7,
-	0x4e, 0x07, 0x5d, 0xfd, 0xd4, 0x0b, 0x57, 0x53, 0x5d, 0xb9, 0x9e, 0xea, 0xca, 0x8f, 0xa9, 0xae,
-	0x7c, 0xbe, 0xd1, 0x0b, 0xd7, 0x37, 0x7a, 0xe1, 0xdb, 0x8d, 0x5e, 0x78, 0xfb, 0x6c, 0x6e, 0xa1,
-	0x1f, 0x09, 0xad, 0x2e, 0x8d, 0x02, 0x07, 0x27, 0xef, 0x96, 0x99, 0xbe, 0xa7, 0x97, 0x73, 0x2f,
-	0xaa, 0x58, 0xf1, 0xfd, 0x8a, 0x58, 0x8a, 0x4f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xf5,
-	0x77, 0x1d, 0xf5, 0x07, 0x00, 0x00,
+	// 880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xe3, 0xfc, 0x68, 0x5f, 0xb6, 0x8b, 0xf0, 0x96, 0xca, 0xad, 0x16, 0x27, 0xeb, 0x43,
+	0x15, 0x81, 0xb0, 0xd5, 0x00, 0x47, 0x90, 0x36, 0x2d, 0xd5, 0x46, 0x22, 0x12, 0x18, 0x2a, 0x24,
+	0xb4, 0x52, 0x35, 0xb1, 0x27, 0xce, 0x08, 0xdb, 0x13, 0x79, 0xc6, 0xd5, 0x86, 0x3b, 0x17, 0x0e,
+	0x88, 0x03, 0x7f, 0x07, 0x7f, 0x47, 0x4f, 0xa8, 0x47, 0xc4, 0x21, 0x82, 0xf4, 0x5f, 0xe0, 0xc6,
+	0x05, 0xcd, 0x8c, 0xd3, 0x26, 0x10, 0x13, 0x4b, 0xa8, 0xda, 0x53, 0xfc, 0xe6, 0x7b, 0xfe, 0xde,
+	0x9b, 0x6f, 0xfc, 0xbe, 0x0c, 0x3c, 0xf5, 0x69, 0x8a, 0xb3, 0xd8, 0x45, 0x8c, 0x61, 0xee, 0x26,
+	0x63, 0xee, 0x5e, 0x9d, 0xb8, 0xfc, 0x95, 0x33, 0x4d, 0x29, 0xa7, 0xc6, 0x13, 0x85, 0x3a, 0x12,
+	0x75, 0x92, 0x31, 0x77, 0xae, 0x4e, 0x8e, 0xf6, 0x43, 0x1a, 0x52, 0x89, 0xbb, 0xe2, 0x49, 0xa5,
+	0x1e, 0x1d, 0x86, 0x94, 0x86, 0x11, 0x76, 0x65, 0x34, 0xca, 0xc6, 0x2e, 0x4a, 0x66, 0x39, 0x64,
+	0xf9, 0x94, 0xc5, 0x94, 0xb9, 0x23, 0xc4, 0xb0, 0x7b, 0x75, 0x32, 0xc2, 0x1c, 0x9d, 0xb8, 0x3e,
+	0x25, 0x49, 0x8e, 0xbf, 0xbd, 0xa9, 0x07, 0x51, 0x4c, 0xc1, 0xed, 0x8d, 0x2d, 0xce, 0xa6, 0x98,
+	0xa9, 0x04, 0xfb, 0xcf, 0x2a, 0xec, 0x0d, 0x59, 0x38, 0x60, 0x2c, 0xc3, 0xa7, 0x11, 0x62, 0xcc,
+	0x38, 0x80, 0x06, 0x11, 0x51, 0x6a, 0x6a, 0x1d, 0xad, 0xbb, 0xeb, 0xe5, 0x91, 0x58, 0x67, 0xb3,
+	0x78, 0x44, 0x23, 0xb3, 0xaa, 0xd6, 0x55, 0x64, 0x18, 0x50, 0x4b, 0x50, 0x8c, 0x4d, 0x5d, 0xae,
+	0xca, 0x67, 0xa3, 0x03, 0xad, 0x00, 0x33, 0x3f, 0x25, 0x53, 0x4e, 0x68, 0x62, 0xd6, 0x24, 0xb4,
+	0xba, 0x64, 0x1c, 0x82, 0x9e, 0xa5, 0xc4, 0xac, 0x0b, 0xa4, 0xdf, 0x5c, 0xcc, 0xdb, 0xfa, 0x85,
+	0x37, 0xf0, 0xc4, 0x9a, 0x71, 0x0c, 0x3b, 0x59, 0x4a, 0x2e, 0x27, 0x88, 0x4d, 0xcc, 0x86, 0xc4,
+	0x5b, 0x8b, 0x79, 0xbb, 0x79, 0xe1, 0x0d, 0x5e, 0x20, 0x36, 0xf1, 0x9a, 0x59, 0x4a, 0xc4, 0x83,
+	0xd1, 0x85, 0x5a, 0x80, 0x38, 0x32, 0x9b, 0x1d, 0xad, 0xdb, 0xea, 0xed, 0x3b, 0x4a, 0x44, 0x67,
+	0x29, 0xa2, 0xf3, 0x3c, 0x99, 0x79, 0x32, 0xc3, 0xf8, 0x08, 0x76, 0xc6, 0x18, 0xf1, 0x2c, 0xc5,
+	0xcc, 0xdc, 0xe9, 0xe8, 0xdd, 0xc7, 0xbd, 0x67, 0xce, 0x86, 0xd3, 0x71, 0xa4, 0x00, 0xe7, 0x2a,
+	0xd3, 0xbb, 0x7b, 0xc5, 0xf8, 0x1c, 0x1e, 0xa5, 0x74, 0x86, 0x22, 0x3e, 0xbb, 0x4c, 0x11, 0xc7,
+	0xe6, 0xae, 0x6c, 0xca, 0xb9, 0x9e, 0xb7, 0x2b, 0xbf, 0xcd, 0xdb, 0xc7, 0x21, 0xe1, 0x93, 0x6c,
+	0xe4, 0xf8, 0x34, 0x76, 0xf3, 0xc3, 0x52, 0x3f, 0xef, 0xb1, 0xe0, 0x9b, 0x5c, 0xeb, 0x33, 0xec,
+	0x7b, 0xad, 0x9c, 0xc3, 0x43, 0x1c, 0xdb, 0xbf, 0x68, 0xd0, 0x1c, 0xb2, 0x70, 0x48, 0x12, 0x2e,
+	0x85, 0xc5, 0x49, 0x70, 0x2f, 0xb8, 0x8a, 0x84, 0x0e, 0xbe, 0x68, 0xe8, 0x92, 0x04, 0x66, 0xf5,
+	0x5e, 0x07, 0xd9, 0xe4, 0xe0, 0xcc, 0x6b, 0x4a, 0x70, 0x10, 0x18, 0x07, 0x50, 0x25, 0x81, 0x92,
+	0xbf, 0xdf, 0x58, 0xcc, 0xdb, 0xd5, 0xc1, 0x99, 0x57, 0x25, 0xc1, 0x52, 0xe2, 0xda, 0x16, 0x89,
+	0xeb, 0x25, 0x24, 0x6e, 0x6c, 0x93, 0xd8, 0x46, 0x72, 0x3f, 0xfd, 0x2c, 0x4d, 0x1e, 0x6a, 0x3f,
+	0xb6, 0x0f, 0xbb, 0x43, 0x16, 0x9e, 0xa7, 0x18, 0x7f, 0x8b, 0x1f, 0xac, 0x08, 0x86, 0xd6, 0x90,
+	0x85, 0x17, 0xc9, 0xf8, 0x61, 0xcb, 0x7c, 0xa7, 0xc1, 0x9b, 0x43, 0x16, 0x3e, 0x0f, 0x82, 0x2f,
+	0xe9, 0x57, 0x13, 0xc2, 0x71, 0x44, 0xd8, 0xc3, 0x7d, 0x09, 0x26, 0x34, 0x91, 0xef, 0xd3, 0x2c,
+	0xe1, 0xf9, 0x28, 0x2e, 0x43, 0xfb, 0x7b, 0x0d, 0x0e, 0x86, 0x2c, 0xf4, 0x70, 0x4c, 0xaf, 0xf0,
+	0x79, 0x4a, 0xe3, 0xd7, 0xd9, 0xcc, 0xcf, 0x6a, 0x28, 0xbe, 0xc0, 0x51, 0xa4, 0xaa, 0x47, 0xd1,
+	0x6a, 0x75, 0x11, 0x19, 0xfb, 0x50, 0x1f, 0x65, 0x33, 0x9c, 0xe6, 0x26, 0xa4, 0x82, 0xb5, 0x9e,
+	0xf4, 0xad, 0x3d, 0xd5, 0xfe, 0xd5, 0xd3, 0x87, 0x50, 0x9f, 0xa6, 0xc4, 0xc7, 0x72, 0x18, 0x5a,
+	0xbd, 0x43, 0x47, 0x4d, 0xb0, 0x23, 0x5c, 0xd7, 0xc9, 0x5d, 0xd7, 0x39, 0xa5, 0x24, 0xe9, 0xd7,
+	0xc4, 0xd4, 0x7b, 0x2a, 0xdb, 0xfe, 0x41, 0x83, 0x47, 0xf9, 0x14, 0xf7, 0x11, 0xf7, 0x27, 0xff,
+	0x5b, 0xb3, 0x8f, 0xa1, 0x4e, 0x38, 0x8e, 0x99, 0xa9, 0x77, 0xf4, 0x6e, 0xab, 0x67, 0x6f, 0x74,
+	0xa9, 0xbb, 0x72, 0x03, 0x8e, 0xe3, 0x65, 0x43, 0xf2, 0x35, 0xfb, 0x27, 0x0d, 0xf6, 0xd6, 0xe0,
+	0x7c, 0xc7, 0x5a, 0x91, 0x39, 0x54, 0xb7, 0x98, 0x83, 0x5e, 0xc2, 0x1c, 0x6a, 0x5b, 0xcd, 0xc1,
+	0x85, 0xb7, 0xd6, 0xfe, 0x63, 0x3c, 0xcc, 0xa6, 0x34, 0x61, 0xb8, 0xa8, 0x3b, 0xfb, 0x0d, 0xd8,
+	0xfb, 0x24, 0x9e, 0xf2, 0xd9, 0x32, 0xb1, 0xf7, 0x57, 0x1d, 0xf4, 0x21, 0x0b, 0x8d, 0x97, 0x00,
+	0xf7, 0x34, 0x46, 0x81, 0x3e, 0xab, 0xa5, 0x8e, 0xde, 0xd9, 0x9e, 0x73, 0xd7, 0xce, 0x0b, 0xa8,
+	0x49, 0x47, 0x7e, 0x5a, 0xf4, 0x8e, 0x40, 0x8f, 0x36, 0x57, 0x5d, 0xeb, 0x57, 0x30, 0x49, 0x2f,
+	0x2c, 0x64, 0x12, 0x68, 0x29, 0xa6, 0x4f, 0xa1, 0x91, 0x5b, 0x9e, 0x55, 0xc4, 0xa5, 0xf0, 0x52,
+	0x6c, 0x9f, 0xc1, 0xce, 0x9d, 0xb7, 0x75, 0x8a, 0xf8, 0x96, 0x19, 0xa5, 0x18, 0x5f, 0xc2, 0xe3,
+	0x7f, 0xb8, 0xd8, 0x71, 0x11, 0xef, 0x7a, 0x5e, 0x29, 0xf6, 0x31, 0x3c, 0xd9, 0xe4, 0x4d, 0xef,
+	0x16, 0x95, 0xd8, 0x90, 0x5c, 0xf6, 0xbc, 0xa4, 0xed, 0x14, 0x9e, 0x97, 0x40, 0x4b, 0x31, 0x79,
+	0xb0, 0x7b, 0xef, 0x07, 0xcf, 0xfe, 0xeb, 0x43, 0x92, 0x29, 0x65, 0x38, 0xfb, 0xde, 0xf5, 0x1f,
+	0x56, 0xe5, 0x7a, 0x61, 0x69, 0x37, 0x0b, 0x4b, 0xfb, 0x7d, 0x61, 0x69, 0x3f, 0xde, 0x5a, 0x95,
+	0x9b, 0x5b, 0xab, 0xf2, 0xeb, 0xad, 0x55, 0xf9, 0xfa, 0x83, 0x95, 0x0b, 0xc8, 0xa9, 0xe4, 0x3a,
+	0xa7, 0x59, 0x12, 0x20, 0x71, 0xcf, 0x72, 0xf3, 0xfb, 0xdf, 0xab, 0x95, 0x1b, 0xa0, 0xbc, 0x92,
+	0x8c, 0x1a, 0x72, 0x4e, 0xdf, 0xff, 0x7b, 0x00, 0xce, 0x37, 0xeb, 0xbf, 0xc5, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -427,7 +607,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	// IssueClass creates new non-fungible token class.
-	IssueClass(ctx context.Context, in *MsgIssueClass, opts ...grpc.CallOption) (*EmptyResponse, error)
+	IssueClass(ctx context.Context, in *MsgIssueClass, opts ...grpc.CallOption) (*MsgIssueClassResponse, error)
 	// Mint mints new non-fungible token in the class.
 	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// Burn burns the existing non-fungible token in the class.
@@ -440,6 +620,10 @@ type MsgClient interface {
 	AddToWhitelist(ctx context.Context, in *MsgAddToWhitelist, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// RemoveFromWhitelist removes an account from whitelisted list of the NFT
 	RemoveFromWhitelist(ctx context.Context, in *MsgRemoveFromWhitelist, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// Sell transfers an NFT from the seller to the buyer in exchange for the price and pays the royalty to the class issuer
+	Sell(ctx context.Context, in *MsgSell, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// MintBatch mints multiple non-fungible tokens in the class atomically.
+	MintBatch(ctx context.Context, in *MsgMintBatch, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type msgClient struct {
@@ -450,8 +634,8 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
-func (c *msgClient) IssueClass(ctx context.Context, in *MsgIssueClass, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
+func (c *msgClient) IssueClass(ctx context.Context, in *MsgIssueClass, opts ...grpc.CallOption) (*MsgIssueClassResponse, error) {
+	out := new(MsgIssueClassResponse)
 	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Msg/IssueClass", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -513,10 +697,28 @@ func (c *msgClient) RemoveFromWhitelist(ctx context.Context, in *MsgRemoveFromWh
 	return out, nil
 }
 
+func (c *msgClient) Sell(ctx context.Context, in *MsgSell, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Msg/Sell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintBatch(ctx context.Context, in *MsgMintBatch, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Msg/MintBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// IssueClass creates new non-fungible token class.
-	IssueClass(context.Context, *MsgIssueClass) (*EmptyResponse, error)
+	IssueClass(context.Context, *MsgIssueClass) (*MsgIssueClassResponse, error)
 	// Mint mints new non-fungible token in the class.
 	Mint(context.Context, *MsgMint) (*EmptyResponse, error)
 	// Burn burns the existing non-fungible token in the class.
@@ -529,13 +731,17 @@ type MsgServer interface {
 	AddToWhitelist(context.Context, *MsgAddToWhitelist) (*EmptyResponse, error)
 	// RemoveFromWhitelist removes an account from whitelisted list of the NFT
 	RemoveFromWhitelist(context.Context, *MsgRemoveFromWhitelist) (*EmptyResponse, error)
+	// Sell transfers an NFT from the seller to the buyer in exchange for the price and pays the royalty to the class issuer
+	Sell(context.Context, *MsgSell) (*EmptyResponse, error)
+	// MintBatch mints multiple non-fungible tokens in the class atomically.
+	MintBatch(context.Context, *MsgMintBatch) (*EmptyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
-func (*UnimplementedMsgServer) IssueClass(ctx context.Context, req *MsgIssueClass) (*EmptyResponse, error) {
+func (*UnimplementedMsgServer) IssueClass(ctx context.Context, req *MsgIssueClass) (*MsgIssueClassResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IssueClass not implemented")
 }
 func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMint) (*EmptyResponse, error) {
@@ -556,6 +762,12 @@ func (*UnimplementedMsgServer) AddToWhitelist(ctx context.Context, req *MsgAddTo
 func (*UnimplementedMsgServer) RemoveFromWhitelist(ctx context.Context, req *MsgRemoveFromWhitelist) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelist not implemented")
 }
+func (*UnimplementedMsgServer) Sell(ctx context.Context, req *MsgSell) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sell not implemented")
+}
+func (*UnimplementedMsgServer) MintBatch(ctx context.Context, req *MsgMintBatch) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintBatch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -687,6 +899,42 @@ func _Msg_RemoveFromWhitelist_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Sell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSell)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Sell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Msg/Sell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Sell(ctx, req.(*MsgSell))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Msg/MintBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintBatch(ctx, req.(*MsgMintBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "coreum.asset.nft.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -719,6 +967,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveFromWhitelist",
 			Handler:    _Msg_RemoveFromWhitelist_Handler,
 		},
+		{
+			MethodName: "Sell",
+			Handler:    _Msg_Sell_Handler,
+		},
+		{
+			MethodName: "MintBatch",
+			Handler:    _Msg_MintBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/asset/nft/v1/tx.proto",
@@ -1133,7 +1389,7 @@ func (m *MsgRemoveFromWhitelist) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSell) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1143,96 +1399,294 @@ func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSell) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSell) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgIssueClass) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.URI)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.URIHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Features) > 0 {
-		l = 0
-		for _, e := range m.Features {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.RoyaltyRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMintBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.ClassID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.URI)
-	if l > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *MintBatchItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintBatchItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintBatchItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIssueClassResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssueClassResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgIssueClass) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = m.RoyaltyRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.URIHash)
@@ -1359,35 +1813,123 @@ func (m *MsgRemoveFromWhitelist) Size() (n int) {
 	return n
 }
 
-func (m *EmptyResponse) Size() (n int) {
+func (m *MsgSell) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgMintBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MintBatchItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *MsgIssueClass) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
+
+func (m *MsgIssueClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *EmptyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgIssueClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -1469,7 +2011,716 @@ func (m *MsgIssueClass) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v ClassFeature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClassFeature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]ClassFeature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClassFeature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClassFeature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1497,11 +2748,11 @@ func (m *MsgIssueClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1529,11 +2780,11 @@ func (m *MsgIssueClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ClassID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1561,11 +2812,61 @@ func (m *MsgIssueClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1593,13 +2894,13 @@ func (m *MsgIssueClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1609,100 +2910,27 @@ func (m *MsgIssueClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType == 0 {
-				var v ClassFeature
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= ClassFeature(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Features = append(m.Features, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Features) == 0 {
-					m.Features = make([]ClassFeature, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v ClassFeature
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= ClassFeature(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Features = append(m.Features, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
-			}
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1730,9 +2958,7 @@ func (m *MsgIssueClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1755,7 +2981,7 @@ func (m *MsgIssueClass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMint) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToWhitelist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1778,10 +3004,10 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToWhitelist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1882,39 +3108,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URI = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1940,45 +3134,9 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Data == nil {
-				m.Data = &types.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
 			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2001,7 +3159,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFromWhitelist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2024,10 +3182,10 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFromWhitelist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFromWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2126,6 +3284,38 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			}
 			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2147,7 +3337,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
+func (m *MsgSell) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2170,15 +3360,15 @@ func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFreeze: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSell: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSell: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2206,9 +3396,41 @@ func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
 			}
@@ -2240,7 +3462,7 @@ func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
@@ -2272,6 +3494,39 @@ func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
 			}
 			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2293,7 +3548,7 @@ func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
+func (m *MsgMintBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2316,10 +3571,10 @@ func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnfreeze: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2388,9 +3643,9 @@ func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2400,23 +3655,25 @@ func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, MintBatchItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2439,7 +3696,7 @@ func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddToWhitelist) Unmarshal(dAtA []byte) error {
+func (m *MintBatchItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2462,15 +3719,15 @@ func (m *MsgAddToWhitelist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddToWhitelist: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintBatchItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddToWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintBatchItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2498,11 +3755,11 @@ func (m *MsgAddToWhitelist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2530,11 +3787,11 @@ func (m *MsgAddToWhitelist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassID = string(dAtA[iNdEx:postIndex])
+			m.URI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2562,13 +3819,13 @@ func (m *MsgAddToWhitelist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.URIHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2578,23 +3835,27 @@ func (m *MsgAddToWhitelist) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2617,7 +3878,7 @@ func (m *MsgAddToWhitelist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveFromWhitelist) Unmarshal(dAtA []byte) error {
+func (m *MsgIssueClassResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2640,77 +3901,13 @@ func (m *MsgRemoveFromWhitelist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveFromWhitelist: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIssueClassResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveFromWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIssueClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClassID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
@@ -2742,38 +3939,6 @@ func (m *MsgRemoveFromWhitelist) Unmarshal(dAtA []byte) error {
 			}
 			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -19,11 +19,17 @@ import (
 	feemodelante "github.com/CoreumFoundation/coreum/x/feemodel/ante"
 )
 
+// BankKeeper defines the bank keeper methods required by the ante handler.
+type BankKeeper interface {
+	authtypes.BankKeeper
+	feemodelante.BankKeeper
+}
+
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
 	DeterministicGasConfig deterministicgas.Config
 	AccountKeeper          authante.AccountKeeper
-	BankKeeper             authtypes.BankKeeper
+	BankKeeper             BankKeeper
 	FeegrantKeeper         authante.FeegrantKeeper
 	FeeModelKeeper         feemodelante.Keeper
 	SignModeHandler        authsigning.SignModeHandler
@@ -102,6 +108,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		authante.NewValidateMemoDecorator(options.AccountKeeper),
 		feemodelante.NewFeeDecorator(options.FeeModelKeeper),
 		authante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		feemodelante.NewTipDecorator(options.FeeModelKeeper, options.BankKeeper),
 		authante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		authante.NewValidateSigCountDecorator(options.AccountKeeper),
 		authante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
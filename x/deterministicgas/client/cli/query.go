@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// GetQueryCmd returns the cli query commands for the module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryGasTable())
+	return cmd
+}
+
+// CmdQueryParams return the QueryParams cobra command.
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the parameters of the deterministic gas",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the parameters of the deterministic gas, including the schedule of gas for smart contracts.
+
+Example:
+$ %[1]s query %s params
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryGasTable return the QueryDeterministicGasTable cobra command.
+func CmdQueryGasTable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-table",
+		Args:  cobra.NoArgs,
+		Short: "Query the deterministic gas of message types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deterministic gas of all the deterministic message types.
+
+Example:
+$ %[1]s query %s gas-table
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DeterministicGasTable(cmd.Context(), &types.QueryDeterministicGasTableRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
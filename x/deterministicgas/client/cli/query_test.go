@@ -0,0 +1,27 @@
+package cli_test
+
+import (
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/testutil/network"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/client/cli"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+func TestQueryGasTable(t *testing.T) {
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	cmd := cli.GetQueryCmd()
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cmd, []string{"gas-table", "--output", "json"})
+	require.NoError(t, err)
+
+	var resp types.QueryDeterministicGasTableResponse
+	require.NoError(t, ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+
+	require.Equal(t, deterministicgas.DefaultConfig().GasTable(), resp.GasTable)
+}
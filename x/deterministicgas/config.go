@@ -1,6 +1,8 @@
 package deterministicgas
 
 import (
+	"sort"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/armon/go-metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,10 +21,19 @@ import (
 
 	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 	nfttypes "github.com/CoreumFoundation/coreum/x/nft"
 )
 
-type gasByMsgFunc = func(msg sdk.Msg) (uint64, bool)
+// gasByMsgFunc computes the gas required by the message from the gas of its type taken from the gas table.
+type gasByMsgFunc = func(msg sdk.Msg, gas uint64, gasTable types.GasTable) (uint64, bool)
+
+// msgGasRule defines the default gas of the deterministic message type and the function computing the gas
+// required by the message.
+type msgGasRule struct {
+	defaultGas uint64
+	gasFunc    gasByMsgFunc
+}
 
 // Config specifies gas required by all transaction types
 // Crisis module is intentionally skipped here because it is already deterministic by design and fee is specified
@@ -33,7 +44,8 @@ type Config struct {
 	freeBytes      uint64
 	freeSignatures uint64
 
-	gasByMsg map[string]gasByMsgFunc
+	gasByMsg             map[string]msgGasRule
+	nondeterministicMsgs map[string]struct{}
 }
 
 // DefaultConfig returns default config for deterministic gas.
@@ -46,69 +58,84 @@ func DefaultConfig() Config {
 		freeSignatures: 1,
 	}
 
-	cfg.gasByMsg = map[string]gasByMsgFunc{
+	cfg.gasByMsg = map[string]msgGasRule{
 		// asset/ft
-		MsgType(&assetfttypes.MsgIssue{}):               constantGasFunc(70000),
-		MsgType(&assetfttypes.MsgMint{}):                constantGasFunc(11000),
-		MsgType(&assetfttypes.MsgBurn{}):                constantGasFunc(23000),
-		MsgType(&assetfttypes.MsgFreeze{}):              constantGasFunc(5000),
-		MsgType(&assetfttypes.MsgUnfreeze{}):            constantGasFunc(2500),
-		MsgType(&assetfttypes.MsgGloballyFreeze{}):      constantGasFunc(5000),
-		MsgType(&assetfttypes.MsgGloballyUnfreeze{}):    constantGasFunc(2500),
-		MsgType(&assetfttypes.MsgSetWhitelistedLimit{}): constantGasFunc(5000),
+		MsgType(&assetfttypes.MsgIssue{}):                      constantGas(70000),
+		MsgType(&assetfttypes.MsgMint{}):                       constantGas(11000),
+		MsgType(&assetfttypes.MsgBurn{}):                       constantGas(23000),
+		MsgType(&assetfttypes.MsgFreeze{}):                     constantGas(5000),
+		MsgType(&assetfttypes.MsgUnfreeze{}):                   constantGas(2500),
+		MsgType(&assetfttypes.MsgTimedFreeze{}):                constantGas(10000),
+		MsgType(&assetfttypes.MsgGloballyFreeze{}):             constantGas(5000),
+		MsgType(&assetfttypes.MsgGloballyUnfreeze{}):           constantGas(2500),
+		MsgType(&assetfttypes.MsgSetWhitelistedLimit{}):        constantGas(5000),
+		MsgType(&assetfttypes.MsgTransferAdmin{}):              constantGas(5000),
+		MsgType(&assetfttypes.MsgClearAdmin{}):                 constantGas(5000),
+		MsgType(&assetfttypes.MsgClawback{}):                   constantGas(15500),
+		MsgType(&assetfttypes.MsgUpdateMetadata{}):             constantGas(8000),
+		MsgType(&assetfttypes.MsgAddToBlacklist{}):             constantGas(5000),
+		MsgType(&assetfttypes.MsgRemoveFromBlacklist{}):        constantGas(3500),
+		MsgType(&assetfttypes.MsgGrantMintAllowance{}):         constantGas(5000),
+		MsgType(&assetfttypes.MsgRevokeMintAllowance{}):        constantGas(3500),
+		MsgType(&assetfttypes.MsgAddRateExemption{}):           constantGas(5000),
+		MsgType(&assetfttypes.MsgRemoveRateExemption{}):        constantGas(3500),
+		MsgType(&assetfttypes.MsgUpdateCommissionRecipients{}): constantGas(8000),
 
 		// asset/nft
-		MsgType(&assetnfttypes.MsgBurn{}):                constantGasFunc(16000),
-		MsgType(&assetnfttypes.MsgIssueClass{}):          constantGasFunc(16000),
-		MsgType(&assetnfttypes.MsgMint{}):                constantGasFunc(39000),
-		MsgType(&assetnfttypes.MsgFreeze{}):              constantGasFunc(7000),
-		MsgType(&assetnfttypes.MsgUnfreeze{}):            constantGasFunc(5000),
-		MsgType(&assetnfttypes.MsgAddToWhitelist{}):      constantGasFunc(7000),
-		MsgType(&assetnfttypes.MsgRemoveFromWhitelist{}): constantGasFunc(3500),
+		MsgType(&assetnfttypes.MsgBurn{}):                constantGas(16000),
+		MsgType(&assetnfttypes.MsgIssueClass{}):          constantGas(16000),
+		MsgType(&assetnfttypes.MsgMint{}):                constantGas(39000),
+		MsgType(&assetnfttypes.MsgFreeze{}):              constantGas(7000),
+		MsgType(&assetnfttypes.MsgUnfreeze{}):            constantGas(5000),
+		MsgType(&assetnfttypes.MsgAddToWhitelist{}):      constantGas(7000),
+		MsgType(&assetnfttypes.MsgRemoveFromWhitelist{}): constantGas(3500),
+		MsgType(&assetnfttypes.MsgSell{}):                constantGas(64000),
+		MsgType(&assetnfttypes.MsgMintBatch{}):           {defaultGas: 39000, gasFunc: assetNFTMintBatchMsgGasFunc},
 
 		// authz
-		MsgType(&authz.MsgExec{}):   cfg.authzMsgExecGasFunc(2000),
-		MsgType(&authz.MsgGrant{}):  constantGasFunc(7000),
-		MsgType(&authz.MsgRevoke{}): constantGasFunc(2500),
+		MsgType(&authz.MsgExec{}):   {defaultGas: 2000, gasFunc: cfg.authzMsgExecGasFunc},
+		MsgType(&authz.MsgGrant{}):  constantGas(7000),
+		MsgType(&authz.MsgRevoke{}): constantGas(2500),
 
 		// bank
-		MsgType(&banktypes.MsgSend{}):      bankSendMsgGasFunc(24000),
-		MsgType(&banktypes.MsgMultiSend{}): bankMultiSendMsgGasFunc(11000),
+		MsgType(&banktypes.MsgSend{}):      {defaultGas: 24000, gasFunc: bankSendMsgGasFunc},
+		MsgType(&banktypes.MsgMultiSend{}): {defaultGas: 11000, gasFunc: bankMultiSendMsgGasFunc},
 
 		// distribution
-		MsgType(&distributiontypes.MsgFundCommunityPool{}):           constantGasFunc(15000),
-		MsgType(&distributiontypes.MsgSetWithdrawAddress{}):          constantGasFunc(5000),
-		MsgType(&distributiontypes.MsgWithdrawDelegatorReward{}):     constantGasFunc(65000),
-		MsgType(&distributiontypes.MsgWithdrawValidatorCommission{}): constantGasFunc(22000),
+		MsgType(&distributiontypes.MsgFundCommunityPool{}):           constantGas(15000),
+		MsgType(&distributiontypes.MsgSetWithdrawAddress{}):          constantGas(5000),
+		MsgType(&distributiontypes.MsgWithdrawDelegatorReward{}):     constantGas(65000),
+		MsgType(&distributiontypes.MsgWithdrawValidatorCommission{}): constantGas(22000),
 
 		// feegrant
-		MsgType(&feegranttypes.MsgGrantAllowance{}):  constantGasFunc(10000),
-		MsgType(&feegranttypes.MsgRevokeAllowance{}): constantGasFunc(2500),
+		MsgType(&feegranttypes.MsgGrantAllowance{}):  constantGas(10000),
+		MsgType(&feegranttypes.MsgRevokeAllowance{}): constantGas(2500),
 
 		// gov
-		MsgType(&govtypes.MsgVote{}):         constantGasFunc(7000),
-		MsgType(&govtypes.MsgVoteWeighted{}): constantGasFunc(9000),
-		MsgType(&govtypes.MsgDeposit{}):      constantGasFunc(52000),
+		MsgType(&govtypes.MsgVote{}):         constantGas(7000),
+		MsgType(&govtypes.MsgVoteWeighted{}): constantGas(9000),
+		MsgType(&govtypes.MsgDeposit{}):      constantGas(52000),
 
 		// nft
-		MsgType(&nfttypes.MsgSend{}): constantGasFunc(16000),
+		MsgType(&nfttypes.MsgSend{}):      constantGas(16000),
+		MsgType(&nfttypes.MsgSendBatch{}): {defaultGas: 16000, gasFunc: nftSendBatchMsgGasFunc},
 
 		// slashing
-		MsgType(&slashingtypes.MsgUnjail{}): constantGasFunc(25000),
+		MsgType(&slashingtypes.MsgUnjail{}): constantGas(25000),
 
 		// staking
-		MsgType(&stakingtypes.MsgDelegate{}):        constantGasFunc(69000),
-		MsgType(&stakingtypes.MsgUndelegate{}):      constantGasFunc(112000),
-		MsgType(&stakingtypes.MsgBeginRedelegate{}): constantGasFunc(142000),
-		MsgType(&stakingtypes.MsgCreateValidator{}): constantGasFunc(76000),
-		MsgType(&stakingtypes.MsgEditValidator{}):   constantGasFunc(13000),
+		MsgType(&stakingtypes.MsgDelegate{}):        constantGas(69000),
+		MsgType(&stakingtypes.MsgUndelegate{}):      constantGas(112000),
+		MsgType(&stakingtypes.MsgBeginRedelegate{}): constantGas(142000),
+		MsgType(&stakingtypes.MsgCreateValidator{}): constantGas(76000),
+		MsgType(&stakingtypes.MsgEditValidator{}):   constantGas(13000),
 
 		// vesting
-		MsgType(&vestingtypes.MsgCreateVestingAccount{}): constantGasFunc(25000),
+		MsgType(&vestingtypes.MsgCreateVestingAccount{}): constantGas(25000),
 
 		// wasm
-		MsgType(&wasmtypes.MsgUpdateAdmin{}): constantGasFunc(8000),
-		MsgType(&wasmtypes.MsgClearAdmin{}):  constantGasFunc(6500),
+		MsgType(&wasmtypes.MsgUpdateAdmin{}): constantGas(8000),
+		MsgType(&wasmtypes.MsgClearAdmin{}):  constantGas(6500),
 	}
 
 	registerNondeterministicGasFuncs(
@@ -153,16 +180,97 @@ func (cfg Config) TxBaseGas(params authtypes.Params) uint64 {
 // GasRequiredByMessage returns gas required by message and true if message is deterministic.
 // Function returns 0 and false if message is nondeterministic or unknown.
 func (cfg Config) GasRequiredByMessage(msg sdk.Msg) (uint64, bool) {
-	gasFunc, ok := cfg.gasByMsg[MsgType(msg)]
-	if ok {
-		return gasFunc(msg)
+	return cfg.GasRequiredByMessageWithTable(msg, cfg)
+}
+
+// GasRequiredByMessageWithTable returns gas required by message and true if message is deterministic.
+// The gas of message types is taken from the gas table, the default one is used if the table doesn't define it.
+// Function returns 0 and false if message is nondeterministic or unknown.
+func (cfg Config) GasRequiredByMessageWithTable(msg sdk.Msg, gasTable types.GasTable) (uint64, bool) {
+	msgType := MsgType(msg)
+	if _, ok := cfg.nondeterministicMsgs[msgType]; ok {
+		return 0, false
+	}
+
+	rule, ok := cfg.gasByMsg[msgType]
+	if !ok {
+		// Currently we treat unknown message types as nondeterministic.
+		// In the future other approach could be to return third boolean parameter
+		// identifying if message is known and report unknown messages to monitoring.
+		reportUnknownMessageMetric(msgType)
+		return 0, false
+	}
+
+	gas, ok := gasTable.GasByMsgType(msgType)
+	if !ok {
+		gas = rule.defaultGas
+	}
+	return rule.gasFunc(msg, gas, gasTable)
+}
+
+// GasByMsgType returns the default gas of the deterministic message type and true if it is defined.
+func (cfg Config) GasByMsgType(msgType string) (uint64, bool) {
+	rule, ok := cfg.gasByMsg[msgType]
+	if !ok {
+		return 0, false
 	}
+	return rule.defaultGas, true
+}
+
+// GasTable returns the default gas of all the deterministic message types sorted by the message type.
+func (cfg Config) GasTable() []types.MsgGas {
+	gasTable := make([]types.MsgGas, 0, len(cfg.gasByMsg))
+	for msgType, rule := range cfg.gasByMsg {
+		gasTable = append(gasTable, types.MsgGas{
+			MsgType: msgType,
+			Gas:     rule.defaultGas,
+		})
+	}
+	sort.Slice(gasTable, func(i, j int) bool {
+		return gasTable[i].MsgType < gasTable[j].MsgType
+	})
+	return gasTable
+}
 
-	// Currently we treat unknown message types as nondeterministic.
-	// In the future other approach could be to return third boolean parameter
-	// identifying if message is known and report unknown messages to monitoring.
-	reportUnknownMessageMetric(MsgType(msg))
-	return 0, false
+// TransferredCoins returns the coins which might be transferred by the deterministic message, including the coins
+// of the messages executed by authz MsgExec. The transfers of the asset ft tokens with the extension feature call
+// the extension contract, which consumes the gas on top of the deterministic one, so the gas of such messages must
+// be estimated by the simulation.
+func (cfg Config) TransferredCoins(msg sdk.Msg) []sdk.Coin {
+	switch m := msg.(type) {
+	case *banktypes.MsgSend:
+		return m.Amount
+	case *banktypes.MsgMultiSend:
+		var coins []sdk.Coin
+		for _, input := range m.Inputs {
+			coins = append(coins, input.Coins...)
+		}
+		return coins
+	case *authz.MsgExec:
+		childMsgs, err := m.GetMessages()
+		if err != nil {
+			return nil
+		}
+		var coins []sdk.Coin
+		for _, childMsg := range childMsgs {
+			coins = append(coins, cfg.TransferredCoins(childMsg)...)
+		}
+		return coins
+	case *assetfttypes.MsgMint:
+		return []sdk.Coin{m.Coin}
+	case *assetfttypes.MsgBurn:
+		return []sdk.Coin{m.Coin}
+	case *assetfttypes.MsgClawback:
+		return []sdk.Coin{m.Coin}
+	case *assetnfttypes.MsgSell:
+		return []sdk.Coin{m.Price}
+	case *distributiontypes.MsgFundCommunityPool:
+		return m.Amount
+	case *vestingtypes.MsgCreateVestingAccount:
+		return m.Amount
+	default:
+		return nil
+	}
 }
 
 // MsgType returns TypeURL of a msg in cosmos SDK style.
@@ -175,77 +283,71 @@ func MsgType(msg sdk.Msg) string {
 
 // NOTE: we need to pass Config by pointer here because
 // it needs to be initialized later map with all msg types inside to estimate gas recursively.
-func (cfg *Config) authzMsgExecGasFunc(authzMsgExecOverhead uint64) gasByMsgFunc {
-	return func(msg sdk.Msg) (uint64, bool) {
-		m, ok := msg.(*authz.MsgExec)
-		if !ok {
-			return 0, false
-		}
+func (cfg *Config) authzMsgExecGasFunc(msg sdk.Msg, authzMsgExecOverhead uint64, gasTable types.GasTable) (uint64, bool) {
+	m, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return 0, false
+	}
 
-		totalGas := authzMsgExecOverhead
-		childMsgs, err := m.GetMessages()
-		if err != nil {
+	totalGas := authzMsgExecOverhead
+	childMsgs, err := m.GetMessages()
+	if err != nil {
+		return 0, false
+	}
+	for _, childMsg := range childMsgs {
+		gas, isDeterministic := cfg.GasRequiredByMessageWithTable(childMsg, gasTable)
+		if !isDeterministic {
 			return 0, false
 		}
-		for _, childMsg := range childMsgs {
-			gas, isDeterministic := cfg.GasRequiredByMessage(childMsg)
-			if !isDeterministic {
-				return 0, false
-			}
-			totalGas += gas
-		}
-		return totalGas, true
+		totalGas += gas
 	}
+	return totalGas, true
 }
 
 func registerNondeterministicGasFuncs(cfg *Config, msgs []sdk.Msg) {
+	cfg.nondeterministicMsgs = make(map[string]struct{}, len(msgs))
 	for _, msg := range msgs {
-		cfg.gasByMsg[MsgType(msg)] = nondeterministicGasFunc()
+		cfg.nondeterministicMsgs[MsgType(msg)] = struct{}{}
 	}
 }
 
-func constantGasFunc(constGasVal uint64) gasByMsgFunc {
-	return func(msg sdk.Msg) (uint64, bool) {
-		return constGasVal, true
+func constantGas(gas uint64) msgGasRule {
+	return msgGasRule{
+		defaultGas: gas,
+		gasFunc:    constantGasFunc,
 	}
 }
 
-func nondeterministicGasFunc() gasByMsgFunc {
-	return func(msg sdk.Msg) (uint64, bool) {
-		return 0, false
-	}
+func constantGasFunc(msg sdk.Msg, gas uint64, _ types.GasTable) (uint64, bool) {
+	return gas, true
 }
 
-func bankSendMsgGasFunc(bankSendPerCoinGas uint64) gasByMsgFunc {
-	return func(msg sdk.Msg) (uint64, bool) {
-		m, ok := msg.(*banktypes.MsgSend)
-		if !ok {
-			return 0, false
-		}
-		entriesNum := len(m.Amount)
-
-		return uint64(lo.Max([]int{entriesNum, 1})) * bankSendPerCoinGas, true
+func bankSendMsgGasFunc(msg sdk.Msg, bankSendPerCoinGas uint64, _ types.GasTable) (uint64, bool) {
+	m, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		return 0, false
 	}
-}
+	entriesNum := len(m.Amount)
 
-func bankMultiSendMsgGasFunc(bankMultiSendPerOperationGas uint64) gasByMsgFunc {
-	return func(msg sdk.Msg) (uint64, bool) {
-		m, ok := msg.(*banktypes.MsgMultiSend)
-		if !ok {
-			return 0, false
-		}
-		totalOperationsNum := 0
-		for _, inp := range m.Inputs {
-			totalOperationsNum += len(inp.Coins)
-		}
+	return uint64(lo.Max([]int{entriesNum, 1})) * bankSendPerCoinGas, true
+}
 
-		for _, outp := range m.Outputs {
-			totalOperationsNum += len(outp.Coins)
-		}
+func bankMultiSendMsgGasFunc(msg sdk.Msg, bankMultiSendPerOperationGas uint64, _ types.GasTable) (uint64, bool) {
+	m, ok := msg.(*banktypes.MsgMultiSend)
+	if !ok {
+		return 0, false
+	}
+	totalOperationsNum := 0
+	for _, inp := range m.Inputs {
+		totalOperationsNum += len(inp.Coins)
+	}
 
-		// Minimum 2 operations (1 input & 1 output) should be present inside any multi-send.
-		return uint64(lo.Max([]int{totalOperationsNum, 2})) * bankMultiSendPerOperationGas, true
+	for _, outp := range m.Outputs {
+		totalOperationsNum += len(outp.Coins)
 	}
+
+	// Minimum 2 operations (1 input & 1 output) should be present inside any multi-send.
+	return uint64(lo.Max([]int{totalOperationsNum, 2})) * bankMultiSendPerOperationGas, true
 }
 
 func reportUnknownMessageMetric(msgName string) {
@@ -253,3 +355,23 @@ func reportUnknownMessageMetric(msgName string) {
 		{Name: "msg_name", Value: msgName},
 	})
 }
+
+func assetNFTMintBatchMsgGasFunc(msg sdk.Msg, assetNFTMintPerItemGas uint64, _ types.GasTable) (uint64, bool) {
+	m, ok := msg.(*assetnfttypes.MsgMintBatch)
+	if !ok {
+		return 0, false
+	}
+	itemsNum := len(m.Items)
+
+	return uint64(lo.Max([]int{itemsNum, 1})) * assetNFTMintPerItemGas, true
+}
+
+func nftSendBatchMsgGasFunc(msg sdk.Msg, nftSendPerItemGas uint64, _ types.GasTable) (uint64, bool) {
+	m, ok := msg.(*nfttypes.MsgSendBatch)
+	if !ok {
+		return 0, false
+	}
+	itemsNum := len(m.Items)
+
+	return uint64(lo.Max([]int{itemsNum, 1})) * nftSendPerItemGas, true
+}
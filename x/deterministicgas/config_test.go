@@ -2,6 +2,7 @@ package deterministicgas_test
 
 import (
 	"reflect"
+	"sort"
 	"testing"
 	_ "unsafe"
 
@@ -14,7 +15,9 @@ import (
 
 	"github.com/CoreumFoundation/coreum/testutil/simapp"
 	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
 	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	nfttypes "github.com/CoreumFoundation/coreum/x/nft"
 )
 
 // To access private variable from github.com/gogo/protobuf we link it to local variable.
@@ -127,7 +130,7 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 	// we assert length to be equal to exact number, so each change requires
 	// explicit adjustment of tests.
 	assert.Equal(t, 10, len(nondeterministicMsgs))
-	assert.Equal(t, 39, len(deterministicMsgs))
+	assert.Equal(t, 54, len(deterministicMsgs))
 
 	for _, sdkMsg := range deterministicMsgs {
 		sdkMsg := sdkMsg
@@ -135,6 +138,9 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 			gas, ok := cfg.GasRequiredByMessage(sdkMsg)
 			assert.True(t, ok)
 			assert.Positive(t, gas)
+
+			_, ok = cfg.GasByMsgType(deterministicgas.MsgType(sdkMsg))
+			assert.True(t, ok)
 		})
 	}
 
@@ -144,8 +150,17 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 			gas, ok := cfg.GasRequiredByMessage(sdkMsg)
 			assert.False(t, ok)
 			assert.Zero(t, gas)
+
+			_, ok = cfg.GasByMsgType(deterministicgas.MsgType(sdkMsg))
+			assert.False(t, ok)
 		})
 	}
+
+	gasTable := cfg.GasTable()
+	assert.Len(t, gasTable, len(deterministicMsgs))
+	assert.True(t, sort.SliceIsSorted(gasTable, func(i, j int) bool {
+		return gasTable[i].MsgType < gasTable[j].MsgType
+	}))
 }
 
 //nolint:funlen
@@ -158,6 +173,8 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 		bankSendPerEntryGas      = 24000
 		bankMultiSendPerEntryGas = 11000
 		authzMsgExecOverhead     = 2000
+		assetNFTMintPerItemGas   = 39000
+		nftSendPerItemGas        = 16000
 	)
 
 	cfg := deterministicgas.DefaultConfig()
@@ -251,6 +268,34 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 			expectedGas:             5 * bankMultiSendPerEntryGas,
 			expectedIsDeterministic: true,
 		},
+		{
+			name:                    "assetnft.MsgMintBatch: 0 items",
+			msg:                     &assetnfttypes.MsgMintBatch{},
+			expectedGas:             assetNFTMintPerItemGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetnft.MsgMintBatch: 3 items",
+			msg: &assetnfttypes.MsgMintBatch{
+				Items: make([]assetnfttypes.MintBatchItem, 3),
+			},
+			expectedGas:             3 * assetNFTMintPerItemGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name:                    "nft.MsgSendBatch: 0 items",
+			msg:                     &nfttypes.MsgSendBatch{},
+			expectedGas:             nftSendPerItemGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "nft.MsgSendBatch: 4 items",
+			msg: &nfttypes.MsgSendBatch{
+				Items: make([]*nfttypes.SendBatchItem, 4),
+			},
+			expectedGas:             4 * nftSendPerItemGas,
+			expectedIsDeterministic: true,
+		},
 		{
 			name:                    "authz.MsgExec: 0 messages",
 			msg:                     &authz.MsgExec{},
@@ -304,3 +349,77 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 		})
 	}
 }
+
+type gasTableMock map[string]uint64
+
+func (t gasTableMock) GasByMsgType(msgType string) (uint64, bool) {
+	gas, ok := t[msgType]
+	return gas, ok
+}
+
+func TestDeterministicGas_GasRequiredByMessageWithTable(t *testing.T) {
+	const (
+		address = "devcore15eqsya33vx9p5zt7ad8fg3k674tlsllk3pvqp6"
+
+		assetFTIssue        = 70000
+		assetFTMint         = 20000
+		bankSendPerEntryGas = 30000
+	)
+
+	cfg := deterministicgas.DefaultConfig()
+	gasTable := gasTableMock{
+		deterministicgas.MsgType(&assetfttypes.MsgMint{}): assetFTMint,
+		deterministicgas.MsgType(&banktypes.MsgSend{}):    bankSendPerEntryGas,
+	}
+
+	gas, isDeterministic := cfg.GasRequiredByMessageWithTable(&assetfttypes.MsgMint{}, gasTable)
+	assert.True(t, isDeterministic)
+	assert.EqualValues(t, assetFTMint, gas)
+
+	// the default gas is used if the message type is not present in the table
+	gas, isDeterministic = cfg.GasRequiredByMessageWithTable(&assetfttypes.MsgIssue{}, gasTable)
+	assert.True(t, isDeterministic)
+	assert.EqualValues(t, assetFTIssue, gas)
+
+	// the gas of the special cases is computed from the gas in the table
+	gas, isDeterministic = cfg.GasRequiredByMessageWithTable(&banktypes.MsgSend{
+		Amount: sdk.NewCoins(sdk.NewCoin("ducore", sdk.OneInt()), sdk.NewCoin("uatom", sdk.OneInt())),
+	}, gasTable)
+	assert.True(t, isDeterministic)
+	assert.EqualValues(t, 2*bankSendPerEntryGas, gas)
+
+	// the table is used for the messages nested in authz.MsgExec
+	authzMsgExecOverhead, _ := cfg.GasByMsgType(deterministicgas.MsgType(&authz.MsgExec{}))
+	gas, isDeterministic = cfg.GasRequiredByMessageWithTable(
+		lo.ToPtr(authz.NewMsgExec(sdk.AccAddress(address), []sdk.Msg{&assetfttypes.MsgMint{}})),
+		gasTable,
+	)
+	assert.True(t, isDeterministic)
+	assert.EqualValues(t, authzMsgExecOverhead+assetFTMint, gas)
+
+	// the table doesn't make the nondeterministic message deterministic
+	gasTable[deterministicgas.MsgType(&wasmtypes.MsgExecuteContract{})] = 1000
+	_, isDeterministic = cfg.GasRequiredByMessageWithTable(&wasmtypes.MsgExecuteContract{}, gasTable)
+	assert.False(t, isDeterministic)
+}
+
+func TestDeterministicGas_TransferredCoins(t *testing.T) {
+	const address = "devcore15eqsya33vx9p5zt7ad8fg3k674tlsllk3pvqp6"
+
+	cfg := deterministicgas.DefaultConfig()
+	coin1 := sdk.NewCoin("ducore", sdk.OneInt())
+	coin2 := sdk.NewCoin("uatom", sdk.OneInt())
+
+	assert.Equal(t, []sdk.Coin{coin1, coin2}, cfg.TransferredCoins(&banktypes.MsgSend{Amount: sdk.NewCoins(coin1, coin2)}))
+	assert.Equal(t, []sdk.Coin{coin1, coin2}, cfg.TransferredCoins(&banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{
+			{Address: address, Coins: sdk.NewCoins(coin1)},
+			{Address: address, Coins: sdk.NewCoins(coin2)},
+		},
+	}))
+	assert.Equal(t, []sdk.Coin{coin1, coin2}, cfg.TransferredCoins(lo.ToPtr(authz.NewMsgExec(
+		sdk.AccAddress(address),
+		[]sdk.Msg{&assetfttypes.MsgMint{Coin: coin1}, &banktypes.MsgSend{Amount: sdk.NewCoins(coin2)}},
+	))))
+	assert.Empty(t, cfg.TransferredCoins(&assetfttypes.MsgFreeze{Coin: coin1}))
+}
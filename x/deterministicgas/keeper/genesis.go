@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// InitGenesis initializes the deterministicgas module's state with the provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	if err := k.SetGasTable(ctx, genState.GasTable); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the deterministicgas module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:   k.GetParams(ctx),
+		GasTable: k.getStoredGasTable(ctx),
+	}
+}
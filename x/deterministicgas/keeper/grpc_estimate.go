@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+var _ types.ServiceServer = EstimateGasService{}
+
+// SimulateFunc simulates the execution of the raw transaction.
+type SimulateFunc func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
+
+// EstimateGasKeeper defines subscope of keeper methods required by the gas estimation service.
+type EstimateGasKeeper interface {
+	GasRequiredByMessage(ctx sdk.Context, msg sdk.Msg) (uint64, bool)
+	TransfersExtensionTokens(ctx sdk.Context, msg sdk.Msg) bool
+}
+
+// EstimateGasService serves grpc requests estimating the gas used by transactions.
+type EstimateGasService struct {
+	keeper      EstimateGasKeeper
+	txDecoder   sdk.TxDecoder
+	anteHandler sdk.AnteHandler
+	simulate    SimulateFunc
+}
+
+// NewEstimateGasService creates the gas estimation service.
+func NewEstimateGasService(
+	keeper EstimateGasKeeper,
+	txDecoder sdk.TxDecoder,
+	anteHandler sdk.AnteHandler,
+	simulate SimulateFunc,
+) EstimateGasService {
+	return EstimateGasService{
+		keeper:      keeper,
+		txDecoder:   txDecoder,
+		anteHandler: anteHandler,
+		simulate:    simulate,
+	}
+}
+
+// EstimateGas estimates the gas used by the transaction. The gas of the transaction containing deterministic messages
+// only is computed without executing them, other transactions are simulated.
+func (s EstimateGasService) EstimateGas(ctx context.Context, req *types.EstimateGasRequest) (*types.EstimateGasResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tx, err := s.txDecoder(req.TxBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction: %s", err)
+	}
+
+	gasUsed, isDeterministic, err := s.deterministicGas(sdk.UnwrapSDKContext(ctx), tx, req.TxBytes)
+	if err != nil {
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	if isDeterministic {
+		return &types.EstimateGasResponse{
+			GasUsed:       gasUsed,
+			Deterministic: true,
+		}, nil
+	}
+
+	gasInfo, _, err := s.simulate(req.TxBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "%v With gas wanted: '%d' and gas used: '%d' ", err, gasInfo.GasWanted, gasInfo.GasUsed)
+	}
+
+	return &types.EstimateGasResponse{
+		GasUsed:       gasInfo.GasUsed,
+		Deterministic: false,
+	}, nil
+}
+
+// deterministicGas returns the gas used by the transaction and true if all of its messages are deterministic.
+// Instead of executing the messages, the ante handler is run in simulation mode to compute the gas charged
+// for the transaction itself (fixed gas, size and signatures), and the deterministic gas of messages is added to it.
+// The transactions transferring the tokens with the extension are simulated, because the gas consumed by
+// the extension contract is charged on top of the deterministic gas.
+func (s EstimateGasService) deterministicGas(ctx sdk.Context, tx sdk.Tx, txBytes []byte) (uint64, bool, error) {
+	var msgsGas uint64
+	for _, msg := range tx.GetMsgs() {
+		gas, isDeterministic := s.keeper.GasRequiredByMessage(ctx, msg)
+		if !isDeterministic || s.keeper.TransfersExtensionTokens(ctx, msg) {
+			return 0, false, nil
+		}
+		msgsGas += gas
+	}
+
+	// changes made by the ante handler (e.g. fee deduction) must not affect the query state
+	ctx, _ = ctx.CacheContext()
+	ctx, err := s.anteHandler(ctx.WithTxBytes(txBytes), tx, true)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return ctx.GasMeter().GasConsumed() + msgsGas, true, nil
+}
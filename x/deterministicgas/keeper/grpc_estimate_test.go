@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/keeper"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+type txMock struct {
+	msgs []sdk.Msg
+}
+
+func (tx txMock) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
+
+func (tx txMock) ValidateBasic() error {
+	return nil
+}
+
+type assetFTKeeperMock struct {
+	extensionDenoms map[string]bool
+}
+
+func (k assetFTKeeperMock) GetDefinition(_ sdk.Context, denom string) (assetfttypes.Definition, error) {
+	if !k.extensionDenoms[denom] {
+		return assetfttypes.Definition{}, assetfttypes.ErrTokenNotFound
+	}
+	return assetfttypes.Definition{
+		Denom:    denom,
+		Features: []assetfttypes.Feature{assetfttypes.Feature_extension},
+	}, nil
+}
+
+func TestEstimateGasService(t *testing.T) {
+	const (
+		anteGas        = 65000
+		simulatedGas   = 123456
+		extensionDenom = "ext-devcore1x"
+	)
+
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	bankSendGas, ok := deterministicgas.DefaultConfig().GasByMsgType(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	requireT.True(ok)
+
+	txs := map[string]sdk.Tx{
+		"deterministic": txMock{msgs: []sdk.Msg{
+			&banktypes.MsgSend{},
+			&banktypes.MsgSend{},
+		}},
+		"nondeterministic": txMock{msgs: []sdk.Msg{
+			&banktypes.MsgSend{},
+			&wasmtypes.MsgExecuteContract{},
+		}},
+		"extension": txMock{msgs: []sdk.Msg{
+			&banktypes.MsgSend{},
+			&banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewInt64Coin(extensionDenom, 1))},
+		}},
+	}
+
+	gasKeeper := keeper.NewKeeper(
+		testApp.GetSubspace(types.ModuleName),
+		testApp.GetKey(types.StoreKey),
+		deterministicgas.DefaultConfig(),
+		wasmKeeperMock{},
+		assetFTKeeperMock{extensionDenoms: map[string]bool{extensionDenom: true}},
+	)
+
+	var simulated bool
+	service := keeper.NewEstimateGasService(
+		gasKeeper,
+		func(txBytes []byte) (sdk.Tx, error) {
+			tx, ok := txs[string(txBytes)]
+			if !ok {
+				return nil, errors.New("unknown tx")
+			}
+			return tx, nil
+		},
+		func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			requireT.True(simulate)
+			ctx.GasMeter().ConsumeGas(anteGas, "ante")
+			return ctx, nil
+		},
+		func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+			simulated = true
+			return sdk.GasInfo{GasUsed: simulatedGas}, &sdk.Result{}, nil
+		},
+	)
+
+	res, err := service.EstimateGas(sdk.WrapSDKContext(ctx), &types.EstimateGasRequest{TxBytes: []byte("deterministic")})
+	requireT.NoError(err)
+	requireT.True(res.Deterministic)
+	requireT.Equal(anteGas+2*bankSendGas, res.GasUsed)
+	requireT.False(simulated)
+
+	res, err = service.EstimateGas(sdk.WrapSDKContext(ctx), &types.EstimateGasRequest{TxBytes: []byte("nondeterministic")})
+	requireT.NoError(err)
+	requireT.False(res.Deterministic)
+	requireT.EqualValues(simulatedGas, res.GasUsed)
+	requireT.True(simulated)
+
+	// the transfers of the tokens with the extension are simulated, since the extension consumes the gas on top
+	simulated = false
+	res, err = service.EstimateGas(sdk.WrapSDKContext(ctx), &types.EstimateGasRequest{TxBytes: []byte("extension")})
+	requireT.NoError(err)
+	requireT.False(res.Deterministic)
+	requireT.EqualValues(simulatedGas, res.GasUsed)
+	requireT.True(simulated)
+
+	_, err = service.EstimateGas(sdk.WrapSDKContext(ctx), &types.EstimateGasRequest{TxBytes: []byte("invalid")})
+	requireT.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = service.EstimateGas(sdk.WrapSDKContext(ctx), &types.EstimateGasRequest{})
+	requireT.Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetGasTable(ctx sdk.Context) []types.MsgGas
+}
+
+// NewQueryService creates query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// QueryService serves grpc requests for the deterministic gas.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// Params returns params of the deterministic gas.
+func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryParamsResponse{
+		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
+
+// DeterministicGasTable returns the deterministic gas of all the deterministic message types.
+func (qs QueryService) DeterministicGasTable(
+	ctx context.Context,
+	req *types.QueryDeterministicGasTableRequest,
+) (*types.QueryDeterministicGasTableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryDeterministicGasTableResponse{
+		GasTable: qs.keeper.GetGasTable(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
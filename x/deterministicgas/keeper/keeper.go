@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// GasConfig defines the deterministic gas config methods required by the deterministicgas keeper.
+type GasConfig interface {
+	GasByMsgType(msgType string) (uint64, bool)
+	GasTable() []types.MsgGas
+	GasRequiredByMessageWithTable(msg sdk.Msg, gasTable types.GasTable) (uint64, bool)
+	TransferredCoins(msg sdk.Msg) []sdk.Coin
+}
+
+// WasmKeeper defines the wasm keeper methods required by the deterministicgas keeper.
+type WasmKeeper interface {
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
+
+// AssetFTKeeper defines the assetft keeper methods required by the deterministicgas keeper.
+type AssetFTKeeper interface {
+	GetDefinition(ctx sdk.Context, denom string) (assetfttypes.Definition, error)
+}
+
+// Keeper is deterministicgas module Keeper.
+type Keeper struct {
+	paramSubspace paramtypes.Subspace
+	storeKey      sdk.StoreKey
+	config        GasConfig
+	wasmKeeper    WasmKeeper
+	assetFTKeeper AssetFTKeeper
+}
+
+// NewKeeper returns a new Keeper instance.
+func NewKeeper(
+	paramSubspace paramtypes.Subspace,
+	storeKey sdk.StoreKey,
+	config GasConfig,
+	wasmKeeper WasmKeeper,
+	assetFTKeeper AssetFTKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSubspace.HasKeyTable() {
+		paramSubspace = paramSubspace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSubspace: paramSubspace,
+		storeKey:      storeKey,
+		config:        config,
+		wasmKeeper:    wasmKeeper,
+		assetFTKeeper: assetFTKeeper,
+	}
+}
+
+// GetParams returns the parameters of the module.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSubspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetGasTable returns the deterministic gas of all the deterministic message types sorted by the message type.
+// The gas stored in the module state takes precedence over the default one.
+func (k Keeper) GetGasTable(ctx sdk.Context) []types.MsgGas {
+	gasTable := k.config.GasTable()
+	for i, entry := range gasTable {
+		if gas, found := k.getMsgGas(ctx, entry.MsgType); found {
+			gasTable[i].Gas = gas
+		}
+	}
+	return gasTable
+}
+
+// SetGasTable validates and stores the deterministic gas of the message types. Only the message types which are
+// deterministic according to the config are accepted.
+func (k Keeper) SetGasTable(ctx sdk.Context, gasTable []types.MsgGas) error {
+	if err := types.ValidateGasTable(gasTable); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	for _, entry := range gasTable {
+		if _, isDeterministic := k.config.GasByMsgType(entry.MsgType); !isDeterministic {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message type %q is not deterministic", entry.MsgType)
+		}
+	}
+
+	for _, entry := range gasTable {
+		k.setMsgGas(ctx, entry)
+	}
+	return nil
+}
+
+// GasRequiredByMessage returns gas required by message and true if message is deterministic.
+// The gas of message types is taken from the gas table stored in the module state.
+// Apart from the messages defined in the config, the execute messages of smart contracts are deterministic
+// if their variants are defined in the schedule of gas stored in params.
+func (k Keeper) GasRequiredByMessage(ctx sdk.Context, msg sdk.Msg) (uint64, bool) {
+	// gas consumed by the lookup must not be charged, otherwise the gas used by the message wouldn't be deterministic
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	if executeMsg, ok := msg.(*wasmtypes.MsgExecuteContract); ok {
+		if gas, isDeterministic := k.wasmExecuteGasRequired(ctx, executeMsg); isDeterministic {
+			return gas, true
+		}
+	}
+	return k.config.GasRequiredByMessageWithTable(msg, storeGasTable{ctx: ctx, keeper: k})
+}
+
+// TransfersExtensionTokens returns true if the message transfers the asset ft tokens with the extension feature.
+// The gas consumed by the extension contract is charged on top of the deterministic gas of the message, so the gas
+// used by such a message can't be computed without executing it.
+func (k Keeper) TransfersExtensionTokens(ctx sdk.Context, msg sdk.Msg) bool {
+	for _, coin := range k.config.TransferredCoins(msg) {
+		def, err := k.assetFTKeeper.GetDefinition(ctx, coin.Denom)
+		if err != nil {
+			continue
+		}
+		if def.IsFeatureEnabled(assetfttypes.Feature_extension) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k Keeper) wasmExecuteGasRequired(ctx sdk.Context, msg *wasmtypes.MsgExecuteContract) (uint64, bool) {
+	contractAddress, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return 0, false
+	}
+	contractInfo := k.wasmKeeper.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return 0, false
+	}
+	msgVariant, err := types.WasmMsgVariant(msg.Msg)
+	if err != nil {
+		return 0, false
+	}
+
+	return k.GetParams(ctx).WasmExecuteGasRequired(contractInfo.CodeID, msg.Contract, msgVariant)
+}
+
+func (k Keeper) getMsgGas(ctx sdk.Context, msgType string) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateMsgGasKey(msgType))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+func (k Keeper) setMsgGas(ctx sdk.Context, msgGas types.MsgGas) {
+	ctx.KVStore(k.storeKey).Set(types.CreateMsgGasKey(msgGas.MsgType), sdk.Uint64ToBigEndian(msgGas.Gas))
+}
+
+func (k Keeper) getStoredGasTable(ctx sdk.Context) []types.MsgGas {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.GasTableKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	gasTable := []types.MsgGas{}
+	for ; iterator.Valid(); iterator.Next() {
+		gasTable = append(gasTable, types.MsgGas{
+			MsgType: string(iterator.Key()),
+			Gas:     sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return gasTable
+}
+
+// storeGasTable provides the deterministic gas of the message types stored in the module state.
+type storeGasTable struct {
+	ctx    sdk.Context
+	keeper Keeper
+}
+
+func (t storeGasTable) GasByMsgType(msgType string) (uint64, bool) {
+	return t.keeper.getMsgGas(t.ctx, msgType)
+}
@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/keeper"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+type wasmKeeperMock struct {
+	contracts map[string]uint64
+}
+
+func (k wasmKeeperMock) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	codeID, ok := k.contracts[contractAddress.String()]
+	if !ok {
+		return nil
+	}
+	return &wasmtypes.ContractInfo{CodeID: codeID}
+}
+
+func TestKeeper_InitAndExportGenesis(t *testing.T) {
+	testApp := simapp.New()
+	gasKeeper := testApp.DeterministicGasKeeper
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	genState := types.GenesisState{
+		Params: types.Params{
+			WasmExecuteGas: []types.WasmExecuteGas{
+				{CodeID: 1, MsgVariant: "transfer", Gas: 100},
+			},
+		},
+		GasTable: []types.MsgGas{
+			{MsgType: deterministicgas.MsgType(&banktypes.MsgSend{}), Gas: 30000},
+		},
+	}
+	gasKeeper.InitGenesis(ctx, genState)
+
+	requireT := require.New(t)
+	requireT.Equal(genState.Params, gasKeeper.GetParams(ctx))
+	requireT.Contains(gasKeeper.GetGasTable(ctx), genState.GasTable[0])
+
+	// the table stored by the default genesis of the app is exported together with the imported one
+	exportedGenState := gasKeeper.ExportGenesis(ctx)
+	requireT.Equal(genState.Params, exportedGenState.Params)
+	requireT.Equal(gasKeeper.GetGasTable(ctx), exportedGenState.GasTable)
+}
+
+func TestKeeper_InitGenesisWithNondeterministicMsgType(t *testing.T) {
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	genState := *types.DefaultGenesisState()
+	genState.GasTable = []types.MsgGas{
+		{MsgType: deterministicgas.MsgType(&wasmtypes.MsgExecuteContract{}), Gas: 30000},
+	}
+	require.NoError(t, genState.Validate())
+	require.Panics(t, func() {
+		testApp.DeterministicGasKeeper.InitGenesis(ctx, genState)
+	})
+}
+
+func TestKeeper_GasRequiredByMessage(t *testing.T) {
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	contractAddress := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	unknownContractAddress := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	migratedContractAddress := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	config := deterministicgas.DefaultConfig()
+	gasKeeper := keeper.NewKeeper(
+		testApp.GetSubspace(types.ModuleName),
+		testApp.GetKey(types.StoreKey),
+		config,
+		wasmKeeperMock{contracts: map[string]uint64{contractAddress: 1, migratedContractAddress: 2}},
+		testApp.AssetFTKeeper,
+	)
+	gasKeeper.SetParams(ctx, types.Params{
+		WasmExecuteGas: []types.WasmExecuteGas{
+			{CodeID: 1, MsgVariant: "transfer", Gas: 100},
+			{CodeID: 1, ContractAddress: migratedContractAddress, MsgVariant: "transfer", Gas: 200},
+		},
+	})
+
+	requireT := require.New(t)
+	gasConsumed := ctx.GasMeter().GasConsumed()
+
+	gas, isDeterministic := gasKeeper.GasRequiredByMessage(ctx, &wasmtypes.MsgExecuteContract{
+		Contract: contractAddress,
+		Msg:      wasmtypes.RawContractMessage(`{"transfer":{}}`),
+	})
+	requireT.True(isDeterministic)
+	requireT.EqualValues(100, gas)
+
+	_, isDeterministic = gasKeeper.GasRequiredByMessage(ctx, &wasmtypes.MsgExecuteContract{
+		Contract: contractAddress,
+		Msg:      wasmtypes.RawContractMessage(`{"mint":{}}`),
+	})
+	requireT.False(isDeterministic)
+
+	// the entry recorded for the contract address is ignored since the contract has been migrated to another code
+	_, isDeterministic = gasKeeper.GasRequiredByMessage(ctx, &wasmtypes.MsgExecuteContract{
+		Contract: migratedContractAddress,
+		Msg:      wasmtypes.RawContractMessage(`{"transfer":{}}`),
+	})
+	requireT.False(isDeterministic)
+
+	_, isDeterministic = gasKeeper.GasRequiredByMessage(ctx, &wasmtypes.MsgExecuteContract{
+		Contract: unknownContractAddress,
+		Msg:      wasmtypes.RawContractMessage(`{"transfer":{}}`),
+	})
+	requireT.False(isDeterministic)
+
+	// messages not covered by the schedule fall back to the config
+	gas, isDeterministic = gasKeeper.GasRequiredByMessage(ctx, &banktypes.MsgSend{})
+	expectedGas, expectedIsDeterministic := config.GasRequiredByMessage(&banktypes.MsgSend{})
+	requireT.Equal(expectedIsDeterministic, isDeterministic)
+	requireT.Equal(expectedGas, gas)
+
+	// the lookup doesn't consume gas
+	requireT.Equal(gasConsumed, ctx.GasMeter().GasConsumed())
+}
+
+func TestKeeper_SetGasTable(t *testing.T) {
+	testApp := simapp.New()
+	gasKeeper := testApp.DeterministicGasKeeper
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	msgSendType := deterministicgas.MsgType(&banktypes.MsgSend{})
+	msgSend := &banktypes.MsgSend{
+		Amount: sdk.NewCoins(sdk.NewInt64Coin("ducore", 1), sdk.NewInt64Coin("uatom", 1)),
+	}
+
+	requireT := require.New(t)
+
+	// the table contains the defaults
+	defaultGasTable := deterministicgas.DefaultConfig().GasTable()
+	requireT.Equal(defaultGasTable, gasKeeper.GetGasTable(ctx))
+
+	gasTable := []types.MsgGas{{MsgType: msgSendType, Gas: 30000}}
+
+	err := gasKeeper.SetGasTable(ctx, []types.MsgGas{{MsgType: msgSendType}})
+	requireT.True(sdkerrors.ErrInvalidRequest.Is(err))
+
+	err = gasKeeper.SetGasTable(ctx, []types.MsgGas{
+		{MsgType: msgSendType, Gas: 30000},
+		{MsgType: deterministicgas.MsgType(&wasmtypes.MsgExecuteContract{}), Gas: 30000},
+	})
+	requireT.True(sdkerrors.ErrInvalidRequest.Is(err))
+
+	// nothing is stored if the update is rejected
+	requireT.Equal(defaultGasTable, gasKeeper.GetGasTable(ctx))
+
+	requireT.NoError(gasKeeper.SetGasTable(ctx, gasTable))
+
+	updatedGasTable := gasKeeper.GetGasTable(ctx)
+	requireT.Len(updatedGasTable, len(defaultGasTable))
+	requireT.Contains(updatedGasTable, gasTable[0])
+
+	gas, isDeterministic := gasKeeper.GasRequiredByMessage(ctx, msgSend)
+	requireT.True(isDeterministic)
+	requireT.EqualValues(2*30000, gas)
+
+	requireT.Equal(updatedGasTable, gasKeeper.ExportGenesis(ctx).GasTable)
+}
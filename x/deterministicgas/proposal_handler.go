@@ -0,0 +1,26 @@
+package deterministicgas
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// ProposalKeeper defines subscope of keeper methods required by the proposal handler.
+type ProposalKeeper interface {
+	SetGasTable(ctx sdk.Context, gasTable []types.MsgGas) error
+}
+
+// NewProposalHandler returns the handler of the deterministicgas governance proposals.
+func NewProposalHandler(keeper ProposalKeeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateGasTableProposal:
+			return keeper.SetGasTable(ctx, c.GasTable)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
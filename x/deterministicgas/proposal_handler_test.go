@@ -0,0 +1,39 @@
+package deterministicgas_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+func TestProposalHandler(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	handler := deterministicgas.NewProposalHandler(testApp.DeterministicGasKeeper)
+	msgSendType := deterministicgas.MsgType(&banktypes.MsgSend{})
+
+	err := handler(ctx, types.NewUpdateGasTableProposal("Update gas table", "-", []types.MsgGas{
+		{MsgType: deterministicgas.MsgType(&wasmtypes.MsgExecuteContract{}), Gas: 30000},
+	}))
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	err = handler(ctx, govtypes.NewTextProposal("Text", "-"))
+	requireT.ErrorIs(err, sdkerrors.ErrUnknownRequest)
+
+	requireT.NoError(handler(ctx, types.NewUpdateGasTableProposal("Update gas table", "-", []types.MsgGas{
+		{MsgType: msgSendType, Gas: 30000},
+	})))
+	requireT.Contains(testApp.DeterministicGasKeeper.GetGasTable(ctx), types.MsgGas{MsgType: msgSendType, Gas: 30000})
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterInterfaces registers the deterministicgas module interfaces.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdateGasTableProposal{},
+	)
+}
@@ -9,14 +9,27 @@ import (
 	"github.com/gogo/protobuf/grpc"
 	"github.com/gogo/protobuf/proto"
 	googlegrpc "google.golang.org/grpc"
-
-	"github.com/CoreumFoundation/coreum/x/deterministicgas"
 )
 
 const fuseGasMultiplier = 5
 
+// GasConfig defines the deterministic gas config methods required by the router.
+type GasConfig interface {
+	GasRequiredByMessage(msg sdk.Msg) (uint64, bool)
+}
+
+// GasTable provides the deterministic gas of the message types.
+type GasTable interface {
+	GasByMsgType(msgType string) (uint64, bool)
+}
+
+// GasKeeper defines the deterministic gas keeper methods required by the message server.
+type GasKeeper interface {
+	GasRequiredByMessage(ctx sdk.Context, msg sdk.Msg) (uint64, bool)
+}
+
 // NewDeterministicGasRouter returns wrapped router charging deterministic amount of gas for defined message types.
-func NewDeterministicGasRouter(baseRouter sdk.Router, deterministicGasConfig deterministicgas.Config) sdk.Router {
+func NewDeterministicGasRouter(baseRouter sdk.Router, deterministicGasConfig GasConfig) sdk.Router {
 	return &deterministicGasRouter{
 		baseRouter:             baseRouter,
 		deterministicGasConfig: deterministicGasConfig,
@@ -25,7 +38,7 @@ func NewDeterministicGasRouter(baseRouter sdk.Router, deterministicGasConfig det
 
 type deterministicGasRouter struct {
 	baseRouter             sdk.Router
-	deterministicGasConfig deterministicgas.Config
+	deterministicGasConfig GasConfig
 }
 
 func (r *deterministicGasRouter) AddRoute(route sdk.Route) sdk.Router {
@@ -39,22 +52,23 @@ func (r *deterministicGasRouter) Route(ctx sdk.Context, path string) sdk.Handler
 
 func (r *deterministicGasRouter) handler(baseHandler sdk.Handler) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
-		ctx, _, _ = ctxForDeterministicGas(ctx, msg, r.deterministicGasConfig)
+		gasRequired, isDeterministic := r.deterministicGasConfig.GasRequiredByMessage(msg)
+		ctx, _ = ctxForDeterministicGas(ctx, msg, gasRequired, isDeterministic)
 		return baseHandler(ctx, msg)
 	}
 }
 
 // NewDeterministicMsgServer returns wrapped message server charging deterministic amount of gas for defined message types.
-func NewDeterministicMsgServer(baseServer grpc.Server, deterministicGasConfig deterministicgas.Config) grpc.Server {
+func NewDeterministicMsgServer(baseServer grpc.Server, deterministicGasKeeper GasKeeper) grpc.Server {
 	return &deterministicMsgServer{
 		baseServer:             baseServer,
-		deterministicGasConfig: deterministicGasConfig,
+		deterministicGasKeeper: deterministicGasKeeper,
 	}
 }
 
 type deterministicMsgServer struct {
 	baseServer             grpc.Server
-	deterministicGasConfig deterministicgas.Config
+	deterministicGasKeeper GasKeeper
 }
 
 func (s *deterministicMsgServer) RegisterService(sd *googlegrpc.ServiceDesc, handler interface{}) {
@@ -85,14 +99,21 @@ func (s *deterministicMsgServer) RegisterService(sd *googlegrpc.ServiceDesc, han
 	//
 	// Then we extract cosmos context from `ctx` replace gas meter, pack it into `ctx` again and hall final handler.
 
-	for i, method := range sd.Methods {
+	// The service description is a global variable generated by protobuf, so it is copied to not affect other
+	// instances of the app (e.g. running in the same process in tests).
+	wrappedSD := *sd
+	wrappedSD.Methods = make([]googlegrpc.MethodDesc, len(sd.Methods))
+	copy(wrappedSD.Methods, sd.Methods)
+
+	for i, method := range wrappedSD.Methods {
 		method := method
-		sd.Methods[i].Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor googlegrpc.UnaryServerInterceptor) (interface{}, error) {
+		wrappedSD.Methods[i].Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor googlegrpc.UnaryServerInterceptor) (interface{}, error) {
 			return method.Handler(srv, ctx, dec, func(ctx context.Context, req interface{}, info *googlegrpc.UnaryServerInfo, handler googlegrpc.UnaryHandler) (resp interface{}, err error) {
 				return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
 					sdkCtx := sdk.UnwrapSDKContext(ctx)
 					msg := req.(sdk.Msg)
-					newSDKCtx, gasBefore, isDeterministic := ctxForDeterministicGas(sdkCtx, msg, s.deterministicGasConfig)
+					gasRequired, isDeterministic := s.deterministicGasKeeper.GasRequiredByMessage(sdkCtx, msg)
+					newSDKCtx, gasBefore := ctxForDeterministicGas(sdkCtx, msg, gasRequired, isDeterministic)
 					//nolint:contextcheck // Naming sdk functions (sdk.WrapSDKContext) is not our responsibility
 					res, err := handler(sdk.WrapSDKContext(newSDKCtx), req)
 					// gas metrics are reported only if message type is deterministic, and was successful
@@ -102,31 +123,37 @@ func (s *deterministicMsgServer) RegisterService(sd *googlegrpc.ServiceDesc, han
 						isDeterministic &&
 						!newSDKCtx.IsCheckTx() &&
 						!newSDKCtx.IsReCheckTx() {
-						reportDeterministicGasMetric(sdkCtx, newSDKCtx, gasBefore, proto.MessageName(msg))
+						reportDeterministicGasMetric(sdkCtx, newSDKCtx, gasBefore, msg)
 					}
 					return res, err
 				})
 			})
 		}
 	}
-	s.baseServer.RegisterService(sd, handler)
+	s.baseServer.RegisterService(&wrappedSD, handler)
 }
 
-func ctxForDeterministicGas(ctx sdk.Context, msg sdk.Msg, deterministicGasConfig deterministicgas.Config) (sdk.Context, sdk.Gas, bool) {
-	gasRequired, exists := deterministicGasConfig.GasRequiredByMessage(msg)
+func ctxForDeterministicGas(ctx sdk.Context, msg sdk.Msg, gasRequired uint64, isDeterministic bool) (sdk.Context, sdk.Gas) {
 	gasBefore := ctx.GasMeter().GasConsumed()
-	if exists {
+	if isDeterministic {
 		// Fixed gas is consumed on original gas meter to require and report deterministic gas amount
 		ctx.GasMeter().ConsumeGas(gasRequired, fmt.Sprintf("DeterministicGas (gas required: %d, message type: %T)", gasRequired, msg))
 
+		// The original gas meter is kept to charge the gas which is not covered by the deterministic gas.
+		// If the message is executed by another deterministic message (e.g. authz MsgExec), the gas meter of
+		// the transaction is already stored and it must not be replaced by the gas meter of the outer message.
+		if _, ok := ctx.Value(txGasMeterKey{}).(sdk.GasMeter); !ok {
+			ctx = ctx.WithValue(txGasMeterKey{}, ctx.GasMeter())
+		}
+
 		// We pass much higher amount of gas to handler to be sure that it succeeds.
 		// We want to avoid passing infinite gas meter to always have a limit in case of mistake.
 		ctx = ctx.WithGasMeter(sdk.NewGasMeter(fuseGasMultiplier * gasRequired))
 	}
-	return ctx, gasBefore, exists
+	return ctx, gasBefore
 }
 
-func reportDeterministicGasMetric(oldCtx, newCtx sdk.Context, gasBefore sdk.Gas, msgName string) {
+func reportDeterministicGasMetric(oldCtx, newCtx sdk.Context, gasBefore sdk.Gas, msg sdk.Msg) {
 	deterministicGas := oldCtx.GasMeter().GasConsumed() - gasBefore
 	if deterministicGas == 0 {
 		return
@@ -134,8 +161,40 @@ func reportDeterministicGasMetric(oldCtx, newCtx sdk.Context, gasBefore sdk.Gas,
 
 	nondeterministicGas := newCtx.GasMeter().GasConsumed()
 
+	labels := []metrics.Label{
+		{Name: "msg_name", Value: proto.MessageName(msg)},
+	}
 	gasFactor := float32(nondeterministicGas) / float32(deterministicGas)
-	metrics.AddSampleWithLabels([]string{"deterministic_gas_factor"}, gasFactor, []metrics.Label{
-		{Name: "msg_name", Value: msgName},
-	})
+	metrics.AddSampleWithLabels([]string{"deterministic_gas_factor"}, gasFactor, labels)
+	metrics.AddSampleWithLabels([]string{"deterministic_gas_real"}, float32(nondeterministicGas), labels)
+	metrics.AddSampleWithLabels([]string{"deterministic_gas_required"}, float32(deterministicGas), labels)
+
+	if recorder, ok := oldCtx.Value(gasRecorderKey{}).(GasRecorder); ok {
+		recorder.RecordGas(sdk.MsgTypeURL(msg), deterministicGas, nondeterministicGas)
+	}
+}
+
+type gasRecorderKey struct{}
+
+// GasRecorder records the gas consumed by the deterministic messages executed in DeliverTx.
+type GasRecorder interface {
+	RecordGas(msgType string, deterministicGas, realGas sdk.Gas)
+}
+
+// WithGasRecorder returns the context passing the deterministic and the real gas consumed by the successfully
+// executed deterministic messages to the recorder.
+func WithGasRecorder(ctx sdk.Context, recorder GasRecorder) sdk.Context {
+	return ctx.WithValue(gasRecorderKey{}, recorder)
+}
+
+type txGasMeterKey struct{}
+
+// TxGasMeter returns the gas meter of the transaction. The gas meter of the context passed to the deterministic
+// message handlers doesn't charge the transaction, so the gas which must be charged on top of the deterministic gas
+// (e.g. the gas of the external contract calls) must be consumed on the returned gas meter.
+func TxGasMeter(ctx sdk.Context) sdk.GasMeter {
+	if gasMeter, ok := ctx.Value(txGasMeterKey{}).(sdk.GasMeter); ok {
+		return gasMeter
+	}
+	return ctx.GasMeter()
 }
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestTxGasMeter_NestedDeterministicMessages(t *testing.T) {
+	requireT := require.New(t)
+
+	txGasMeter := sdk.NewGasMeter(1_000_000)
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()).WithGasMeter(txGasMeter)
+	requireT.Equal(txGasMeter, TxGasMeter(ctx))
+
+	// the gas meter of the transaction is passed to the deterministic message executed by another deterministic message
+	outerCtx, _ := ctxForDeterministicGas(ctx, &authz.MsgExec{}, 1000, true)
+	innerCtx, _ := ctxForDeterministicGas(outerCtx, &banktypes.MsgSend{}, 500, true)
+	requireT.Equal(txGasMeter, TxGasMeter(outerCtx))
+	requireT.Equal(txGasMeter, TxGasMeter(innerCtx))
+
+	TxGasMeter(innerCtx).ConsumeGas(300, "extension")
+	requireT.EqualValues(1000+300, txGasMeter.GasConsumed())
+	requireT.EqualValues(500, outerCtx.GasMeter().GasConsumed())
+	requireT.Zero(innerCtx.GasMeter().GasConsumed())
+}
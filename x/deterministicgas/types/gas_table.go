@@ -0,0 +1,25 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ValidateGasTable validates the entries of the gas table.
+func ValidateGasTable(gasTable []MsgGas) error {
+	msgTypes := make(map[string]struct{}, len(gasTable))
+	for _, entry := range gasTable {
+		if !strings.HasPrefix(entry.MsgType, "/") {
+			return errors.Errorf("invalid message type %q, type URL starting with / is expected", entry.MsgType)
+		}
+		if entry.Gas == 0 {
+			return errors.Errorf("gas of message type %q must be positive", entry.MsgType)
+		}
+		if _, exists := msgTypes[entry.MsgType]; exists {
+			return errors.Errorf("duplicated entry for message type %q", entry.MsgType)
+		}
+		msgTypes[entry.MsgType] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+type gasRecord struct {
+	msgType          string
+	deterministicGas sdk.Gas
+	realGas          sdk.Gas
+}
+
+type gasRecorderMock struct {
+	records []gasRecord
+}
+
+func (r *gasRecorderMock) RecordGas(msgType string, deterministicGas, realGas sdk.Gas) {
+	r.records = append(r.records, gasRecord{
+		msgType:          msgType,
+		deterministicGas: deterministicGas,
+		realGas:          realGas,
+	})
+}
+
+func TestWithGasRecorder(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BeginNextBlock()
+
+	sender, _ := testApp.GenAccount(ctx)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ucore", 100))
+	requireT.NoError(testApp.FundAccount(ctx, sender, coins))
+
+	recorder := &gasRecorderMock{}
+	ctx = types.WithGasRecorder(ctx, recorder).WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	msg := &banktypes.MsgSend{
+		FromAddress: sender.String(),
+		ToAddress:   sender.String(),
+		Amount:      coins,
+	}
+	handler := testApp.MsgServiceRouter().Handler(msg)
+	_, err := handler(ctx, msg)
+	requireT.NoError(err)
+
+	deterministicGas, ok := deterministicgas.DefaultConfig().GasRequiredByMessage(msg)
+	requireT.True(ok)
+	requireT.Len(recorder.records, 1)
+	requireT.Equal(sdk.MsgTypeURL(msg), recorder.records[0].msgType)
+	requireT.Equal(deterministicGas, recorder.records[0].deterministicGas)
+	requireT.Positive(recorder.records[0].realGas)
+
+	// failed messages are not recorded
+	msg.Amount = sdk.NewCoins(sdk.NewInt64Coin("ucore", 1000))
+	_, err = handler(ctx, msg)
+	requireT.Error(err)
+	requireT.Len(recorder.records, 1)
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/CoreumFoundation/coreum/pkg/store"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "deterministicgas"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key.
+	RouterKey = ModuleName
+)
+
+// GasTableKeyPrefix defines the key prefix for the deterministic gas of message types.
+var GasTableKeyPrefix = []byte{0x01}
+
+// CreateMsgGasKey creates the key of the deterministic gas of the message type.
+func CreateMsgGasKey(msgType string) []byte {
+	return store.JoinKeys(GasTableKeyPrefix, []byte(msgType))
+}
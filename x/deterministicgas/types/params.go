@@ -0,0 +1,121 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/pkg/errors"
+)
+
+// KeyWasmExecuteGas represents the WasmExecuteGas param key with which the schedule of gas for smart contracts will be stored.
+var KeyWasmExecuteGas = []byte("WasmExecuteGas")
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns params with default values.
+func DefaultParams() Params {
+	return Params{
+		WasmExecuteGas: []WasmExecuteGas{},
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// of deterministic gas parameters.
+func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyWasmExecuteGas, &m.WasmExecuteGas, validateWasmExecuteGas),
+	}
+}
+
+// ValidateBasic validates parameters.
+func (m Params) ValidateBasic() error {
+	return validateWasmExecuteGas(m.WasmExecuteGas)
+}
+
+// WasmExecuteGasRequired returns the deterministic gas required by the execute message variant of the contract
+// and true if it is defined in the schedule. The entry defined for the contract address takes precedence
+// over the one defined for the code ID. The entry defined for the contract address is applied only while the
+// contract runs the code it was recorded with, so it is ignored once the contract is migrated to another code.
+func (m Params) WasmExecuteGasRequired(codeID uint64, contractAddress, msgVariant string) (uint64, bool) {
+	var (
+		gas   uint64
+		found bool
+	)
+	for _, entry := range m.WasmExecuteGas {
+		if entry.MsgVariant != msgVariant {
+			continue
+		}
+		if entry.CodeID != codeID {
+			continue
+		}
+		if entry.ContractAddress == "" {
+			gas, found = entry.Gas, true
+			continue
+		}
+		if entry.ContractAddress == contractAddress {
+			return entry.Gas, true
+		}
+	}
+	return gas, found
+}
+
+// WasmMsgVariant returns the variant of the execute message, which is the only top-level key of its JSON object.
+func WasmMsgVariant(msg []byte) (string, error) {
+	var variants map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &variants); err != nil {
+		return "", errors.Wrap(err, "execute message must be a JSON object")
+	}
+	if len(variants) != 1 {
+		return "", errors.Errorf("execute message must have exactly one top-level key, got %d", len(variants))
+	}
+	for variant := range variants {
+		return variant, nil
+	}
+	return "", nil
+}
+
+func validateWasmExecuteGas(i interface{}) error {
+	schedule, ok := i.([]WasmExecuteGas)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	type entryKey struct {
+		codeID          uint64
+		contractAddress string
+		msgVariant      string
+	}
+	entries := make(map[entryKey]struct{}, len(schedule))
+	for _, entry := range schedule {
+		if entry.CodeID == 0 {
+			return errors.New("code ID must be set")
+		}
+		if entry.ContractAddress != "" {
+			if _, err := sdk.AccAddressFromBech32(entry.ContractAddress); err != nil {
+				return errors.Wrapf(err, "invalid contract address %q", entry.ContractAddress)
+			}
+		}
+		if entry.MsgVariant == "" {
+			return errors.New("msg variant must be set")
+		}
+		if entry.Gas == 0 {
+			return errors.New("gas must be positive")
+		}
+
+		key := entryKey{
+			codeID:          entry.CodeID,
+			contractAddress: entry.ContractAddress,
+			msgVariant:      entry.MsgVariant,
+		}
+		if _, exists := entries[key]; exists {
+			return errors.Errorf("duplicated entry for msg variant %q", entry.MsgVariant)
+		}
+		entries[key] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,641 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/deterministicgas/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WasmExecuteGas defines the deterministic gas charged for the execute message variant of the smart contract.
+type WasmExecuteGas struct {
+	// code_id is the code ID of the contracts the gas applies to. It must always be set.
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+	// contract_address is the address of the contract the gas applies to. It takes precedence over the entries
+	// without the address, and it is ignored once the contract is migrated to the code different from code_id.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// msg_variant is the variant of the execute message, which is the only top-level key of its JSON object.
+	MsgVariant string `protobuf:"bytes,3,opt,name=msg_variant,json=msgVariant,proto3" json:"msg_variant,omitempty" yaml:"msg_variant"`
+	// gas is the deterministic gas charged for the message.
+	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty" yaml:"gas"`
+}
+
+func (m *WasmExecuteGas) Reset()         { *m = WasmExecuteGas{} }
+func (m *WasmExecuteGas) String() string { return proto.CompactTextString(m) }
+func (*WasmExecuteGas) ProtoMessage()    {}
+func (*WasmExecuteGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0faecebb7e64b78, []int{0}
+}
+func (m *WasmExecuteGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WasmExecuteGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WasmExecuteGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WasmExecuteGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmExecuteGas.Merge(m, src)
+}
+func (m *WasmExecuteGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *WasmExecuteGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmExecuteGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmExecuteGas proto.InternalMessageInfo
+
+func (m *WasmExecuteGas) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
+	}
+	return 0
+}
+
+func (m *WasmExecuteGas) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *WasmExecuteGas) GetMsgVariant() string {
+	if m != nil {
+		return m.MsgVariant
+	}
+	return ""
+}
+
+func (m *WasmExecuteGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// Params store gov manageable parameters.
+type Params struct {
+	// wasm_execute_gas is the schedule of deterministic gas for execute messages of smart contracts.
+	WasmExecuteGas []WasmExecuteGas `protobuf:"bytes,1,rep,name=wasm_execute_gas,json=wasmExecuteGas,proto3" json:"wasm_execute_gas" yaml:"wasm_execute_gas"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0faecebb7e64b78, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetWasmExecuteGas() []WasmExecuteGas {
+	if m != nil {
+		return m.WasmExecuteGas
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*WasmExecuteGas)(nil), "coreum.deterministicgas.v1.WasmExecuteGas")
+	proto.RegisterType((*Params)(nil), "coreum.deterministicgas.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("coreum/deterministicgas/v1/params.proto", fileDescriptor_d0faecebb7e64b78)
+}
+
+var fileDescriptor_d0faecebb7e64b78 = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6a, 0xdb, 0x40,
+	0x10, 0xc6, 0xb5, 0xb5, 0x51, 0xe9, 0x1a, 0x54, 0x23, 0x4a, 0x2b, 0xdc, 0x22, 0x89, 0xbd, 0xd4,
+	0xf4, 0x20, 0xe1, 0x96, 0x52, 0x68, 0x4f, 0xb5, 0x5b, 0x07, 0xdf, 0x82, 0x20, 0x09, 0xe4, 0x22,
+	0xd6, 0xda, 0x65, 0x23, 0xc8, 0x6a, 0x8d, 0x76, 0xe5, 0x3f, 0xa7, 0xbc, 0x42, 0x1e, 0xcb, 0x47,
+	0x1f, 0x73, 0x12, 0x41, 0xbe, 0xe5, 0xa8, 0x27, 0x08, 0x92, 0x6c, 0x12, 0x3b, 0xe4, 0x36, 0x3b,
+	0xf3, 0xfd, 0x86, 0x99, 0x6f, 0x16, 0x7e, 0x8d, 0x44, 0x4a, 0x33, 0xee, 0x13, 0xaa, 0x68, 0xca,
+	0xe3, 0x24, 0x96, 0x2a, 0x8e, 0x18, 0x96, 0xfe, 0x7c, 0xe0, 0xcf, 0x70, 0x8a, 0xb9, 0xf4, 0x66,
+	0xa9, 0x50, 0xc2, 0xec, 0x35, 0x42, 0xef, 0x58, 0xe8, 0xcd, 0x07, 0xbd, 0x0f, 0x4c, 0x30, 0x51,
+	0xcb, 0xfc, 0x2a, 0x6a, 0x08, 0xf4, 0x00, 0xa0, 0x71, 0x81, 0x25, 0xff, 0xbf, 0xa4, 0x51, 0xa6,
+	0xe8, 0x09, 0x96, 0xe6, 0x4f, 0xf8, 0x36, 0x12, 0x84, 0x86, 0x31, 0xb1, 0x80, 0x0b, 0xfa, 0xed,
+	0xe1, 0x97, 0x22, 0x77, 0xf4, 0x91, 0x20, 0x74, 0xf2, 0xaf, 0xcc, 0x1d, 0x63, 0x85, 0xf9, 0xf5,
+	0x6f, 0xb4, 0x93, 0xa0, 0x40, 0xaf, 0xa2, 0x09, 0x31, 0xc7, 0xb0, 0x1b, 0x89, 0x44, 0xa5, 0x38,
+	0x52, 0x21, 0x26, 0x24, 0xa5, 0x52, 0x5a, 0x6f, 0x5c, 0xd0, 0x7f, 0x37, 0xfc, 0x5c, 0xe6, 0xce,
+	0xa7, 0x3d, 0x75, 0xa8, 0x40, 0xc1, 0xfb, 0x7d, 0xea, 0x6f, 0x93, 0x31, 0x7f, 0xc1, 0x0e, 0x97,
+	0x2c, 0x9c, 0xe3, 0x34, 0xc6, 0x89, 0xb2, 0x5a, 0x75, 0x8b, 0x8f, 0x65, 0xee, 0x98, 0x4d, 0x8b,
+	0x67, 0x45, 0x14, 0x40, 0x2e, 0xd9, 0x79, 0xf3, 0x30, 0x5d, 0xd8, 0x62, 0x58, 0x5a, 0xed, 0x7a,
+	0x66, 0xa3, 0xcc, 0x1d, 0xd8, 0x00, 0x0c, 0x4b, 0x14, 0x54, 0x25, 0x74, 0x03, 0xf5, 0xd3, 0xda,
+	0x2e, 0x33, 0x83, 0xdd, 0x05, 0x96, 0x3c, 0xa4, 0xcd, 0xda, 0x61, 0x05, 0x02, 0xb7, 0xd5, 0xef,
+	0x7c, 0xff, 0xe6, 0xbd, 0xee, 0xa1, 0x77, 0xe8, 0xd4, 0xd0, 0x59, 0xe7, 0x8e, 0xf6, 0xb4, 0xdc,
+	0x71, 0x47, 0x14, 0x18, 0x8b, 0x43, 0xe0, 0x6c, 0x5d, 0xd8, 0x60, 0x53, 0xd8, 0xe0, 0xbe, 0xb0,
+	0xc1, 0xed, 0xd6, 0xd6, 0x36, 0x5b, 0x5b, 0xbb, 0xdb, 0xda, 0xda, 0xe5, 0x1f, 0x16, 0xab, 0xab,
+	0x6c, 0xea, 0x45, 0x82, 0xfb, 0xa3, 0x7a, 0x80, 0xb1, 0xc8, 0x12, 0x82, 0x55, 0x2c, 0x12, 0x7f,
+	0x77, 0xfe, 0xe5, 0xcb, 0x0f, 0xa0, 0x56, 0x33, 0x2a, 0xa7, 0x7a, 0x7d, 0xcb, 0x1f, 0x8f, 0x03,
+	0x00, 0xd5, 0xa3, 0xb3, 0x59, 0x28, 0x02, 0x00, 0x00,
+}
+
+func (m *WasmExecuteGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmExecuteGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmExecuteGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MsgVariant) > 0 {
+		i -= len(m.MsgVariant)
+		copy(dAtA[i:], m.MsgVariant)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgVariant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WasmExecuteGas) > 0 {
+		for iNdEx := len(m.WasmExecuteGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WasmExecuteGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WasmExecuteGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovParams(uint64(m.CodeID))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.MsgVariant)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovParams(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WasmExecuteGas) > 0 {
+		for _, e := range m.WasmExecuteGas {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WasmExecuteGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmExecuteGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmExecuteGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgVariant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgVariant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmExecuteGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WasmExecuteGas = append(m.WasmExecuteGas, WasmExecuteGas{})
+			if err := m.WasmExecuteGas[len(m.WasmExecuteGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
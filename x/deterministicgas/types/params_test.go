@@ -0,0 +1,124 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestParams_ValidateBasic(t *testing.T) {
+	contractAddress := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	require.NoError(t, DefaultParams().ValidateBasic())
+
+	testCases := []struct {
+		name     string
+		schedule []WasmExecuteGas
+		valid    bool
+	}{
+		{
+			name: "valid",
+			schedule: []WasmExecuteGas{
+				{CodeID: 1, MsgVariant: "transfer", Gas: 100},
+				{CodeID: 1, MsgVariant: "mint", Gas: 100},
+				{CodeID: 1, ContractAddress: contractAddress, MsgVariant: "transfer", Gas: 200},
+			},
+			valid: true,
+		},
+		{
+			name:     "no_code_id",
+			schedule: []WasmExecuteGas{{MsgVariant: "transfer", Gas: 100}},
+		},
+		{
+			name:     "address_without_code_id",
+			schedule: []WasmExecuteGas{{ContractAddress: contractAddress, MsgVariant: "transfer", Gas: 100}},
+		},
+		{
+			name:     "invalid_address",
+			schedule: []WasmExecuteGas{{CodeID: 1, ContractAddress: "invalid", MsgVariant: "transfer", Gas: 100}},
+		},
+		{
+			name:     "empty_msg_variant",
+			schedule: []WasmExecuteGas{{CodeID: 1, Gas: 100}},
+		},
+		{
+			name:     "zero_gas",
+			schedule: []WasmExecuteGas{{CodeID: 1, MsgVariant: "transfer"}},
+		},
+		{
+			name: "duplicated_entry",
+			schedule: []WasmExecuteGas{
+				{CodeID: 1, MsgVariant: "transfer", Gas: 100},
+				{CodeID: 1, MsgVariant: "transfer", Gas: 200},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := Params{WasmExecuteGas: tc.schedule}.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_WasmExecuteGasRequired(t *testing.T) {
+	contractAddress := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	otherContractAddress := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	params := Params{
+		WasmExecuteGas: []WasmExecuteGas{
+			{CodeID: 1, ContractAddress: contractAddress, MsgVariant: "transfer", Gas: 200},
+			{CodeID: 1, MsgVariant: "transfer", Gas: 100},
+			{CodeID: 2, MsgVariant: "mint", Gas: 300},
+		},
+	}
+
+	requireT := require.New(t)
+
+	gas, found := params.WasmExecuteGasRequired(1, contractAddress, "transfer")
+	requireT.True(found)
+	requireT.EqualValues(200, gas)
+
+	gas, found = params.WasmExecuteGasRequired(1, otherContractAddress, "transfer")
+	requireT.True(found)
+	requireT.EqualValues(100, gas)
+
+	_, found = params.WasmExecuteGasRequired(1, otherContractAddress, "mint")
+	requireT.False(found)
+
+	_, found = params.WasmExecuteGasRequired(2, otherContractAddress, "transfer")
+	requireT.False(found)
+
+	// the contract has been migrated to another code, so the entry recorded for its address is ignored
+	_, found = params.WasmExecuteGasRequired(2, contractAddress, "transfer")
+	requireT.False(found)
+
+	gas, found = params.WasmExecuteGasRequired(2, contractAddress, "mint")
+	requireT.True(found)
+	requireT.EqualValues(300, gas)
+}
+
+func TestWasmMsgVariant(t *testing.T) {
+	requireT := require.New(t)
+
+	variant, err := WasmMsgVariant([]byte(`{"transfer":{"recipient":"addr","amount":"10"}}`))
+	requireT.NoError(err)
+	requireT.Equal("transfer", variant)
+
+	_, err = WasmMsgVariant([]byte(`{"transfer":{},"mint":{}}`))
+	requireT.Error(err)
+
+	_, err = WasmMsgVariant([]byte(`{}`))
+	requireT.Error(err)
+
+	_, err = WasmMsgVariant([]byte(`"transfer"`))
+	requireT.Error(err)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ProposalTypeUpdateGasTable defines the type of the proposal updating the deterministic gas of the message types.
+const ProposalTypeUpdateGasTable = "UpdateGasTable"
+
+var _ govtypes.Content = &UpdateGasTableProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateGasTable)
+}
+
+// NewUpdateGasTableProposal returns a new instance of the UpdateGasTableProposal.
+func NewUpdateGasTableProposal(title, description string, gasTable []MsgGas) *UpdateGasTableProposal {
+	return &UpdateGasTableProposal{
+		Title:       title,
+		Description: description,
+		GasTable:    gasTable,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p *UpdateGasTableProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *UpdateGasTableProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (p *UpdateGasTableProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (p *UpdateGasTableProposal) ProposalType() string { return ProposalTypeUpdateGasTable }
+
+// ValidateBasic validates the proposal.
+func (p *UpdateGasTableProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.GasTable) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "gas table must not be empty")
+	}
+
+	if err := ValidateGasTable(p.GasTable); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// String returns the human-readable representation of the proposal.
+func (p UpdateGasTableProposal) String() string {
+	return fmt.Sprintf(`Update Gas Table Proposal:
+  Title:       %s
+  Description: %s
+  Gas Table:   %v
+`, p.Title, p.Description, p.GasTable)
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateGasTableProposal_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		proposal      *UpdateGasTableProposal
+		expectedError error
+	}{
+		{
+			name: "valid proposal",
+			proposal: NewUpdateGasTableProposal("Update gas table", "-", []MsgGas{
+				{MsgType: "/coreum.asset.ft.v1.MsgMint", Gas: 11000},
+			}),
+		},
+		{
+			name: "empty title",
+			proposal: NewUpdateGasTableProposal("", "-", []MsgGas{
+				{MsgType: "/coreum.asset.ft.v1.MsgMint", Gas: 11000},
+			}),
+			expectedError: govtypes.ErrInvalidProposalContent,
+		},
+		{
+			name:          "empty gas table",
+			proposal:      NewUpdateGasTableProposal("Update gas table", "-", nil),
+			expectedError: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "zero gas",
+			proposal: NewUpdateGasTableProposal("Update gas table", "-", []MsgGas{
+				{MsgType: "/coreum.asset.ft.v1.MsgMint"},
+			}),
+			expectedError: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
@@ -1,15 +1,32 @@
 package ante
 
 import (
+	"math"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/ibc-go/v4/testing/simapp/helpers"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
-// Keeper interface exposes methods required by ante handler decorator of fee model.
+// PriorityPrecision is the multiplier applied to the ratio of the tip to the base fee to compute the priority of the transaction.
+const PriorityPrecision = 1_000_000
+
+// Keeper interface exposes methods required by ante handler decorators of fee model.
 type Keeper interface {
 	TrackGas(ctx sdk.Context, gas int64)
+	GetParams(ctx sdk.Context) types.Params
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
+	GetMinGasPrices(ctx sdk.Context) sdk.DecCoins
+	ValidateAcceptedFeeDenom(ctx sdk.Context, denom string) error
+}
+
+// BankKeeper interface exposes methods required by ante handler decorators of fee model.
+type BankKeeper interface {
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
 // FeeDecorator will check if the gas price offered by transaction's fee is at least as large
@@ -53,14 +70,27 @@ func (fd FeeDecorator) actOnFeeModelOutput(ctx sdk.Context, feeTx sdk.FeeTx) err
 		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, "no fee declared for transaction")
 	}
 
-	minGasPrice := fd.keeper.GetMinGasPrice(ctx)
-	if len(fees) > 1 || fees[0].Denom != minGasPrice.Denom {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee must be paid in '%s' coin only", minGasPrice.Denom)
+	minGasPrices := fd.keeper.GetMinGasPrices(ctx)
+	if len(fees) > 1 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee must be paid in a single coin, one of '%s'", denoms(minGasPrices))
+	}
+
+	feeDenom := fees[0].Denom
+	minGasPrice := minGasPrices.AmountOf(feeDenom)
+	if !minGasPrice.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee must be paid in one of '%s' coins only", denoms(minGasPrices))
+	}
+	// the accepted fee denoms are validated by the param change proposal handler, but the genesis can't be validated
+	// against the state, so the fee is never taken in the denom which can't be sent to the fee collector as is
+	if feeDenom != fd.keeper.GetMinGasPrice(ctx).Denom {
+		if err := fd.keeper.ValidateAcceptedFeeDenom(ctx, feeDenom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee can't be paid in %s: %s", feeDenom, err)
+		}
 	}
 
 	gasDeclared := sdk.NewDecFromInt(sdk.NewIntFromUint64(feeTx.GetGas()))
-	feeOffered := sdk.NewDecCoin(minGasPrice.Denom, fees.AmountOf(minGasPrice.Denom))
-	feeRequired := sdk.NewDecCoinFromDec(minGasPrice.Denom, gasDeclared.Mul(minGasPrice.Amount))
+	feeOffered := sdk.NewDecCoin(feeDenom, fees[0].Amount)
+	feeRequired := sdk.NewDecCoinFromDec(feeDenom, gasDeclared.Mul(minGasPrice))
 
 	if feeOffered.IsLT(feeRequired) {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeOffered, feeRequired)
@@ -71,3 +101,93 @@ func (fd FeeDecorator) actOnFeeModelOutput(ctx sdk.Context, feeTx sdk.FeeTx) err
 func (fd FeeDecorator) collectFeeModelInput(ctx sdk.Context, feeTx sdk.FeeTx) {
 	fd.keeper.TrackGas(ctx, int64(feeTx.GetGas()))
 }
+
+// TipDecorator splits the fee offered by the transaction into the base fee required by the fee model and the tip,
+// burns the configured fraction of the tip and computes the priority of the transaction.
+// CONTRACT: Must be placed after the decorator deducting the fee, which must be placed after FeeDecorator.
+type TipDecorator struct {
+	keeper     Keeper
+	bankKeeper BankKeeper
+}
+
+// NewTipDecorator creates ante decorator handling the tip offered by the transaction above the required fee.
+func NewTipDecorator(keeper Keeper, bankKeeper BankKeeper) TipDecorator {
+	return TipDecorator{
+		keeper:     keeper,
+		bankKeeper: bankKeeper,
+	}
+}
+
+// AnteHandle handles transaction in ante decorator.
+func (td TipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeight() == 0 || simulate || ctx.ChainID() == helpers.SimAppChainID {
+		// Fee model is not enforced on genesis block and during simulation
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+
+	// FeeDecorator guarantees that the fee is paid in a single accepted coin
+	fee := feeTx.GetFee()[0]
+	minGasPrice := td.keeper.GetMinGasPrices(ctx).AmountOf(fee.Denom)
+	baseFee, tip := SplitFee(fee, feeTx.GetGas(), minGasPrice)
+
+	burntTip := sdk.NewCoin(fee.Denom, sdk.ZeroInt())
+	if fee.Denom == td.keeper.GetMinGasPrice(ctx).Denom {
+		burntTip.Amount = td.keeper.GetParams(ctx).TipBurnFraction.MulInt(tip.Amount).TruncateInt()
+	}
+	if burntTip.IsPositive() {
+		if err := td.bankKeeper.BurnCoins(ctx, authtypes.FeeCollectorName, sdk.NewCoins(burntTip)); err != nil {
+			return ctx, sdkerrors.Wrapf(err, "can't burn the tip")
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFee{
+		BaseFee:  baseFee,
+		Tip:      tip,
+		BurntTip: burntTip,
+		Priority: Priority(baseFee, tip),
+	}); err != nil {
+		return ctx, sdkerrors.Wrap(err, "can't emit EventFee event")
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// SplitFee splits the fee into the base fee required by the min gas price and the tip offered above it.
+func SplitFee(fee sdk.Coin, gas uint64, minGasPrice sdk.Dec) (sdk.Coin, sdk.Coin) {
+	baseFeeAmount := minGasPrice.MulInt(sdk.NewIntFromUint64(gas)).Ceil().TruncateInt()
+	if baseFeeAmount.GT(fee.Amount) {
+		baseFeeAmount = fee.Amount
+	}
+	return sdk.NewCoin(fee.Denom, baseFeeAmount), sdk.NewCoin(fee.Denom, fee.Amount.Sub(baseFeeAmount))
+}
+
+// Priority computes the priority of the transaction as the ratio of the tip to the base fee multiplied by PriorityPrecision.
+// As the base fee is proportional to the min gas price, it orders the transactions by the effective gas price over the min one,
+// no matter which accepted denom the fee is paid in.
+func Priority(baseFee, tip sdk.Coin) int64 {
+	if !tip.IsPositive() {
+		return 0
+	}
+	if !baseFee.IsPositive() {
+		return math.MaxInt64
+	}
+
+	priority := tip.Amount.ToDec().MulInt64(PriorityPrecision).QuoInt(baseFee.Amount).TruncateInt()
+	if !priority.IsInt64() {
+		return math.MaxInt64
+	}
+	return priority.Int64()
+}
+
+func denoms(coins sdk.DecCoins) string {
+	denoms := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		denoms = append(denoms, coin.Denom)
+	}
+	return strings.Join(denoms, ",")
+}
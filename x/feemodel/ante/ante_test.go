@@ -0,0 +1,40 @@
+package ante_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/ante"
+)
+
+func TestSplitFee(t *testing.T) {
+	baseFee, tip := ante.SplitFee(sdk.NewInt64Coin("coin", 1000), 100, sdk.MustNewDecFromStr("2.5"))
+	assert.Equal(t, sdk.NewInt64Coin("coin", 250), baseFee)
+	assert.Equal(t, sdk.NewInt64Coin("coin", 750), tip)
+
+	// base fee is rounded up
+	baseFee, tip = ante.SplitFee(sdk.NewInt64Coin("coin", 1000), 3, sdk.MustNewDecFromStr("0.5"))
+	assert.Equal(t, sdk.NewInt64Coin("coin", 2), baseFee)
+	assert.Equal(t, sdk.NewInt64Coin("coin", 998), tip)
+
+	// there is no tip if the fee is equal to the required one
+	baseFee, tip = ante.SplitFee(sdk.NewInt64Coin("coin", 250), 100, sdk.MustNewDecFromStr("2.5"))
+	assert.Equal(t, sdk.NewInt64Coin("coin", 250), baseFee)
+	assert.Equal(t, sdk.NewInt64Coin("coin", 0).String(), tip.String())
+}
+
+func TestPriority(t *testing.T) {
+	assert.EqualValues(t, 0, ante.Priority(sdk.NewInt64Coin("coin", 100), sdk.NewInt64Coin("coin", 0)))
+	assert.EqualValues(t, ante.PriorityPrecision, ante.Priority(sdk.NewInt64Coin("coin", 100), sdk.NewInt64Coin("coin", 100)))
+	assert.EqualValues(t, ante.PriorityPrecision/4, ante.Priority(sdk.NewInt64Coin("coin", 100), sdk.NewInt64Coin("coin", 25)))
+	assert.EqualValues(t, math.MaxInt64, ante.Priority(sdk.NewInt64Coin("coin", 0), sdk.NewInt64Coin("coin", 25)))
+
+	// priority does not depend on the denom, only on the ratio of the tip to the base fee
+	assert.Equal(t,
+		ante.Priority(sdk.NewInt64Coin("coin", 100), sdk.NewInt64Coin("coin", 50)),
+		ante.Priority(sdk.NewInt64Coin("feecoin", 200), sdk.NewInt64Coin("feecoin", 100)),
+	)
+}
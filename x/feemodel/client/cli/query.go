@@ -1,13 +1,23 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
+// FlagBlockGas is the flag defining the gas assumed to be used by each block.
+const FlagBlockGas = "block-gas"
+
 // GetQueryCmd returns the parent command for all x/feemodel CLI query commands. The
 // provided clientCtx should have, at a minimum, a verifier, Tendermint RPC client,
 // and marshaler set.
@@ -22,6 +32,9 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetMinGasPriceCmd(),
+		GetRecentGasPricesCmd(),
+		GetForecastMinGasPriceCmd(),
+		GetSimulateParamsCmd(),
 	)
 
 	return cmd
@@ -52,6 +65,145 @@ func GetMinGasPriceCmd() *cobra.Command {
 	return cmd
 }
 
+// GetRecentGasPricesCmd returns command for getting gas price records of the recent blocks.
+func GetRecentGasPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recent-gas-prices",
+		Short: "Query for gas price records of the recent blocks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RecentGasPrices(cmd.Context(), &types.QueryRecentGasPricesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "recent gas prices")
+
+	return cmd
+}
+
+// GetForecastMinGasPriceCmd returns command for getting the highest minimum gas price required by the network within the given number of blocks.
+func GetForecastMinGasPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forecast-min-gas-price [after-blocks]",
+		Short: "Query for the highest minimum gas price required by the network within the given number of blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the highest minimum gas price required by the network within the given number of blocks, assuming each of them uses the provided gas.
+
+Example:
+$ %[1]s query %[2]s forecast-min-gas-price 5 --%[3]s=10000000
+`,
+				version.AppName, types.ModuleName, FlagBlockGas,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			afterBlocks, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return errors.Wrap(err, "invalid after-blocks")
+			}
+			blockGas, err := cmd.Flags().GetInt64(FlagBlockGas)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ForecastMinGasPrice(cmd.Context(), &types.QueryForecastMinGasPriceRequest{
+				AfterBlocks: uint32(afterBlocks),
+				BlockGas:    blockGas,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.MinGasPrice)
+		},
+	}
+	cmd.Flags().Int64(FlagBlockGas, 0, "Gas assumed to be used by each of the blocks, max block gas is used if not set")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSimulateParamsCmd returns command for getting the gas prices the proposed model params would have produced.
+func GetSimulateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-params [model-params-file]",
+		Short: "Query for the gas prices the proposed model params would have produced for the stored history",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the gas prices the proposed model params would have produced for the stored gas price history.
+
+Example:
+$ %[1]s query %[2]s simulate-params model.json
+
+Where model.json contains:
+{
+  "initial_gas_price": "0.0625",
+  "max_gas_price_multiplier": "1000.0",
+  "max_discount": "0.5",
+  "escalation_start_fraction": "0.8",
+  "max_block_gas": 50000000,
+  "short_ema_block_length": 50,
+  "long_ema_block_length": 1000
+}
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			modelParamsJSON, err := os.ReadFile(args[0])
+			if err != nil {
+				return errors.Wrap(err, "failed to read model params file")
+			}
+			var modelParams types.ModelParams
+			if err := clientCtx.Codec.UnmarshalJSON(modelParamsJSON, &modelParams); err != nil {
+				return errors.Wrap(err, "failed to parse model params")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateParams(cmd.Context(), &types.QuerySimulateParamsRequest{
+				Model: modelParams,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryGasPrice queries the gas price.
 func QueryGasPrice(cmd *cobra.Command) (*types.QueryMinGasPriceResponse, error) {
 	clientCtx, err := client.GetClientQueryContext(cmd)
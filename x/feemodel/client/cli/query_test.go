@@ -2,6 +2,8 @@ package cli_test
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/testutil/network"
 	"github.com/CoreumFoundation/coreum/x/feemodel/client/cli"
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
 func TestMinGasPrice(t *testing.T) {
@@ -27,3 +30,70 @@ func TestMinGasPrice(t *testing.T) {
 	assert.Equal(t, testNetwork.Config.BondDenom, resp.Denom)
 	assert.True(t, resp.Amount.GT(sdk.ZeroDec()))
 }
+
+func TestRecentGasPrices(t *testing.T) {
+	testNetwork := network.New(t)
+	require.NoError(t, testNetwork.WaitForNextBlock())
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	cmd := cli.GetQueryCmd()
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cmd, []string{"recent-gas-prices", "--limit", "1", "--reverse", "--output", "json"})
+	require.NoError(t, err)
+
+	var resp types.QueryRecentGasPricesResponse
+	require.NoError(t, ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+
+	require.Len(t, resp.Records, 1)
+	assert.Positive(t, resp.Records[0].Height)
+	assert.Equal(t, testNetwork.Config.BondDenom, resp.Records[0].MinGasPrice.Denom)
+	assert.True(t, resp.Records[0].MinGasPrice.Amount.GT(sdk.ZeroDec()))
+}
+
+func TestForecastMinGasPrice(t *testing.T) {
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	cmd := cli.GetQueryCmd()
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cmd, []string{"min-gas-price", "--output", "json"})
+	require.NoError(t, err)
+
+	var minGasPrice sdk.DecCoin
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &minGasPrice))
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cmd, []string{"forecast-min-gas-price", "10", "--output", "json"})
+	require.NoError(t, err)
+
+	var forecastMinGasPrice sdk.DecCoin
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &forecastMinGasPrice))
+
+	assert.Equal(t, testNetwork.Config.BondDenom, forecastMinGasPrice.Denom)
+	assert.True(t, forecastMinGasPrice.Amount.GTE(minGasPrice.Amount))
+}
+
+func TestSimulateParams(t *testing.T) {
+	testNetwork := network.New(t)
+	require.NoError(t, testNetwork.WaitForNextBlock())
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	modelParams := types.DefaultModel().Params()
+	modelParams.ShortEmaBlockLength = 1
+	modelParamsFile := filepath.Join(t.TempDir(), "model.json")
+	require.NoError(t, os.WriteFile(modelParamsFile, ctx.Codec.MustMarshalJSON(&modelParams), 0o600))
+
+	cmd := cli.GetQueryCmd()
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cmd, []string{"simulate-params", modelParamsFile, "--output", "json"})
+	require.NoError(t, err)
+
+	var resp types.QuerySimulateParamsResponse
+	require.NoError(t, ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+
+	require.NotEmpty(t, resp.Records)
+	assert.Equal(t, testNetwork.Config.BondDenom, resp.Records[0].MinGasPrice.Denom)
+	assert.True(t, resp.Records[0].MinGasPrice.Amount.GT(sdk.ZeroDec()))
+
+	// invalid params are rejected
+	modelParams.MaxDiscount = sdk.OneDec()
+	require.NoError(t, os.WriteFile(modelParamsFile, ctx.Codec.MustMarshalJSON(&modelParams), 0o600))
+	_, err = clitestutil.ExecTestCLICmd(ctx, cmd, []string{"simulate-params", modelParamsFile, "--output", "json"})
+	require.Error(t, err)
+}
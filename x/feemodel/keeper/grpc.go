@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -14,6 +15,10 @@ import (
 type QueryKeeper interface {
 	GetParams(ctx sdk.Context) types.Params
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
+	GetMinGasPrices(ctx sdk.Context) sdk.DecCoins
+	GetRecentGasPrices(ctx sdk.Context, pagination *query.PageRequest) ([]types.GasPriceRecord, *query.PageResponse, error)
+	ForecastMinGasPrice(ctx sdk.Context, afterBlocks uint32, blockGas int64) sdk.DecCoin
+	SimulateGasPrices(ctx sdk.Context, modelParams types.ModelParams) []types.GasPriceRecord
 }
 
 // NewQueryService creates query service.
@@ -34,8 +39,10 @@ func (qs QueryService) MinGasPrice(ctx context.Context, req *types.QueryMinGasPr
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	return &types.QueryMinGasPriceResponse{
-		MinGasPrice: qs.keeper.GetMinGasPrice(sdk.UnwrapSDKContext(ctx)),
+		MinGasPrice:  qs.keeper.GetMinGasPrice(sdkCtx),
+		MinGasPrices: qs.keeper.GetMinGasPrices(sdkCtx),
 	}, nil
 }
 
@@ -49,3 +56,57 @@ func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest
 		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(ctx)),
 	}, nil
 }
+
+// RecentGasPrices returns the gas price records of the recent blocks.
+func (qs QueryService) RecentGasPrices(ctx context.Context, req *types.QueryRecentGasPricesRequest) (*types.QueryRecentGasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	records, pageRes, err := qs.keeper.GetRecentGasPrices(sdk.UnwrapSDKContext(ctx), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryRecentGasPricesResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ForecastMinGasPrice returns the highest minimum gas price required by the network within the given number of blocks.
+func (qs QueryService) ForecastMinGasPrice(ctx context.Context, req *types.QueryForecastMinGasPriceRequest) (*types.QueryForecastMinGasPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.AfterBlocks > types.MaxForecastBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "after blocks must not be greater than %d", types.MaxForecastBlocks)
+	}
+	if req.BlockGas < 0 {
+		return nil, status.Error(codes.InvalidArgument, "block gas must not be negative")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockGas := req.BlockGas
+	if blockGas == 0 {
+		blockGas = qs.keeper.GetParams(sdkCtx).Model.MaxBlockGas
+	}
+
+	return &types.QueryForecastMinGasPriceResponse{
+		MinGasPrice: qs.keeper.ForecastMinGasPrice(sdkCtx, req.AfterBlocks, blockGas),
+	}, nil
+}
+
+// SimulateParams returns the gas price records which would have been produced by the proposed model params.
+func (qs QueryService) SimulateParams(ctx context.Context, req *types.QuerySimulateParamsRequest) (*types.QuerySimulateParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := req.Model.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySimulateParamsResponse{
+		Records: qs.keeper.SimulateGasPrices(sdk.UnwrapSDKContext(ctx), req.Model),
+	}, nil
+}
@@ -1,21 +1,32 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/pkg/errors"
 
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	"github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
 // ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters.
 type ParamSubspace interface {
 	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+	GetParamSetIfExists(ctx sdk.Context, ps paramtypes.ParamSet)
 	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
 }
 
+// AssetFTKeeper represents the methods of the asset ft keeper required by the fee model.
+type AssetFTKeeper interface {
+	GetDefinition(ctx sdk.Context, denom string) (assetfttypes.Definition, error)
+}
+
 // Keeper is a fee model keeper.
 type Keeper struct {
 	paramSubspace     ParamSubspace
+	assetFTKeeper     AssetFTKeeper
 	storeKey          sdk.StoreKey
 	transientStoreKey sdk.StoreKey
 }
@@ -23,11 +34,13 @@ type Keeper struct {
 // NewKeeper returns a new keeper object providing storage options required by fee model.
 func NewKeeper(
 	paramSubspace ParamSubspace,
+	assetFTKeeper AssetFTKeeper,
 	storeKey sdk.StoreKey,
 	transientStoreKey sdk.StoreKey,
 ) Keeper {
 	return Keeper{
 		paramSubspace:     paramSubspace,
+		assetFTKeeper:     assetFTKeeper,
 		storeKey:          storeKey,
 		transientStoreKey: transientStoreKey,
 	}
@@ -35,10 +48,29 @@ func NewKeeper(
 
 // TrackedGas returns gas limits declared by transactions executed so far in current block.
 func (k Keeper) TrackedGas(ctx sdk.Context) int64 {
+	return k.trackedGas(ctx, gasTrackingKey)
+}
+
+// TrackGas increments gas tracked for current block.
+func (k Keeper) TrackGas(ctx sdk.Context, gas int64) {
+	k.trackGas(ctx, gasTrackingKey, gas)
+}
+
+// TrackedGasUsed returns gas used by transactions executed so far in current block.
+func (k Keeper) TrackedGasUsed(ctx sdk.Context) int64 {
+	return k.trackedGas(ctx, gasUsedTrackingKey)
+}
+
+// TrackGasUsed increments gas used tracked for current block.
+func (k Keeper) TrackGasUsed(ctx sdk.Context, gas int64) {
+	k.trackGas(ctx, gasUsedTrackingKey, gas)
+}
+
+func (k Keeper) trackedGas(ctx sdk.Context, key []byte) int64 {
 	tStore := ctx.TransientStore(k.transientStoreKey)
 
 	gasUsed := sdk.NewInt(0)
-	bz := tStore.Get(gasTrackingKey)
+	bz := tStore.Get(key)
 
 	if bz != nil {
 		if err := gasUsed.Unmarshal(bz); err != nil {
@@ -49,14 +81,13 @@ func (k Keeper) TrackedGas(ctx sdk.Context) int64 {
 	return gasUsed.Int64()
 }
 
-// TrackGas increments gas tracked for current block.
-func (k Keeper) TrackGas(ctx sdk.Context, gas int64) {
+func (k Keeper) trackGas(ctx sdk.Context, key []byte, gas int64) {
 	tStore := ctx.TransientStore(k.transientStoreKey)
-	bz, err := sdk.NewInt(k.TrackedGas(ctx) + gas).Marshal()
+	bz, err := sdk.NewInt(k.trackedGas(ctx, key) + gas).Marshal()
 	if err != nil {
 		panic(err)
 	}
-	tStore.Set(gasTrackingKey, bz)
+	tStore.Set(key, bz)
 }
 
 // SetParams sets the parameters of the model.
@@ -71,6 +102,35 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return params
 }
 
+// GetParamsIfExists gets the parameters of the model stored so far, the ones which are not stored keep the values
+// passed in.
+func (k Keeper) GetParamsIfExists(ctx sdk.Context, params *types.Params) {
+	k.paramSubspace.GetParamSetIfExists(ctx, params)
+}
+
+// ValidateAcceptedFeeDenom checks that the fee might be paid in the denom accepted next to the native one.
+// The fees paid in the accepted denoms are distributed in the same way as the native ones, so the denom must not be
+// the native one and the transfers of the denom to the fee collector must not be rejected or charged.
+func (k Keeper) ValidateAcceptedFeeDenom(ctx sdk.Context, denom string) error {
+	if denom == k.GetMinGasPrice(ctx).Denom {
+		return errors.Errorf("native denom %q can't be the accepted fee denom", denom)
+	}
+
+	def, err := k.assetFTKeeper.GetDefinition(ctx, denom)
+	if err != nil {
+		// the denoms which are not issued by the asset ft module are not restricted
+		if assetfttypes.ErrInvalidDenom.Is(err) || assetfttypes.ErrTokenNotFound.Is(err) {
+			return nil
+		}
+		return err
+	}
+	if def.IsTransferRestricted() {
+		return errors.Errorf("transfers of the accepted fee denom %q are restricted by its features or rates", denom)
+	}
+
+	return nil
+}
+
 // GetShortEMAGas retrieves average gas used by previous blocks, used as a representation of smoothed gas used by latest block.
 func (k Keeper) GetShortEMAGas(ctx sdk.Context) int64 {
 	store := ctx.KVStore(k.storeKey)
@@ -142,6 +202,12 @@ func (k Keeper) GetMinGasPrice(ctx sdk.Context) sdk.DecCoin {
 	return minGasPrice
 }
 
+// GetMinGasPrices returns current minimum gas prices required by the network in the native denom
+// and all the accepted fee denoms.
+func (k Keeper) GetMinGasPrices(ctx sdk.Context) sdk.DecCoins {
+	return types.ComputeMinGasPrices(k.GetMinGasPrice(ctx), k.GetParams(ctx).AcceptedFeeDenoms)
+}
+
 // SetMinGasPrice sets minimum gas price required by the network on current block.
 func (k Keeper) SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin) {
 	store := ctx.KVStore(k.storeKey)
@@ -151,3 +217,83 @@ func (k Keeper) SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin) {
 	}
 	store.Set(gasPriceKey, bz)
 }
+
+// AddGasPriceRecord stores the gas price record of the block and prunes the records older than the history length.
+func (k Keeper) AddGasPriceRecord(ctx sdk.Context, record types.GasPriceRecord) {
+	store := ctx.KVStore(k.storeKey)
+	historyLength := int64(k.GetParams(ctx).HistoryLength)
+	if historyLength > 0 {
+		bz, err := record.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(gasPriceRecordKey(record.Height), bz)
+	}
+
+	// records are ordered by height, so all the records below the first height to keep are pruned
+	firstHeightToKeep := record.Height - historyLength + 1
+	if firstHeightToKeep <= 0 {
+		return
+	}
+	recordStore := prefix.NewStore(store, gasPriceRecordKeyPrefix)
+	iterator := recordStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(firstHeightToKeep)))
+	defer iterator.Close()
+
+	var keysToDelete [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDelete = append(keysToDelete, iterator.Key())
+	}
+	for _, key := range keysToDelete {
+		recordStore.Delete(key)
+	}
+}
+
+// GetRecentGasPrices returns the gas price records of the recent blocks ordered by height.
+func (k Keeper) GetRecentGasPrices(ctx sdk.Context, pagination *query.PageRequest) ([]types.GasPriceRecord, *query.PageResponse, error) {
+	recordStore := prefix.NewStore(ctx.KVStore(k.storeKey), gasPriceRecordKeyPrefix)
+	records := make([]types.GasPriceRecord, 0)
+	pageRes, err := query.Paginate(recordStore, pagination, func(key, value []byte) error {
+		var record types.GasPriceRecord
+		if err := record.Unmarshal(value); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return records, pageRes, nil
+}
+
+// ForecastMinGasPrice returns the highest minimum gas price required by the network within afterBlocks next blocks,
+// assuming each of them uses blockGas.
+func (k Keeper) ForecastMinGasPrice(ctx sdk.Context, afterBlocks uint32, blockGas int64) sdk.DecCoin {
+	minGasPrice := k.GetMinGasPrice(ctx)
+	model := types.NewModel(k.GetParams(ctx).Model)
+	forecastGasPrice := model.ForecastMaxGasPrice(k.GetShortEMAGas(ctx), k.GetLongEMAGas(ctx), blockGas, afterBlocks)
+	if forecastGasPrice.GT(minGasPrice.Amount) {
+		minGasPrice.Amount = forecastGasPrice
+	}
+	return minGasPrice
+}
+
+// SimulateGasPrices replays the stored gas price history through the model params and returns the gas price records
+// they would have produced.
+func (k Keeper) SimulateGasPrices(ctx sdk.Context, modelParams types.ModelParams) []types.GasPriceRecord {
+	recordStore := prefix.NewStore(ctx.KVStore(k.storeKey), gasPriceRecordKeyPrefix)
+	iterator := recordStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var records []types.GasPriceRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.GasPriceRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		records = append(records, record)
+	}
+
+	return types.NewModel(modelParams).SimulateGasPrices(records, k.GetMinGasPrice(ctx).Denom)
+}
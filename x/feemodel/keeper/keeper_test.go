@@ -1,18 +1,23 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/CoreumFoundation/coreum-tools/pkg/must"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	"github.com/CoreumFoundation/coreum/x/feemodel/keeper"
 	"github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
@@ -33,12 +38,59 @@ func (psm *paramSubspaceMock) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSe
 	}
 }
 
+func (psm *paramSubspaceMock) GetParamSetIfExists(ctx sdk.Context, ps paramtypes.ParamSet) {
+	for _, pair := range ps.ParamSetPairs() {
+		if bz, ok := psm.params[string(pair.Key)]; ok {
+			must.OK(json.Unmarshal(bz, pair.Value))
+		}
+	}
+}
+
 func (psm *paramSubspaceMock) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
 		psm.params[string(pair.Key)] = must.Bytes(json.Marshal(pair.Value))
 	}
 }
 
+type assetFTKeeperMock struct {
+	definitions map[string]assetfttypes.Definition
+}
+
+func (m assetFTKeeperMock) GetDefinition(ctx sdk.Context, denom string) (assetfttypes.Definition, error) {
+	if _, _, err := assetfttypes.DeconstructDenom(denom); err != nil {
+		return assetfttypes.Definition{}, err
+	}
+	def, ok := m.definitions[denom]
+	if !ok {
+		return assetfttypes.Definition{}, sdkerrors.Wrapf(assetfttypes.ErrTokenNotFound, "denom: %s", denom)
+	}
+	return def, nil
+}
+
+var (
+	issuer             = sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20))
+	ftDenom            = assetfttypes.BuildDenom("ftcoin", issuer)
+	freezableDenom     = assetfttypes.BuildDenom("freezablecoin", issuer)
+	burnRateDenom      = assetfttypes.BuildDenom("burnratecoin", issuer)
+	notExistingFTDenom = assetfttypes.BuildDenom("notexisting", issuer)
+)
+
+var assetFTKeeper = assetFTKeeperMock{
+	definitions: map[string]assetfttypes.Definition{
+		ftDenom: {
+			Denom: ftDenom,
+		},
+		freezableDenom: {
+			Denom:    freezableDenom,
+			Features: []assetfttypes.Feature{assetfttypes.Feature_freezing},
+		},
+		burnRateDenom: {
+			Denom:    burnRateDenom,
+			BurnRate: sdk.MustNewDecFromStr("0.1"),
+		},
+	},
+}
+
 func setup() (sdk.Context, keeper.Keeper) {
 	key := sdk.NewKVStoreKey(types.StoreKey)
 	tKey := sdk.NewTransientStoreKey(types.TransientStoreKey)
@@ -50,7 +102,7 @@ func setup() (sdk.Context, keeper.Keeper) {
 	must.OK(cms.LoadLatestVersion())
 	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
 
-	return ctx, keeper.NewKeeper(newParamSubspaceMock(), key, tKey)
+	return ctx, keeper.NewKeeper(newParamSubspaceMock(), assetFTKeeper, key, tKey)
 }
 
 func TestTrackGas(t *testing.T) {
@@ -65,6 +117,21 @@ func TestTrackGas(t *testing.T) {
 	assert.EqualValues(t, 15, keeper.TrackedGas(ctx))
 }
 
+func TestTrackGasUsed(t *testing.T) {
+	ctx, keeper := setup()
+
+	assert.EqualValues(t, 0, keeper.TrackedGasUsed(ctx))
+
+	keeper.TrackGasUsed(ctx, 10)
+	assert.EqualValues(t, 10, keeper.TrackedGasUsed(ctx))
+
+	keeper.TrackGasUsed(ctx, 5)
+	assert.EqualValues(t, 15, keeper.TrackedGasUsed(ctx))
+
+	// gas used is tracked separately from the gas declared by transactions
+	assert.EqualValues(t, 0, keeper.TrackedGas(ctx))
+}
+
 func TestShortEMAGas(t *testing.T) {
 	ctx, keeper := setup()
 
@@ -97,6 +164,24 @@ func TestMinGasPrice(t *testing.T) {
 	assert.Equal(t, "coin", minGasPrice.Denom)
 }
 
+func TestMinGasPrices(t *testing.T) {
+	ctx, keeper := setup()
+
+	params := types.DefaultParams()
+	keeper.SetParams(ctx, params)
+	keeper.SetMinGasPrice(ctx, sdk.NewDecCoin("coin", sdk.NewInt(10)))
+	assert.Equal(t, sdk.DecCoins{sdk.NewDecCoin("coin", sdk.NewInt(10))}, keeper.GetMinGasPrices(ctx))
+
+	params.AcceptedFeeDenoms = []types.FeeDenom{
+		{Denom: "feecoin", ExchangeRate: sdk.MustNewDecFromStr("2.5")},
+	}
+	keeper.SetParams(ctx, params)
+	assert.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoin("coin", sdk.NewInt(10)),
+		sdk.NewDecCoin("feecoin", sdk.NewInt(25)),
+	}, keeper.GetMinGasPrices(ctx))
+}
+
 func TestParams(t *testing.T) {
 	ctx, keeper := setup()
 
@@ -111,4 +196,149 @@ func TestParams(t *testing.T) {
 	assert.Equal(t, defParams.Model.MaxBlockGas, params.Model.MaxBlockGas)
 	assert.Equal(t, defParams.Model.ShortEmaBlockLength, params.Model.ShortEmaBlockLength)
 	assert.Equal(t, defParams.Model.LongEmaBlockLength, params.Model.LongEmaBlockLength)
+	assert.Equal(t, defParams.HistoryLength, params.HistoryLength)
+	assert.Equal(t, defParams.AcceptedFeeDenoms, params.AcceptedFeeDenoms)
+}
+
+func TestSimulateGasPrices(t *testing.T) {
+	requireT := require.New(t)
+	ctx, keeper := setup()
+
+	params := types.DefaultParams()
+	keeper.SetParams(ctx, params)
+	keeper.SetMinGasPrice(ctx, sdk.NewDecCoin("coin", sdk.NewInt(1)))
+
+	requireT.Empty(keeper.SimulateGasPrices(ctx, params.Model))
+
+	for height := int64(1); height <= 3; height++ {
+		keeper.AddGasPriceRecord(ctx, types.GasPriceRecord{
+			Height:      height,
+			TrackedGas:  params.Model.MaxBlockGas,
+			ShortEmaGas: 100,
+			LongEmaGas:  100,
+			MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(1)),
+		})
+	}
+
+	modelParams := params.Model
+	modelParams.ShortEmaBlockLength = 1
+	records := keeper.SimulateGasPrices(ctx, modelParams)
+	requireT.Equal([]int64{1, 2, 3}, recordHeights(records))
+
+	// with short EMA over one block full blocks result in the max gas price
+	maxGasPrice := types.NewModel(modelParams).CalculateMaxGasPrice()
+	requireT.Equal(sdk.NewDecCoinFromDec("coin", maxGasPrice), records[1].MinGasPrice)
+	requireT.Equal(sdk.NewDecCoinFromDec("coin", maxGasPrice), records[2].MinGasPrice)
+
+	// stored history is not modified
+	storedRecords, _, err := keeper.GetRecentGasPrices(ctx, nil)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewDecCoin("coin", sdk.NewInt(1)), storedRecords[2].MinGasPrice)
+}
+
+func TestGasPriceRecords(t *testing.T) {
+	requireT := require.New(t)
+	ctx, keeper := setup()
+
+	params := types.DefaultParams()
+	params.HistoryLength = 3
+	keeper.SetParams(ctx, params)
+
+	for height := int64(1); height <= 5; height++ {
+		keeper.AddGasPriceRecord(ctx, types.GasPriceRecord{
+			Height:      height,
+			TrackedGas:  height * 10,
+			ShortEmaGas: height * 5,
+			LongEmaGas:  height * 2,
+			MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(height)),
+		})
+	}
+
+	records, pageRes, err := keeper.GetRecentGasPrices(ctx, &query.PageRequest{CountTotal: true})
+	requireT.NoError(err)
+	requireT.EqualValues(3, pageRes.Total)
+	requireT.Equal([]int64{3, 4, 5}, recordHeights(records))
+	requireT.EqualValues(40, records[1].TrackedGas)
+	requireT.EqualValues(20, records[1].ShortEmaGas)
+	requireT.EqualValues(8, records[1].LongEmaGas)
+	requireT.Equal(sdk.NewDecCoin("coin", sdk.NewInt(4)), records[1].MinGasPrice)
+
+	// pagination
+	records, pageRes, err = keeper.GetRecentGasPrices(ctx, &query.PageRequest{Limit: 2})
+	requireT.NoError(err)
+	requireT.Equal([]int64{3, 4}, recordHeights(records))
+	records, _, err = keeper.GetRecentGasPrices(ctx, &query.PageRequest{Key: pageRes.NextKey})
+	requireT.NoError(err)
+	requireT.Equal([]int64{5}, recordHeights(records))
+
+	records, _, err = keeper.GetRecentGasPrices(ctx, &query.PageRequest{Limit: 1, Reverse: true})
+	requireT.NoError(err)
+	requireT.Equal([]int64{5}, recordHeights(records))
+
+	// shorter history prunes all the older records
+	params.HistoryLength = 1
+	keeper.SetParams(ctx, params)
+	keeper.AddGasPriceRecord(ctx, types.GasPriceRecord{Height: 6})
+	records, _, err = keeper.GetRecentGasPrices(ctx, nil)
+	requireT.NoError(err)
+	requireT.Equal([]int64{6}, recordHeights(records))
+
+	// zero history length disables the history
+	params.HistoryLength = 0
+	keeper.SetParams(ctx, params)
+	keeper.AddGasPriceRecord(ctx, types.GasPriceRecord{Height: 7})
+	records, _, err = keeper.GetRecentGasPrices(ctx, nil)
+	requireT.NoError(err)
+	requireT.Empty(records)
+}
+
+func TestForecastMinGasPrice(t *testing.T) {
+	ctx, keeper := setup()
+
+	params := types.DefaultParams()
+	keeper.SetParams(ctx, params)
+	model := types.NewModel(params.Model)
+
+	keeper.SetShortEMAGas(ctx, 100)
+	keeper.SetLongEMAGas(ctx, 100)
+	keeper.SetMinGasPrice(ctx, sdk.NewDecCoinFromDec("coin", model.CalculateGasPriceWithMaxDiscount()))
+
+	// with no blocks the current price is returned
+	minGasPrice := keeper.ForecastMinGasPrice(ctx, 0, params.Model.MaxBlockGas)
+	assert.Equal(t, sdk.NewDecCoinFromDec("coin", model.CalculateGasPriceWithMaxDiscount()), minGasPrice)
+
+	// full blocks escalate the price
+	minGasPrice = keeper.ForecastMinGasPrice(ctx, 100, params.Model.MaxBlockGas)
+	assert.Equal(t, "coin", minGasPrice.Denom)
+	assert.True(t, minGasPrice.Amount.Equal(model.ForecastMaxGasPrice(100, 100, params.Model.MaxBlockGas, 100)))
+	assert.True(t, minGasPrice.Amount.GT(model.CalculateGasPriceWithMaxDiscount()))
+
+	// current price is returned if it is higher than the forecast one
+	keeper.SetMinGasPrice(ctx, sdk.NewDecCoinFromDec("coin", model.CalculateMaxGasPrice()))
+	minGasPrice = keeper.ForecastMinGasPrice(ctx, 10, 0)
+	assert.True(t, minGasPrice.Amount.Equal(model.CalculateMaxGasPrice()))
+}
+
+func recordHeights(records []types.GasPriceRecord) []int64 {
+	heights := make([]int64, 0, len(records))
+	for _, record := range records {
+		heights = append(heights, record.Height)
+	}
+	return heights
+}
+
+func TestValidateAcceptedFeeDenom(t *testing.T) {
+	requireT := require.New(t)
+	ctx, keeper := setup()
+
+	keeper.SetParams(ctx, types.DefaultParams())
+	keeper.SetMinGasPrice(ctx, sdk.NewDecCoin("coin", sdk.NewInt(10)))
+
+	requireT.NoError(keeper.ValidateAcceptedFeeDenom(ctx, "feecoin"))
+	requireT.NoError(keeper.ValidateAcceptedFeeDenom(ctx, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"))
+	requireT.NoError(keeper.ValidateAcceptedFeeDenom(ctx, ftDenom))
+	requireT.NoError(keeper.ValidateAcceptedFeeDenom(ctx, notExistingFTDenom))
+	requireT.Error(keeper.ValidateAcceptedFeeDenom(ctx, "coin"))
+	requireT.Error(keeper.ValidateAcceptedFeeDenom(ctx, freezableDenom))
+	requireT.Error(keeper.ValidateAcceptedFeeDenom(ctx, burnRateDenom))
 }
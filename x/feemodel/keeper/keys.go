@@ -1,8 +1,17 @@
 package keeper
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 var (
 	gasTrackingKey = []byte{0x00}
 	gasPriceKey    = []byte{0x01}
 	shortEMAGasKey = []byte{0x02}
 	longEMAGasKey  = []byte{0x03}
+
+	gasPriceRecordKeyPrefix = []byte{0x04}
+	gasUsedTrackingKey      = []byte{0x05}
 )
+
+func gasPriceRecordKey(height int64) []byte {
+	return append(append([]byte{}, gasPriceRecordKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+// MigrationKeeper defines subscope of keeper methods required by the migrations.
+type MigrationKeeper interface {
+	GetParamsIfExists(ctx sdk.Context, params *types.Params)
+	SetParams(ctx sdk.Context, params types.Params)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper MigrationKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper MigrationKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// In version 2 the history length, accepted fee denoms, gas tracking mode and tip burn fraction params have been
+// introduced, so they are set to the default values while the model params are kept.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	m.keeper.GetParamsIfExists(ctx, &params)
+	m.keeper.SetParams(ctx, params)
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -32,14 +33,22 @@ var (
 // Keeper defines an interface of keeper required by fee module.
 type Keeper interface {
 	TrackedGas(ctx sdk.Context) int64
+	TrackedGasUsed(ctx sdk.Context) int64
 	SetParams(ctx sdk.Context, params types.Params)
 	GetParams(ctx sdk.Context) types.Params
+	GetParamsIfExists(ctx sdk.Context, params *types.Params)
 	GetShortEMAGas(ctx sdk.Context) int64
 	SetShortEMAGas(ctx sdk.Context, emaGas int64)
 	GetLongEMAGas(ctx sdk.Context) int64
 	SetLongEMAGas(ctx sdk.Context, emaGas int64)
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
+	GetMinGasPrices(ctx sdk.Context) sdk.DecCoins
 	SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin)
+	AddGasPriceRecord(ctx sdk.Context, record types.GasPriceRecord)
+	GetRecentGasPrices(ctx sdk.Context, pagination *query.PageRequest) ([]types.GasPriceRecord, *query.PageResponse, error)
+	ForecastMinGasPrice(ctx sdk.Context, afterBlocks uint32, blockGas int64) sdk.DecCoin
+	SimulateGasPrices(ctx sdk.Context, modelParams types.ModelParams) []types.GasPriceRecord
+	ValidateAcceptedFeeDenom(ctx sdk.Context, denom string) error
 }
 
 // AppModuleBasic defines the basic application module used by the fee module.
@@ -86,7 +95,9 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 }
 
 // RegisterInterfaces registers interfaces and implementations of the fee module.
-func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {}
+func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
 
 // AppModule implements an application module for the fee module.
 type AppModule struct {
@@ -98,6 +109,11 @@ type AppModule struct {
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryService(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
 }
 
 // NewAppModule creates a new AppModule object.
@@ -145,7 +161,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock performs a no-op.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
@@ -154,8 +170,13 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// TODO (wojtek): add simulation tests
-	currentGasUsage := am.keeper.TrackedGas(ctx)
 	params := am.keeper.GetParams(ctx)
+	currentGasUsage := am.keeper.TrackedGas(ctx)
+	if params.GasTrackingMode == types.GasTrackingMode_gas_used {
+		// gas used by each delivered transaction, including the failed ones, is tracked by the app once the
+		// transaction is executed
+		currentGasUsage = am.keeper.TrackedGasUsed(ctx)
+	}
 	model := types.NewModel(params.Model)
 	previousMinGasPrice := am.keeper.GetMinGasPrice(ctx)
 
@@ -168,7 +189,15 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 
 	am.keeper.SetShortEMAGas(ctx, newShortEMA)
 	am.keeper.SetLongEMAGas(ctx, newLongEMA)
-	am.keeper.SetMinGasPrice(ctx, sdk.NewDecCoinFromDec(previousMinGasPrice.Denom, newMinGasPrice))
+	newMinGasPriceCoin := sdk.NewDecCoinFromDec(previousMinGasPrice.Denom, newMinGasPrice)
+	am.keeper.SetMinGasPrice(ctx, newMinGasPriceCoin)
+	am.keeper.AddGasPriceRecord(ctx, types.GasPriceRecord{
+		Height:      ctx.BlockHeight(),
+		TrackedGas:  currentGasUsage,
+		ShortEmaGas: newShortEMA,
+		LongEmaGas:  newLongEMA,
+		MinGasPrice: newMinGasPriceCoin,
+	})
 	metrics.SetGauge([]string{"min_gas_price"}, float32(newMinGasPrice.MustFloat64()))
 
 	return []abci.ValidatorUpdate{}
@@ -4,13 +4,20 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/ibc-go/v4/testing/simapp/helpers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/CoreumFoundation/coreum/app"
 	"github.com/CoreumFoundation/coreum/pkg/config"
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
 	"github.com/CoreumFoundation/coreum/x/feemodel"
 	"github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
@@ -22,13 +29,18 @@ func newKeeperMock(genesisState types.GenesisState) *keeperMock {
 }
 
 type keeperMock struct {
-	state types.GenesisState
+	state   types.GenesisState
+	records []types.GasPriceRecord
 }
 
 func (k *keeperMock) TrackedGas(ctx sdk.Context) int64 {
 	return 1
 }
 
+func (k *keeperMock) TrackedGasUsed(ctx sdk.Context) int64 {
+	return 7
+}
+
 func (k *keeperMock) SetParams(ctx sdk.Context, params types.Params) {
 	k.state.Params = params
 }
@@ -37,6 +49,10 @@ func (k *keeperMock) GetParams(ctx sdk.Context) types.Params {
 	return k.state.Params
 }
 
+func (k *keeperMock) GetParamsIfExists(ctx sdk.Context, params *types.Params) {
+	*params = k.state.Params
+}
+
 func (k *keeperMock) GetShortEMAGas(ctx sdk.Context) int64 {
 	return 0
 }
@@ -53,10 +69,37 @@ func (k *keeperMock) GetMinGasPrice(ctx sdk.Context) sdk.DecCoin {
 	return k.state.MinGasPrice
 }
 
+func (k *keeperMock) GetMinGasPrices(ctx sdk.Context) sdk.DecCoins {
+	return types.ComputeMinGasPrices(k.state.MinGasPrice, k.state.Params.AcceptedFeeDenoms)
+}
+
+func (k *keeperMock) SimulateGasPrices(ctx sdk.Context, modelParams types.ModelParams) []types.GasPriceRecord {
+	return types.NewModel(modelParams).SimulateGasPrices(k.records, k.state.MinGasPrice.Denom)
+}
+
+func (k *keeperMock) ValidateAcceptedFeeDenom(ctx sdk.Context, denom string) error {
+	return nil
+}
+
 func (k *keeperMock) SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin) {
 	k.state.MinGasPrice = minGasPrice
 }
 
+func (k *keeperMock) AddGasPriceRecord(ctx sdk.Context, record types.GasPriceRecord) {
+	k.records = append(k.records, record)
+}
+
+func (k *keeperMock) GetRecentGasPrices(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.GasPriceRecord, *query.PageResponse, error) {
+	return k.records, &query.PageResponse{Total: uint64(len(k.records))}, nil
+}
+
+func (k *keeperMock) ForecastMinGasPrice(ctx sdk.Context, afterBlocks uint32, blockGas int64) sdk.DecCoin {
+	return k.state.MinGasPrice
+}
+
 func setup() (feemodel.AppModule, feemodel.Keeper, types.GenesisState, codec.Codec) {
 	genesisState := types.GenesisState{
 		Params: types.Params{
@@ -69,6 +112,11 @@ func setup() (feemodel.AppModule, feemodel.Keeper, types.GenesisState, codec.Cod
 				ShortEmaBlockLength:     1,
 				LongEmaBlockLength:      3,
 			},
+			HistoryLength: 10,
+			AcceptedFeeDenoms: []types.FeeDenom{
+				{Denom: "feecoin", ExchangeRate: sdk.NewDec(2)},
+			},
+			TipBurnFraction: sdk.MustNewDecFromStr("0.5"),
 		},
 		MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(155)),
 	}
@@ -90,6 +138,7 @@ func TestInitGenesis(t *testing.T) {
 	genesisState.Params.Model.MaxBlockGas++
 	genesisState.Params.Model.ShortEmaBlockLength++
 	genesisState.Params.Model.LongEmaBlockLength++
+	genesisState.Params.HistoryLength++
 	genesisState.MinGasPrice.Denom = "coin2"
 	genesisState.MinGasPrice.Amount.Add(sdk.OneDec())
 
@@ -104,6 +153,7 @@ func TestInitGenesis(t *testing.T) {
 	assert.Equal(t, genesisState.Params.Model.MaxBlockGas, params.Model.MaxBlockGas)
 	assert.Equal(t, genesisState.Params.Model.ShortEmaBlockLength, params.Model.ShortEmaBlockLength)
 	assert.Equal(t, genesisState.Params.Model.LongEmaBlockLength, params.Model.LongEmaBlockLength)
+	assert.Equal(t, genesisState.Params.HistoryLength, params.HistoryLength)
 	assert.Equal(t, genesisState.MinGasPrice.Denom, minGasPrice.Denom)
 	assert.True(t, genesisState.MinGasPrice.Amount.Equal(minGasPrice.Amount))
 }
@@ -126,4 +176,75 @@ func TestEndBlock(t *testing.T) {
 	minGasPrice := keeper.GetMinGasPrice(sdk.Context{})
 	assert.True(t, minGasPrice.Amount.Equal(model.CalculateGasPriceWithMaxDiscount()))
 	assert.Equal(t, minGasPrice.Denom, state.MinGasPrice.Denom)
+
+	records, _, err := keeper.GetRecentGasPrices(sdk.Context{}, nil)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.EqualValues(t, 1, records[0].TrackedGas)
+	assert.Equal(t, minGasPrice, records[0].MinGasPrice)
+}
+
+func TestEndBlockWithGasUsed(t *testing.T) {
+	module, keeper, state, _ := setup()
+
+	params := state.Params
+	params.GasTrackingMode = types.GasTrackingMode_gas_used
+	keeper.SetParams(sdk.Context{}, params)
+
+	module.EndBlock(sdk.Context{}, abci.RequestEndBlock{})
+
+	records, _, err := keeper.GetRecentGasPrices(sdk.Context{}, nil)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	// gas used by transactions is tracked instead of the gas declared by them
+	assert.EqualValues(t, 7, records[0].TrackedGas)
+	assert.EqualValues(t, 7, records[0].ShortEmaGas)
+}
+
+func TestDeliverTxTracksGasUsed(t *testing.T) {
+	requireT := require.New(t)
+	simApp := simapp.New()
+
+	ctx := simApp.BeginNextBlock()
+	params := simApp.FeeModelKeeper.GetParams(ctx)
+	params.GasTrackingMode = types.GasTrackingMode_gas_used
+	simApp.FeeModelKeeper.SetParams(ctx, params)
+	sender, senderKey := simApp.GenAccount(ctx)
+	bondDenom := simApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(simApp.FundAccount(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000))))
+	simApp.EndBlockAndCommit(ctx)
+
+	ctx = simApp.BeginNextBlock()
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	txConfig := config.NewEncodingConfig(app.ModuleBasics).TxConfig
+	var expectedGasUsed int64
+	for i, amount := range []int64{10, 1_000_000_000} {
+		tx, err := helpers.GenTx(
+			txConfig,
+			[]sdk.Msg{banktypes.NewMsgSend(sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount)))},
+			sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000)),
+			300_000,
+			"",
+			[]uint64{simApp.AccountKeeper.GetAccount(ctx, sender).GetAccountNumber()},
+			[]uint64{uint64(i)},
+			senderKey,
+		)
+		requireT.NoError(err)
+		txBytes, err := txConfig.TxEncoder()(tx)
+		requireT.NoError(err)
+
+		res := simApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		// the second transaction fails because of the insufficient funds
+		requireT.Equal(i == 0, res.IsOK())
+		requireT.Positive(res.GasUsed)
+		expectedGasUsed += res.GasUsed
+	}
+
+	// gas used by both the successful and the failed transactions is tracked
+	requireT.Equal(expectedGasUsed, simApp.FeeModelKeeper.TrackedGasUsed(ctx))
+
+	simApp.EndBlockAndCommit(ctx)
+	records, _, err := simApp.FeeModelKeeper.GetRecentGasPrices(simApp.NewUncachedContext(false, tmproto.Header{}), nil)
+	requireT.NoError(err)
+	requireT.Equal(expectedGasUsed, records[len(records)-1].TrackedGas)
 }
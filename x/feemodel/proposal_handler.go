@@ -0,0 +1,71 @@
+package feemodel
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+// ProposalKeeper defines subscope of keeper methods required by the proposal handler.
+type ProposalKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+	ValidateAcceptedFeeDenom(ctx sdk.Context, denom string) error
+}
+
+// NewProposalHandler returns the handler of the feemodel governance proposals.
+func NewProposalHandler(keeper ProposalKeeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateParamsProposal:
+			return handleUpdateParamsProposal(ctx, keeper, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleUpdateParamsProposal(ctx sdk.Context, keeper ProposalKeeper, proposal *types.UpdateParamsProposal) error {
+	if err := proposal.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	for _, feeDenom := range proposal.Params.AcceptedFeeDenoms {
+		if err := keeper.ValidateAcceptedFeeDenom(ctx, feeDenom.Denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	keeper.SetParams(ctx, proposal.Params)
+	return nil
+}
+
+// NewParamChangeProposalHandler wraps the param change proposal handler to validate the accepted fee denoms against
+// the chain state once the proposed changes are applied, because the params module validates them statelessly.
+// The handler is executed when the proposal is submitted too, so the invalid proposals are rejected early.
+func NewParamChangeProposalHandler(keeper ProposalKeeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+
+		proposal, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range proposal.Changes {
+			if change.Subspace != types.ModuleName || change.Key != string(types.KeyAcceptedFeeDenoms) {
+				continue
+			}
+			for _, feeDenom := range keeper.GetParams(ctx).AcceptedFeeDenoms {
+				if err := keeper.ValidateAcceptedFeeDenom(ctx, feeDenom.Denom); err != nil {
+					return sdkerrors.Wrapf(paramproposal.ErrSettingParameter, "key: %s, err: %s", change.Key, err)
+				}
+			}
+		}
+
+		return nil
+	}
+}
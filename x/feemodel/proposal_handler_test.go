@@ -0,0 +1,114 @@
+package feemodel_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/x/feemodel"
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	freezableDenom, err := testApp.AssetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "FREEZE",
+		Subunit:       "freeze",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_freezing},
+	})
+	requireT.NoError(err)
+	denom, err := testApp.AssetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "FEE",
+		Subunit:       "fee",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	handler := feemodel.NewParamChangeProposalHandler(
+		testApp.FeeModelKeeper, params.NewParamChangeProposalHandler(testApp.ParamsKeeper),
+	)
+	newProposal := func(denom string) *paramproposal.ParameterChangeProposal {
+		value, err := tmjson.Marshal([]types.FeeDenom{
+			{Denom: denom, ExchangeRate: sdk.OneDec()},
+		})
+		requireT.NoError(err)
+		return paramproposal.NewParameterChangeProposal("Accept fee denom", "-", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(types.ModuleName, string(types.KeyAcceptedFeeDenoms), string(value)),
+		})
+	}
+
+	for _, invalidDenom := range []string{testApp.FeeModelKeeper.GetMinGasPrice(ctx).Denom, freezableDenom} {
+		cacheCtx, _ := ctx.CacheContext()
+		requireT.ErrorIs(handler(cacheCtx, newProposal(invalidDenom)), paramproposal.ErrSettingParameter)
+	}
+	requireT.Empty(testApp.FeeModelKeeper.GetParams(ctx).AcceptedFeeDenoms)
+
+	requireT.NoError(handler(ctx, newProposal(denom)))
+	requireT.Equal(denom, testApp.FeeModelKeeper.GetParams(ctx).AcceptedFeeDenoms[0].Denom)
+}
+
+func TestProposalHandler(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	freezableDenom, err := testApp.AssetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "FREEZE",
+		Subunit:       "freeze",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_freezing},
+	})
+	requireT.NoError(err)
+
+	handler := feemodel.NewProposalHandler(testApp.FeeModelKeeper)
+	initialParams := testApp.FeeModelKeeper.GetParams(ctx)
+
+	requireT.ErrorIs(handler(ctx, govtypes.NewTextProposal("Text", "-")), sdkerrors.ErrUnknownRequest)
+
+	invalidParams := initialParams
+	invalidParams.Model.EscalationStartFraction = sdk.OneDec()
+	requireT.ErrorIs(
+		handler(ctx, types.NewUpdateParamsProposal("Update params", "-", invalidParams)),
+		sdkerrors.ErrInvalidRequest,
+	)
+
+	invalidParams = initialParams
+	invalidParams.AcceptedFeeDenoms = []types.FeeDenom{{Denom: freezableDenom, ExchangeRate: sdk.OneDec()}}
+	requireT.ErrorIs(
+		handler(ctx, types.NewUpdateParamsProposal("Update params", "-", invalidParams)),
+		sdkerrors.ErrInvalidRequest,
+	)
+	storedParams := testApp.FeeModelKeeper.GetParams(ctx)
+	requireT.Equal(initialParams.String(), storedParams.String())
+
+	newParams := initialParams
+	newParams.TipBurnFraction = sdk.MustNewDecFromStr("0.25")
+	newParams.AcceptedFeeDenoms = []types.FeeDenom{{Denom: "ibc/feecoin", ExchangeRate: sdk.OneDec()}}
+	requireT.NoError(handler(ctx, types.NewUpdateParamsProposal("Update params", "-", newParams)))
+	storedParams = testApp.FeeModelKeeper.GetParams(ctx)
+	requireT.Equal(newParams.String(), storedParams.String())
+}
@@ -0,0 +1,472 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/feemodel/v1/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventFee is emitted when the fee of the transaction is charged.
+type EventFee struct {
+	// base_fee is the part of the fee required by the minimum gas price.
+	BaseFee types.Coin `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee"`
+	// tip is the part of the fee offered above the required one.
+	Tip types.Coin `protobuf:"bytes,2,opt,name=tip,proto3" json:"tip"`
+	// burnt_tip is the part of the tip which is burnt.
+	BurntTip types.Coin `protobuf:"bytes,3,opt,name=burnt_tip,json=burntTip,proto3" json:"burnt_tip"`
+	// priority is the priority of the transaction in the mempool computed from the tip.
+	Priority int64 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *EventFee) Reset()         { *m = EventFee{} }
+func (m *EventFee) String() string { return proto.CompactTextString(m) }
+func (*EventFee) ProtoMessage()    {}
+func (*EventFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea6e19e4e6fcbeaf, []int{0}
+}
+func (m *EventFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFee.Merge(m, src)
+}
+func (m *EventFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFee proto.InternalMessageInfo
+
+func (m *EventFee) GetBaseFee() types.Coin {
+	if m != nil {
+		return m.BaseFee
+	}
+	return types.Coin{}
+}
+
+func (m *EventFee) GetTip() types.Coin {
+	if m != nil {
+		return m.Tip
+	}
+	return types.Coin{}
+}
+
+func (m *EventFee) GetBurntTip() types.Coin {
+	if m != nil {
+		return m.BurntTip
+	}
+	return types.Coin{}
+}
+
+func (m *EventFee) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventFee)(nil), "coreum.feemodel.v1.EventFee")
+}
+
+func init() { proto.RegisterFile("coreum/feemodel/v1/event.proto", fileDescriptor_ea6e19e4e6fcbeaf) }
+
+var fileDescriptor_ea6e19e4e6fcbeaf = []byte{
+	// 274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0x31, 0x4e, 0xc3, 0x30,
+	0x18, 0x46, 0x63, 0x52, 0x41, 0x30, 0x5b, 0xc4, 0x10, 0x32, 0x98, 0x8a, 0xa9, 0x93, 0xad, 0xd0,
+	0x0d, 0x31, 0xb5, 0xa2, 0x1b, 0x4b, 0xc5, 0xc4, 0x52, 0x25, 0xe9, 0xdf, 0x60, 0x89, 0xf8, 0xb7,
+	0x1c, 0x27, 0xa2, 0xb7, 0xe0, 0x58, 0x1d, 0x2b, 0xb1, 0x30, 0x21, 0x94, 0x5c, 0x04, 0x39, 0x29,
+	0xb0, 0x76, 0xb3, 0xfd, 0xbe, 0xe7, 0xe1, 0x51, 0x96, 0xa3, 0x81, 0xba, 0x14, 0x1b, 0x80, 0x12,
+	0xd7, 0xf0, 0x2a, 0x9a, 0x44, 0x40, 0x03, 0xca, 0x72, 0x6d, 0xd0, 0x62, 0x18, 0x0e, 0x9c, 0xff,
+	0x72, 0xde, 0x24, 0xf1, 0x65, 0x81, 0x05, 0xf6, 0x58, 0xb8, 0xd3, 0xb0, 0x8c, 0x59, 0x8e, 0x55,
+	0x89, 0x95, 0xc8, 0xd2, 0x0a, 0x44, 0x93, 0x64, 0x60, 0xd3, 0x44, 0xe4, 0x28, 0xd5, 0xc0, 0x6f,
+	0x3e, 0x08, 0x0d, 0x1e, 0xdc, 0xcf, 0x0b, 0x80, 0xf0, 0x8e, 0x06, 0x6e, 0xb7, 0xda, 0x00, 0x44,
+	0x64, 0x4c, 0x26, 0x17, 0xb7, 0x57, 0x7c, 0xf0, 0xb9, 0x7b, 0xe7, 0x07, 0x9f, 0xcf, 0x51, 0xaa,
+	0xd9, 0x68, 0xf7, 0x75, 0xed, 0x2d, 0xcf, 0x1c, 0x70, 0x6e, 0x42, 0x7d, 0x2b, 0x75, 0x74, 0x72,
+	0x9c, 0xe6, 0xb6, 0xe1, 0x3d, 0x3d, 0xcf, 0x6a, 0xa3, 0xec, 0xca, 0x89, 0xfe, 0x71, 0x62, 0xd0,
+	0x1b, 0x4f, 0x52, 0x87, 0x31, 0x0d, 0xb4, 0x91, 0x68, 0xa4, 0xdd, 0x46, 0xa3, 0x31, 0x99, 0xf8,
+	0xcb, 0xbf, 0xfb, 0xec, 0x71, 0xd7, 0x32, 0xb2, 0x6f, 0x19, 0xf9, 0x6e, 0x19, 0x79, 0xef, 0x98,
+	0xb7, 0xef, 0x98, 0xf7, 0xd9, 0x31, 0xef, 0x79, 0x5a, 0x48, 0xfb, 0x52, 0x67, 0x3c, 0xc7, 0x52,
+	0xcc, 0xfb, 0x88, 0x0b, 0xac, 0xd5, 0x3a, 0xb5, 0x12, 0x95, 0x38, 0x54, 0x7f, 0xfb, 0xef, 0x6e,
+	0xb7, 0x1a, 0xaa, 0xec, 0xb4, 0x6f, 0x35, 0xfd, 0x19, 0x00, 0x8e, 0xca, 0x28, 0x6f, 0x97, 0x01,
+	0x00, 0x00,
+}
+
+func (m *EventFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.BurntTip.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Tip.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Tip.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BurntTip.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Priority != 0 {
+		n += 1 + sovEvent(uint64(m.Priority))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurntTip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurntTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// PriorityFromEvents returns the priority reported by the EventFee event or 0 if there is no such event.
+func PriorityFromEvents(events []abci.Event) int64 {
+	eventType := proto.MessageName(&EventFee{})
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return 0
+		}
+		eventFee, ok := msg.(*EventFee)
+		if !ok {
+			return 0
+		}
+		return eventFee.Priority
+	}
+	return 0
+}
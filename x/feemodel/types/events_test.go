@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestPriorityFromEvents(t *testing.T) {
+	assert.EqualValues(t, 0, PriorityFromEvents(nil))
+
+	eventFee, err := sdk.TypedEventToEvent(&EventFee{
+		BaseFee:  sdk.NewInt64Coin("coin", 100),
+		Tip:      sdk.NewInt64Coin("coin", 10),
+		BurntTip: sdk.NewInt64Coin("coin", 0),
+		Priority: 100_000,
+	})
+	require.NoError(t, err)
+
+	events := sdk.Events{sdk.NewEvent("message"), eventFee}.ToABCIEvents()
+	assert.EqualValues(t, 100_000, PriorityFromEvents(events))
+	assert.EqualValues(t, 0, PriorityFromEvents([]abci.Event{events[0]}))
+}
@@ -22,5 +22,13 @@ func (m *GenesisState) Validate() error {
 	if !m.MinGasPrice.IsPositive() {
 		return errors.New("min gas price must be positive")
 	}
-	return m.Params.ValidateBasic()
+	if err := m.Params.ValidateBasic(); err != nil {
+		return err
+	}
+	for _, feeDenom := range m.Params.AcceptedFeeDenoms {
+		if feeDenom.Denom == m.MinGasPrice.Denom {
+			return errors.Errorf("native denom %q can't be the accepted fee denom", feeDenom.Denom)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,474 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/feemodel/v1/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GasPriceRecord is the state of the fee model computed at the end of the block.
+type GasPriceRecord struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// tracked_gas is the gas tracked by the fee model in the block.
+	TrackedGas int64 `protobuf:"varint,2,opt,name=tracked_gas,json=trackedGas,proto3" json:"tracked_gas,omitempty"`
+	// short_ema_gas is the short average block gas computed at the end of the block.
+	ShortEmaGas int64 `protobuf:"varint,3,opt,name=short_ema_gas,json=shortEmaGas,proto3" json:"short_ema_gas,omitempty"`
+	// long_ema_gas is the long average block gas computed at the end of the block.
+	LongEmaGas int64 `protobuf:"varint,4,opt,name=long_ema_gas,json=longEmaGas,proto3" json:"long_ema_gas,omitempty"`
+	// min_gas_price is the minimum gas price computed at the end of the block and required by the next block.
+	MinGasPrice types.DecCoin `protobuf:"bytes,5,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+}
+
+func (m *GasPriceRecord) Reset()         { *m = GasPriceRecord{} }
+func (m *GasPriceRecord) String() string { return proto.CompactTextString(m) }
+func (*GasPriceRecord) ProtoMessage()    {}
+func (*GasPriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff14b79ad4bf116c, []int{0}
+}
+func (m *GasPriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceRecord.Merge(m, src)
+}
+func (m *GasPriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceRecord proto.InternalMessageInfo
+
+func (m *GasPriceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GasPriceRecord) GetTrackedGas() int64 {
+	if m != nil {
+		return m.TrackedGas
+	}
+	return 0
+}
+
+func (m *GasPriceRecord) GetShortEmaGas() int64 {
+	if m != nil {
+		return m.ShortEmaGas
+	}
+	return 0
+}
+
+func (m *GasPriceRecord) GetLongEmaGas() int64 {
+	if m != nil {
+		return m.LongEmaGas
+	}
+	return 0
+}
+
+func (m *GasPriceRecord) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+func init() {
+	proto.RegisterType((*GasPriceRecord)(nil), "coreum.feemodel.v1.GasPriceRecord")
+}
+
+func init() { proto.RegisterFile("coreum/feemodel/v1/history.proto", fileDescriptor_ff14b79ad4bf116c) }
+
+var fileDescriptor_ff14b79ad4bf116c = []byte{
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xc1, 0x4e, 0xc2, 0x30,
+	0x1c, 0xc6, 0x37, 0x41, 0x0e, 0x9d, 0x78, 0x58, 0x8c, 0x21, 0xc4, 0x94, 0x85, 0x13, 0xa7, 0x36,
+	0x93, 0x37, 0x00, 0x85, 0x93, 0x89, 0xe1, 0xe8, 0x85, 0x74, 0x5d, 0xdd, 0x1a, 0x69, 0xff, 0xa4,
+	0x2d, 0x44, 0xde, 0xc2, 0xc7, 0xe2, 0xc8, 0x4d, 0x4f, 0xc6, 0xc0, 0x8b, 0x98, 0x75, 0x43, 0x6f,
+	0xed, 0xf7, 0xfd, 0xd2, 0xf4, 0xfb, 0xa1, 0x84, 0x83, 0x11, 0x1b, 0x45, 0x5f, 0x85, 0x50, 0x90,
+	0x8b, 0x15, 0xdd, 0xa6, 0xb4, 0x94, 0xd6, 0x81, 0xd9, 0x91, 0xb5, 0x01, 0x07, 0x71, 0x5c, 0x13,
+	0xe4, 0x4c, 0x90, 0x6d, 0xda, 0xbf, 0x29, 0xa0, 0x00, 0x5f, 0xd3, 0xea, 0x54, 0x93, 0x7d, 0xcc,
+	0xc1, 0x2a, 0xb0, 0x34, 0x63, 0x56, 0xd0, 0x6d, 0x9a, 0x09, 0xc7, 0x52, 0xca, 0x41, 0xea, 0xba,
+	0x1f, 0x7e, 0x86, 0xe8, 0x7a, 0xce, 0xec, 0xb3, 0x91, 0x5c, 0x2c, 0x04, 0x07, 0x93, 0xc7, 0xb7,
+	0xa8, 0x53, 0x0a, 0x59, 0x94, 0xae, 0x17, 0x26, 0xe1, 0xa8, 0xb5, 0x68, 0x6e, 0xf1, 0x00, 0x45,
+	0xce, 0x30, 0xfe, 0x26, 0xf2, 0x65, 0xc1, 0x6c, 0xef, 0xc2, 0x97, 0xa8, 0x89, 0xe6, 0xcc, 0xc6,
+	0x43, 0xd4, 0xb5, 0x25, 0x18, 0xb7, 0x14, 0x8a, 0x79, 0xa4, 0xe5, 0x91, 0xc8, 0x87, 0x8f, 0x8a,
+	0x55, 0x4c, 0x82, 0xae, 0x56, 0xa0, 0x8b, 0x3f, 0xa4, 0x5d, 0xbf, 0x52, 0x65, 0x0d, 0x31, 0x43,
+	0x5d, 0x25, 0x75, 0x55, 0x2e, 0xd7, 0xd5, 0xaf, 0x7a, 0x97, 0x49, 0x38, 0x8a, 0xee, 0xef, 0x48,
+	0xbd, 0x84, 0x54, 0x4b, 0x48, 0xb3, 0x84, 0x3c, 0x08, 0x3e, 0x05, 0xa9, 0x27, 0xed, 0xfd, 0xf7,
+	0x20, 0x58, 0x44, 0x4a, 0xea, 0xf3, 0x98, 0xc9, 0xd3, 0xfe, 0x88, 0xc3, 0xc3, 0x11, 0x87, 0x3f,
+	0x47, 0x1c, 0x7e, 0x9c, 0x70, 0x70, 0x38, 0xe1, 0xe0, 0xeb, 0x84, 0x83, 0x97, 0x71, 0x21, 0x5d,
+	0xb9, 0xc9, 0x08, 0x07, 0x45, 0xa7, 0x5e, 0xe4, 0x0c, 0x36, 0x3a, 0x67, 0x4e, 0x82, 0xa6, 0x8d,
+	0xfb, 0xf7, 0x7f, 0xfb, 0x6e, 0xb7, 0x16, 0x36, 0xeb, 0x78, 0x5f, 0xe3, 0xdf, 0x01, 0x00, 0x69,
+	0xd9, 0xb6, 0x32, 0x9d, 0x01, 0x00, 0x00,
+}
+
+func (m *GasPriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.LongEmaGas != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.LongEmaGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShortEmaGas != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ShortEmaGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TrackedGas != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.TrackedGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GasPriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	if m.TrackedGas != 0 {
+		n += 1 + sovHistory(uint64(m.TrackedGas))
+	}
+	if m.ShortEmaGas != 0 {
+		n += 1 + sovHistory(uint64(m.ShortEmaGas))
+	}
+	if m.LongEmaGas != 0 {
+		n += 1 + sovHistory(uint64(m.LongEmaGas))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GasPriceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedGas", wireType)
+			}
+			m.TrackedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrackedGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortEmaGas", wireType)
+			}
+			m.ShortEmaGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortEmaGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongEmaGas", wireType)
+			}
+			m.LongEmaGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LongEmaGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -45,6 +45,42 @@ func (m Model) CalculateNextGasPrice(shortEMA, longEMA int64) sdk.Dec {
 	}
 }
 
+// ForecastMaxGasPrice calculates the highest minimum gas price required by any of the next blocks,
+// assuming each of them uses blockGas. shortEMA and longEMA are the averages computed at the end of the latest block.
+func (m Model) ForecastMaxGasPrice(shortEMA, longEMA, blockGas int64, afterBlocks uint32) sdk.Dec {
+	maxGasPrice := m.CalculateNextGasPrice(shortEMA, longEMA)
+	for i := uint32(0); i < afterBlocks; i++ {
+		shortEMA = CalculateEMA(shortEMA, blockGas, m.params.ShortEmaBlockLength)
+		longEMA = CalculateEMA(longEMA, blockGas, m.params.LongEmaBlockLength)
+		if gasPrice := m.CalculateNextGasPrice(shortEMA, longEMA); gasPrice.GT(maxGasPrice) {
+			maxGasPrice = gasPrice
+		}
+	}
+	return maxGasPrice
+}
+
+// SimulateGasPrices replays the gas tracked in the records through the model and returns the records it would have produced.
+// The averages of the first record are taken as the starting point.
+func (m Model) SimulateGasPrices(records []GasPriceRecord, denom string) []GasPriceRecord {
+	simulatedRecords := make([]GasPriceRecord, 0, len(records))
+	for i, record := range records {
+		shortEMA, longEMA := record.ShortEmaGas, record.LongEmaGas
+		if i > 0 {
+			previousRecord := simulatedRecords[i-1]
+			shortEMA = CalculateEMA(previousRecord.ShortEmaGas, record.TrackedGas, m.params.ShortEmaBlockLength)
+			longEMA = CalculateEMA(previousRecord.LongEmaGas, record.TrackedGas, m.params.LongEmaBlockLength)
+		}
+		simulatedRecords = append(simulatedRecords, GasPriceRecord{
+			Height:      record.Height,
+			TrackedGas:  record.TrackedGas,
+			ShortEmaGas: shortEMA,
+			LongEmaGas:  longEMA,
+			MinGasPrice: sdk.NewDecCoinFromDec(denom, m.CalculateNextGasPrice(shortEMA, longEMA)),
+		})
+	}
+	return simulatedRecords
+}
+
 // CalculateGasPriceWithMaxDiscount calculates gas price with maximum discount applied.
 func (m Model) CalculateGasPriceWithMaxDiscount() sdk.Dec {
 	return m.params.InitialGasPrice.Mul(sdk.OneDec().Sub(m.params.MaxDiscount))
@@ -69,3 +69,38 @@ func ExampleGasPriceOverTime() {
 	// Output: list of gas prices over time
 	// Check x/feemodel/spec/assets/time_series.png
 }
+
+//nolint:govet // This example does not refer to any identifier
+func ExampleGasPriceOverTimeWithGasUsed() {
+	// Transactions usually declare higher gas limits than they really consume.
+	// Here blocks are full of declared gas, but only the fraction of it is consumed.
+	const gasUsedFraction = 0.4
+
+	var (
+		gasLimitShortEMA int64
+		gasLimitLongEMA  int64
+		gasUsedShortEMA  int64
+		gasUsedLongEMA   int64
+		params           = feeModelSim.Params()
+	)
+	for i := 0; i < 5000; i++ {
+		gasLimit := params.MaxBlockGas
+		gasUsed := int64(gasUsedFraction * float64(gasLimit))
+
+		gasLimitShortEMA = CalculateEMA(gasLimitShortEMA, gasLimit, params.ShortEmaBlockLength)
+		gasLimitLongEMA = CalculateEMA(gasLimitLongEMA, gasLimit, params.LongEmaBlockLength)
+		gasUsedShortEMA = CalculateEMA(gasUsedShortEMA, gasUsed, params.ShortEmaBlockLength)
+		gasUsedLongEMA = CalculateEMA(gasUsedLongEMA, gasUsed, params.LongEmaBlockLength)
+
+		if i%10 != 0 {
+			continue
+		}
+
+		fmt.Printf("%d\t%d\t%s\t%d\t%s\n", i,
+			gasLimitShortEMA, feeModelSim.CalculateNextGasPrice(gasLimitShortEMA, gasLimitLongEMA),
+			gasUsedShortEMA, feeModelSim.CalculateNextGasPrice(gasUsedShortEMA, gasUsedLongEMA),
+		)
+	}
+
+	// Output: list of gas prices over time computed from the declared gas limits and from the gas used
+}
@@ -44,6 +44,65 @@ func TestCalculateNextGasPriceKeyPoints(t *testing.T) {
 	assert.True(t, nextGasPrice.Equal(feeModel.CalculateMaxGasPrice()))
 }
 
+func TestForecastMaxGasPrice(t *testing.T) {
+	// with no blocks the forecast is the price for next block
+
+	forecastGasPrice := feeModel.ForecastMaxGasPrice(100, 100, feeModel.params.MaxBlockGas, 0)
+	assert.True(t, forecastGasPrice.Equal(gasPriceWithMaxDiscount))
+
+	// full blocks escalate the price, the highest one is reached in the last block
+
+	shortEMA, longEMA := int64(100), int64(100)
+	for i := 0; i < 20; i++ {
+		shortEMA = CalculateEMA(shortEMA, feeModel.params.MaxBlockGas, feeModel.params.ShortEmaBlockLength)
+		longEMA = CalculateEMA(longEMA, feeModel.params.MaxBlockGas, feeModel.params.LongEmaBlockLength)
+	}
+	expectedGasPrice := feeModel.CalculateNextGasPrice(shortEMA, longEMA)
+	assert.True(t, expectedGasPrice.GT(gasPriceWithMaxDiscount))
+
+	forecastGasPrice = feeModel.ForecastMaxGasPrice(100, 100, feeModel.params.MaxBlockGas, 20)
+	assert.True(t, forecastGasPrice.Equal(expectedGasPrice))
+
+	// when the load goes down, the highest price is the current one
+
+	forecastGasPrice = feeModel.ForecastMaxGasPrice(feeModel.params.MaxBlockGas, 100, 100, 20)
+	assert.True(t, forecastGasPrice.Equal(feeModel.CalculateMaxGasPrice()))
+
+	// empty blocks move the price from the max discount towards the initial price
+
+	forecastGasPrice = feeModel.ForecastMaxGasPrice(100, 100, 0, 20)
+	assert.True(t, forecastGasPrice.GT(gasPriceWithMaxDiscount))
+	assert.True(t, forecastGasPrice.LTE(feeModel.params.InitialGasPrice))
+}
+
+func TestSimulateGasPrices(t *testing.T) {
+	records := []GasPriceRecord{
+		{Height: 1, TrackedGas: 100, ShortEmaGas: 100, LongEmaGas: 100},
+		{Height: 2, TrackedGas: feeModel.params.MaxBlockGas},
+		{Height: 3, TrackedGas: feeModel.params.MaxBlockGas},
+	}
+
+	simulatedRecords := feeModel.SimulateGasPrices(records, "coin")
+	assert.Len(t, simulatedRecords, 3)
+
+	// averages of the first record are taken as they are
+	assert.EqualValues(t, 100, simulatedRecords[0].ShortEmaGas)
+	assert.EqualValues(t, 100, simulatedRecords[0].LongEmaGas)
+	assert.Equal(t, sdk.NewDecCoinFromDec("coin", gasPriceWithMaxDiscount), simulatedRecords[0].MinGasPrice)
+
+	// next averages are recomputed using the model block lengths
+	shortEMA := CalculateEMA(100, feeModel.params.MaxBlockGas, feeModel.params.ShortEmaBlockLength)
+	longEMA := CalculateEMA(100, feeModel.params.MaxBlockGas, feeModel.params.LongEmaBlockLength)
+	assert.Equal(t, shortEMA, simulatedRecords[1].ShortEmaGas)
+	assert.Equal(t, longEMA, simulatedRecords[1].LongEmaGas)
+	assert.Equal(t, sdk.NewDecCoinFromDec("coin", feeModel.CalculateNextGasPrice(shortEMA, longEMA)), simulatedRecords[1].MinGasPrice)
+
+	assert.EqualValues(t, 3, simulatedRecords[2].Height)
+	assert.Greater(t, simulatedRecords[2].ShortEmaGas, simulatedRecords[1].ShortEmaGas)
+
+	assert.Empty(t, feeModel.SimulateGasPrices(nil, "coin"))
+}
+
 func TestEMAGasBeyondEscalationStartBlockGas(t *testing.T) {
 	// There is a special case when long average block gas is higher than escalation start block gas.
 	// The question is if in such scenario we should offer discounted gas price or escalation should be applied instead.
@@ -6,14 +6,35 @@ import (
 	"github.com/pkg/errors"
 )
 
-// KeyModel represents the Model param key with which the ModelParams will be stored.
-var KeyModel = []byte("Model")
+const (
+	// MaxHistoryLength is the maximum number of blocks for which the gas price records might be kept.
+	MaxHistoryLength = 100_000
+	// MaxForecastBlocks is the maximum number of blocks the min gas price might be forecast for.
+	MaxForecastBlocks = 1000
+)
+
+var (
+	// KeyModel represents the Model param key with which the ModelParams will be stored.
+	KeyModel = []byte("Model")
+	// KeyHistoryLength represents the HistoryLength param key with which the history length will be stored.
+	KeyHistoryLength = []byte("HistoryLength")
+	// KeyAcceptedFeeDenoms represents the AcceptedFeeDenoms param key with which the accepted fee denoms will be stored.
+	KeyAcceptedFeeDenoms = []byte("AcceptedFeeDenoms")
+	// KeyGasTrackingMode represents the GasTrackingMode param key with which the gas tracking mode will be stored.
+	KeyGasTrackingMode = []byte("GasTrackingMode")
+	// KeyTipBurnFraction represents the TipBurnFraction param key with which the tip burn fraction will be stored.
+	KeyTipBurnFraction = []byte("TipBurnFraction")
+)
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // of model's parameters.
 func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyModel, &m.Model, validateModelParams),
+		paramtypes.NewParamSetPair(KeyHistoryLength, &m.HistoryLength, validateHistoryLength),
+		paramtypes.NewParamSetPair(KeyAcceptedFeeDenoms, &m.AcceptedFeeDenoms, validateAcceptedFeeDenoms),
+		paramtypes.NewParamSetPair(KeyGasTrackingMode, &m.GasTrackingMode, validateGasTrackingMode),
+		paramtypes.NewParamSetPair(KeyTipBurnFraction, &m.TipBurnFraction, validateTipBurnFraction),
 	}
 }
 
@@ -29,12 +50,28 @@ func DefaultParams() Params {
 			ShortEmaBlockLength:     50,
 			LongEmaBlockLength:      1000,
 		},
+		HistoryLength:     1000,
+		AcceptedFeeDenoms: []FeeDenom{},
+		GasTrackingMode:   GasTrackingMode_gas_limit,
+		TipBurnFraction:   sdk.ZeroDec(),
 	}
 }
 
 // ValidateBasic validates parameters of the model.
 func (m Params) ValidateBasic() error {
-	return validateModelParams(m.Model)
+	if err := validateModelParams(m.Model); err != nil {
+		return err
+	}
+	if err := validateHistoryLength(m.HistoryLength); err != nil {
+		return err
+	}
+	if err := validateAcceptedFeeDenoms(m.AcceptedFeeDenoms); err != nil {
+		return err
+	}
+	if err := validateGasTrackingMode(m.GasTrackingMode); err != nil {
+		return err
+	}
+	return validateTipBurnFraction(m.TipBurnFraction)
 }
 
 // ValidateBasic validates parameters of the model params.
@@ -93,3 +130,87 @@ func validateModelParams(i interface{}) error {
 
 	return nil
 }
+
+func validateHistoryLength(i interface{}) error {
+	historyLength, ok := i.(uint32)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if historyLength > MaxHistoryLength {
+		return errors.Errorf("history length must not be greater than %d", MaxHistoryLength)
+	}
+
+	return nil
+}
+
+func validateAcceptedFeeDenoms(i interface{}) error {
+	feeDenoms, ok := i.([]FeeDenom)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]struct{}, len(feeDenoms))
+	for _, feeDenom := range feeDenoms {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return errors.Wrapf(err, "invalid accepted fee denom %q", feeDenom.Denom)
+		}
+		if _, ok := denoms[feeDenom.Denom]; ok {
+			return errors.Errorf("duplicated accepted fee denom %q", feeDenom.Denom)
+		}
+		denoms[feeDenom.Denom] = struct{}{}
+
+		if feeDenom.ExchangeRate.IsNil() {
+			return errors.Errorf("exchange rate of the accepted fee denom %q is not set", feeDenom.Denom)
+		}
+		if !feeDenom.ExchangeRate.IsPositive() {
+			return errors.Errorf("exchange rate of the accepted fee denom %q must be positive", feeDenom.Denom)
+		}
+	}
+
+	return nil
+}
+
+// ComputeMinGasPrices computes the minimum gas prices in the native denom and all the accepted fee denoms.
+func ComputeMinGasPrices(minGasPrice sdk.DecCoin, feeDenoms []FeeDenom) sdk.DecCoins {
+	minGasPrices := sdk.DecCoins{minGasPrice}
+	for _, feeDenom := range feeDenoms {
+		if feeDenom.Denom == minGasPrice.Denom {
+			continue
+		}
+		minGasPrices = append(minGasPrices, sdk.NewDecCoinFromDec(feeDenom.Denom, minGasPrice.Amount.Mul(feeDenom.ExchangeRate)))
+	}
+	return minGasPrices.Sort()
+}
+
+func validateGasTrackingMode(i interface{}) error {
+	gasTrackingMode, ok := i.(GasTrackingMode)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, exists := GasTrackingMode_name[int32(gasTrackingMode)]; !exists {
+		return errors.Errorf("unknown gas tracking mode %d", gasTrackingMode)
+	}
+
+	return nil
+}
+
+func validateTipBurnFraction(i interface{}) error {
+	tipBurnFraction, ok := i.(sdk.Dec)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if tipBurnFraction.IsNil() {
+		return errors.New("tip burn fraction is not set")
+	}
+	if tipBurnFraction.IsNegative() {
+		return errors.New("tip burn fraction must not be negative")
+	}
+	if tipBurnFraction.GT(sdk.OneDec()) {
+		return errors.New("tip burn fraction must not be greater than 1")
+	}
+
+	return nil
+}
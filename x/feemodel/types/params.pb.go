@@ -25,6 +25,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// GasTrackingMode defines which gas of the block transactions is used as the input of the fee model.
+type GasTrackingMode int32
+
+const (
+	// gas_limit tracks the gas limits declared by the transactions.
+	GasTrackingMode_gas_limit GasTrackingMode = 0
+	// gas_used tracks the gas really consumed by the transactions.
+	GasTrackingMode_gas_used GasTrackingMode = 1
+)
+
+var GasTrackingMode_name = map[int32]string{
+	0: "gas_limit",
+	1: "gas_used",
+}
+
+var GasTrackingMode_value = map[string]int32{
+	"gas_limit": 0,
+	"gas_used":  1,
+}
+
+func (x GasTrackingMode) String() string {
+	return proto.EnumName(GasTrackingMode_name, int32(x))
+}
+
+func (GasTrackingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3500559e6fedefd6, []int{0}
+}
+
 // ModelParams define fee model params.
 // There are four regions on the fee model curve
 // - between 0 and "long average block gas" where gas price goes down exponentially from InitialGasPrice to gas price with maximum discount (InitialGasPrice * (1 - MaxDiscount))
@@ -111,6 +139,14 @@ func (m *ModelParams) GetLongEmaBlockLength() uint32 {
 type Params struct {
 	// model is a fee model params.
 	Model ModelParams `protobuf:"bytes,1,opt,name=model,proto3" json:"model" yaml:"model"`
+	// history_length defines the number of the latest blocks for which the gas price records are kept in the store. Older records are pruned. Zero disables the history.
+	HistoryLength uint32 `protobuf:"varint,2,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty" yaml:"history_length"`
+	// accepted_fee_denoms is the list of denoms, other than the native one, in which the fees might be paid.
+	AcceptedFeeDenoms []FeeDenom `protobuf:"bytes,3,rep,name=accepted_fee_denoms,json=acceptedFeeDenoms,proto3" json:"accepted_fee_denoms" yaml:"accepted_fee_denoms"`
+	// gas_tracking_mode defines which gas of the block transactions is used as the input of the fee model.
+	GasTrackingMode GasTrackingMode `protobuf:"varint,4,opt,name=gas_tracking_mode,json=gasTrackingMode,proto3,enum=coreum.feemodel.v1.GasTrackingMode" json:"gas_tracking_mode,omitempty" yaml:"gas_tracking_mode"`
+	// tip_burn_fraction is the fraction of the tip (the fee offered above the required one) which is burnt. It applies to the fees paid in the native denom only.
+	TipBurnFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=tip_burn_fraction,json=tipBurnFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tip_burn_fraction" yaml:"tip_burn_fraction"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -153,48 +189,133 @@ func (m *Params) GetModel() ModelParams {
 	return ModelParams{}
 }
 
+func (m *Params) GetHistoryLength() uint32 {
+	if m != nil {
+		return m.HistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetAcceptedFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.AcceptedFeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetGasTrackingMode() GasTrackingMode {
+	if m != nil {
+		return m.GasTrackingMode
+	}
+	return GasTrackingMode_gas_limit
+}
+
+// FeeDenom defines the denom accepted to pay the fees and its exchange rate to the native denom.
+type FeeDenom struct {
+	// denom is the denom accepted to pay the fees.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// exchange_rate is the amount of the denom required in place of one unit of the native denom. The minimum gas price in the denom is computed as: MinGasPrice * ExchangeRate.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3500559e6fedefd6, []int{2}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("coreum.feemodel.v1.GasTrackingMode", GasTrackingMode_name, GasTrackingMode_value)
 	proto.RegisterType((*ModelParams)(nil), "coreum.feemodel.v1.ModelParams")
 	proto.RegisterType((*Params)(nil), "coreum.feemodel.v1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "coreum.feemodel.v1.FeeDenom")
 }
 
 func init() { proto.RegisterFile("coreum/feemodel/v1/params.proto", fileDescriptor_3500559e6fedefd6) }
 
 var fileDescriptor_3500559e6fedefd6 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc7, 0x9b, 0xdf, 0x7e, 0x2d, 0xc2, 0xdd, 0x84, 0xf0, 0x3a, 0xc8, 0x26, 0x88, 0x8b, 0x0f,
-	0xa8, 0x17, 0x12, 0x8d, 0xdd, 0x10, 0xa7, 0xb0, 0x3f, 0x12, 0x50, 0x69, 0x64, 0x82, 0x03, 0x97,
-	0xc8, 0x4d, 0xbd, 0x34, 0x5a, 0x1c, 0x57, 0xb1, 0x5b, 0x75, 0x6f, 0x81, 0x03, 0xe2, 0x65, 0xed,
-	0xb8, 0x23, 0xe2, 0x10, 0xa1, 0xf6, 0x1d, 0xe4, 0xc4, 0x11, 0xd9, 0x4e, 0x97, 0xa1, 0x6e, 0x87,
-	0x9e, 0x92, 0xe7, 0x79, 0xbe, 0xfe, 0x7c, 0x6d, 0x3f, 0x7e, 0x00, 0x8a, 0x78, 0x4e, 0x27, 0xcc,
-	0x3b, 0xa7, 0x94, 0xf1, 0x21, 0x4d, 0xbd, 0xe9, 0xbe, 0x37, 0x26, 0x39, 0x61, 0xc2, 0x1d, 0xe7,
-	0x5c, 0x72, 0x08, 0x8d, 0xc0, 0x5d, 0x0a, 0xdc, 0xe9, 0xfe, 0xde, 0x6e, 0xc4, 0x05, 0xe3, 0x22,
-	0xd4, 0x0a, 0xcf, 0x04, 0x46, 0xbe, 0xd7, 0x89, 0x79, 0xcc, 0x4d, 0x5e, 0xfd, 0x99, 0x2c, 0xfe,
-	0xd3, 0x04, 0xed, 0xbe, 0x5a, 0x7d, 0xaa, 0xd1, 0x70, 0x0a, 0x1e, 0x27, 0x59, 0x22, 0x13, 0x92,
-	0x86, 0x31, 0x51, 0x9c, 0x24, 0xa2, 0xb6, 0xd5, 0xb5, 0x7a, 0x0f, 0xfd, 0xf7, 0x57, 0x05, 0x6a,
-	0xfc, 0x2a, 0xd0, 0xcb, 0x38, 0x91, 0xa3, 0xc9, 0xc0, 0x8d, 0x38, 0xab, 0x1c, 0xaa, 0xcf, 0x2b,
-	0x31, 0xbc, 0xf0, 0xe4, 0xe5, 0x98, 0x0a, 0xf7, 0x90, 0x46, 0x65, 0x81, 0xec, 0x4b, 0xc2, 0xd2,
-	0x37, 0x78, 0x05, 0x88, 0x83, 0x47, 0x55, 0xee, 0x84, 0x88, 0x53, 0x95, 0x81, 0xdf, 0x2c, 0x60,
-	0x33, 0x32, 0xab, 0x35, 0x21, 0x9b, 0xa4, 0x32, 0x19, 0xa7, 0x09, 0xcd, 0xed, 0xff, 0xb4, 0xff,
-	0xa7, 0xb5, 0xfd, 0x91, 0xf1, 0xbf, 0x8f, 0x8b, 0x83, 0x1d, 0x46, 0x66, 0xcb, 0x2d, 0xf4, 0x6f,
-	0xf2, 0x70, 0x04, 0x36, 0xd5, 0x9a, 0x61, 0x22, 0x22, 0x3e, 0xc9, 0xa4, 0xbd, 0xa1, 0xfd, 0x8f,
-	0xd6, 0xf6, 0xdf, 0xae, 0xfd, 0x97, 0x2c, 0x1c, 0xb4, 0x19, 0x99, 0x1d, 0x56, 0x11, 0xfc, 0x6e,
-	0x81, 0x5d, 0x2a, 0x22, 0x92, 0x12, 0x99, 0xf0, 0x2c, 0x14, 0x92, 0xe4, 0x32, 0x3c, 0xcf, 0x49,
-	0xa4, 0x42, 0xfb, 0x7f, 0xed, 0x1b, 0xac, 0xed, 0xdb, 0x35, 0xbe, 0xf7, 0x82, 0x71, 0xf0, 0xb4,
-	0xae, 0x9d, 0xa9, 0xd2, 0x71, 0x55, 0x81, 0x6f, 0xc1, 0x96, 0xda, 0xee, 0x20, 0xe5, 0xd1, 0x85,
-	0xba, 0x34, 0xbb, 0xd9, 0xb5, 0x7a, 0x1b, 0xbe, 0x5d, 0x16, 0xa8, 0x53, 0x9f, 0xe6, 0xa6, 0x6c,
-	0x8e, 0xe3, 0xab, 0xf0, 0x84, 0x08, 0xf8, 0x05, 0x3c, 0x11, 0x23, 0x9e, 0xcb, 0x90, 0x32, 0x52,
-	0x89, 0x52, 0x9a, 0xc5, 0x72, 0x64, 0xb7, 0xba, 0x56, 0x6f, 0xcb, 0x7f, 0x51, 0x16, 0xe8, 0xb9,
-	0xc1, 0xdc, 0xad, 0xc3, 0xc1, 0xb6, 0x2e, 0x1c, 0x31, 0xa2, 0xa1, 0x1f, 0x75, 0x16, 0x9e, 0x81,
-	0x9d, 0x94, 0x67, 0xf1, 0x2a, 0xf6, 0x81, 0xc6, 0x76, 0xcb, 0x02, 0x3d, 0x33, 0xd8, 0x3b, 0x65,
-	0x38, 0x80, 0x2a, 0xff, 0x2f, 0x14, 0x7f, 0x06, 0xad, 0xea, 0xd1, 0x7f, 0x00, 0x4d, 0x3d, 0x41,
-	0xfa, 0xa1, 0xb7, 0x5f, 0x23, 0x77, 0x75, 0xb2, 0xdc, 0x5b, 0x43, 0xe2, 0x77, 0x54, 0x47, 0xca,
-	0x02, 0x6d, 0x56, 0x37, 0xa2, 0x4a, 0x38, 0x30, 0x0c, 0xbf, 0x7f, 0x35, 0x77, 0xac, 0xeb, 0xb9,
-	0x63, 0xfd, 0x9e, 0x3b, 0xd6, 0x8f, 0x85, 0xd3, 0xb8, 0x5e, 0x38, 0x8d, 0x9f, 0x0b, 0xa7, 0xf1,
-	0xf5, 0xe0, 0x56, 0x03, 0xdf, 0x69, 0x87, 0x63, 0x3e, 0xc9, 0x86, 0xba, 0x0b, 0x5e, 0x35, 0xed,
-	0xb3, 0x7a, 0xde, 0x75, 0x47, 0x07, 0x2d, 0x3d, 0xa7, 0x07, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x2c, 0xde, 0x9b, 0xa2, 0x0f, 0x04, 0x00, 0x00,
+	// 745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x4f, 0xe3, 0x46,
+	0x14, 0x8e, 0x49, 0x43, 0x61, 0x92, 0x10, 0x18, 0xa0, 0x35, 0x88, 0xc6, 0xe9, 0x54, 0x42, 0x51,
+	0xa5, 0x3a, 0x02, 0x6e, 0x55, 0x0f, 0x95, 0x0b, 0x41, 0x6a, 0x8b, 0x44, 0x4d, 0xd5, 0x43, 0x2f,
+	0xd6, 0xc4, 0x19, 0x1c, 0x2b, 0xb6, 0xc7, 0xf2, 0x8c, 0x51, 0xf8, 0x0b, 0x7b, 0x58, 0xed, 0x3f,
+	0xd8, 0xbf, 0xc3, 0x91, 0xe3, 0x6a, 0x0f, 0xd6, 0x0a, 0xfe, 0xc0, 0x2a, 0xa7, 0x3d, 0xae, 0x66,
+	0x3c, 0xc6, 0x64, 0x13, 0x0e, 0xd1, 0x9e, 0x92, 0xf7, 0xbd, 0x6f, 0xbe, 0xef, 0xcd, 0xf3, 0xbc,
+	0x19, 0x60, 0xb8, 0x34, 0x21, 0x69, 0xd8, 0xbb, 0x26, 0x24, 0xa4, 0x43, 0x12, 0xf4, 0x6e, 0x8e,
+	0x7a, 0x31, 0x4e, 0x70, 0xc8, 0xcc, 0x38, 0xa1, 0x9c, 0x42, 0x98, 0x13, 0xcc, 0x82, 0x60, 0xde,
+	0x1c, 0xed, 0xef, 0xb9, 0x94, 0x85, 0x94, 0x39, 0x92, 0xd1, 0xcb, 0x83, 0x9c, 0xbe, 0xbf, 0xe3,
+	0x51, 0x8f, 0xe6, 0xb8, 0xf8, 0x97, 0xa3, 0xe8, 0x53, 0x0d, 0xd4, 0x2f, 0xc4, 0xea, 0x4b, 0x29,
+	0x0d, 0x6f, 0xc0, 0x96, 0x1f, 0xf9, 0xdc, 0xc7, 0x81, 0xe3, 0x61, 0xa1, 0xe3, 0xbb, 0x44, 0xd7,
+	0x3a, 0x5a, 0x77, 0xdd, 0xfa, 0xf3, 0x2e, 0x33, 0x2a, 0xef, 0x33, 0xe3, 0xd0, 0xf3, 0xf9, 0x28,
+	0x1d, 0x98, 0x2e, 0x0d, 0x95, 0x83, 0xfa, 0xf9, 0x85, 0x0d, 0xc7, 0x3d, 0x7e, 0x1b, 0x13, 0x66,
+	0x9e, 0x12, 0x77, 0x9a, 0x19, 0xfa, 0x2d, 0x0e, 0x83, 0x5f, 0xd1, 0x9c, 0x20, 0xb2, 0x5b, 0x0a,
+	0x3b, 0xc7, 0xec, 0x52, 0x20, 0xf0, 0x95, 0x06, 0xf4, 0x10, 0x4f, 0x4a, 0x8e, 0x13, 0xa6, 0x01,
+	0xf7, 0xe3, 0xc0, 0x27, 0x89, 0xbe, 0x22, 0xfd, 0xff, 0x59, 0xda, 0xdf, 0xc8, 0xfd, 0x5f, 0xd2,
+	0x45, 0xf6, 0x6e, 0x88, 0x27, 0x45, 0x09, 0x17, 0x4f, 0x38, 0x1c, 0x81, 0x86, 0x58, 0x33, 0xf4,
+	0x99, 0x4b, 0xd3, 0x88, 0xeb, 0x55, 0xe9, 0x7f, 0xb6, 0xb4, 0xff, 0x76, 0xe9, 0x5f, 0x68, 0x21,
+	0xbb, 0x1e, 0xe2, 0xc9, 0xa9, 0x8a, 0xe0, 0x6b, 0x0d, 0xec, 0x11, 0xe6, 0xe2, 0x00, 0x73, 0x9f,
+	0x46, 0x0e, 0xe3, 0x38, 0xe1, 0xce, 0x75, 0x82, 0x5d, 0x11, 0xea, 0xdf, 0x48, 0x5f, 0x7b, 0x69,
+	0xdf, 0x4e, 0xee, 0xfb, 0xa2, 0x30, 0xb2, 0xbf, 0x2f, 0x73, 0x57, 0x22, 0xd5, 0x57, 0x19, 0xf8,
+	0x1b, 0x68, 0x8a, 0x72, 0x07, 0x01, 0x75, 0xc7, 0xa2, 0x69, 0x7a, 0xad, 0xa3, 0x75, 0xab, 0x96,
+	0x3e, 0xcd, 0x8c, 0x9d, 0x72, 0x37, 0x4f, 0xe9, 0x7c, 0x3b, 0x96, 0x08, 0xcf, 0x31, 0x83, 0xff,
+	0x81, 0xef, 0xd8, 0x88, 0x26, 0xdc, 0x21, 0x21, 0x56, 0xa4, 0x80, 0x44, 0x1e, 0x1f, 0xe9, 0xab,
+	0x1d, 0xad, 0xdb, 0xb4, 0x7e, 0x9c, 0x66, 0xc6, 0x0f, 0xb9, 0xcc, 0x62, 0x1e, 0xb2, 0xb7, 0x65,
+	0xe2, 0x2c, 0xc4, 0x52, 0xf4, 0x6f, 0x89, 0xc2, 0x2b, 0xb0, 0x1b, 0xd0, 0xc8, 0x9b, 0x97, 0xfd,
+	0x56, 0xca, 0x76, 0xa6, 0x99, 0x71, 0x90, 0xcb, 0x2e, 0xa4, 0x21, 0x1b, 0x0a, 0x7c, 0x56, 0x14,
+	0x7d, 0xac, 0x82, 0x55, 0x75, 0xea, 0xff, 0x02, 0x35, 0x39, 0x42, 0xf2, 0xa4, 0xd7, 0x8f, 0x0d,
+	0x73, 0x7e, 0xb4, 0xcc, 0x67, 0x53, 0x62, 0xed, 0x88, 0x4f, 0x32, 0xcd, 0x8c, 0x86, 0x6a, 0x89,
+	0x48, 0x21, 0x3b, 0xd7, 0x80, 0xbf, 0x83, 0x8d, 0x91, 0xcf, 0x38, 0x4d, 0x6e, 0x8b, 0x2a, 0x57,
+	0x64, 0x95, 0x7b, 0xd3, 0xcc, 0xd8, 0xcd, 0x17, 0xcc, 0xe6, 0x91, 0xdd, 0x54, 0x80, 0xda, 0x6e,
+	0x0c, 0xb6, 0xb1, 0xeb, 0x92, 0x98, 0x93, 0xa1, 0x73, 0x4d, 0x88, 0x33, 0x24, 0x11, 0x0d, 0x99,
+	0x5e, 0xed, 0x54, 0xbb, 0xf5, 0xe3, 0x83, 0x45, 0xc5, 0xf5, 0x09, 0x39, 0x15, 0x24, 0x0b, 0xa9,
+	0xca, 0xf6, 0x73, 0xa3, 0x05, 0x32, 0xc8, 0xde, 0x2a, 0xd0, 0x62, 0x15, 0x83, 0x21, 0xd8, 0x12,
+	0x13, 0xc2, 0x13, 0xec, 0x8e, 0xfd, 0xc8, 0x73, 0x84, 0xb0, 0x3c, 0x7e, 0x1b, 0xc7, 0x3f, 0x2d,
+	0xf2, 0x3b, 0xc7, 0xec, 0x5f, 0xc5, 0x15, 0x7d, 0xb1, 0x0e, 0xca, 0x69, 0x9f, 0xd3, 0x41, 0x76,
+	0xcb, 0x9b, 0xa5, 0x8b, 0x5b, 0x86, 0xfb, 0xb1, 0x33, 0x48, 0x93, 0xa8, 0x3c, 0xed, 0xb5, 0xaf,
+	0xbb, 0x65, 0xe6, 0x04, 0x91, 0xdd, 0xe2, 0x7e, 0x6c, 0xa5, 0x49, 0x54, 0x9c, 0x6e, 0xf4, 0x56,
+	0x03, 0x6b, 0xc5, 0xa6, 0xe1, 0x21, 0xa8, 0xc9, 0x8e, 0xa8, 0xeb, 0x6d, 0xb3, 0xfc, 0x9e, 0x12,
+	0x46, 0x76, 0x9e, 0x86, 0x63, 0xd0, 0x24, 0x13, 0x77, 0x84, 0x23, 0x8f, 0x38, 0x09, 0xe6, 0x44,
+	0x5d, 0x47, 0xfd, 0xa5, 0x0b, 0x55, 0x03, 0x34, 0x23, 0x86, 0xec, 0x46, 0x11, 0xdb, 0x98, 0x93,
+	0x9f, 0x4d, 0xd0, 0xfa, 0xa2, 0xb7, 0xb0, 0x09, 0xd6, 0x45, 0x4f, 0x03, 0x3f, 0xf4, 0xf9, 0x66,
+	0x05, 0x36, 0xc0, 0x9a, 0x08, 0x53, 0x46, 0x86, 0x9b, 0x9a, 0x75, 0x71, 0xf7, 0xd0, 0xd6, 0xee,
+	0x1f, 0xda, 0xda, 0x87, 0x87, 0xb6, 0xf6, 0xe6, 0xb1, 0x5d, 0xb9, 0x7f, 0x6c, 0x57, 0xde, 0x3d,
+	0xb6, 0x2b, 0xff, 0x9f, 0x3c, 0xab, 0xeb, 0x0f, 0xf9, 0x05, 0xfb, 0x34, 0x8d, 0x86, 0x72, 0xe6,
+	0x7b, 0xea, 0x6d, 0x99, 0x94, 0xaf, 0x8b, 0x2c, 0x74, 0xb0, 0x2a, 0x5f, 0x85, 0x93, 0xcf, 0x03,
+	0x00, 0x05, 0xb6, 0x6d, 0xc2, 0x7d, 0x06, 0x00, 0x00,
 }
 
 func (m *ModelParams) Marshal() (dAtA []byte, err error) {
@@ -295,6 +416,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TipBurnFraction.Size()
+		i -= size
+		if _, err := m.TipBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.GasTrackingMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasTrackingMode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for iNdEx := len(m.AcceptedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.HistoryLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoryLength))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Model.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -308,6 +463,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -353,6 +548,35 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.Model.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.HistoryLength != 0 {
+		n += 1 + sovParams(uint64(m.HistoryLength))
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for _, e := range m.AcceptedFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.GasTrackingMode != 0 {
+		n += 1 + sovParams(uint64(m.GasTrackingMode))
+	}
+	l = m.TipBurnFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -667,6 +891,228 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLength", wireType)
+			}
+			m.HistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedFeeDenoms = append(m.AcceptedFeeDenoms, FeeDenom{})
+			if err := m.AcceptedFeeDenoms[len(m.AcceptedFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTrackingMode", wireType)
+			}
+			m.GasTrackingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasTrackingMode |= GasTrackingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TipBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -17,6 +17,8 @@ var params = Params{
 		ShortEmaBlockLength:     10,
 		LongEmaBlockLength:      1000,
 	},
+	HistoryLength:   100,
+	TipBurnFraction: sdk.MustNewDecFromStr("0.5"),
 }
 
 func TestParamsValidation(t *testing.T) {
@@ -53,4 +55,89 @@ func TestParamsValidation(t *testing.T) {
 	testParams = params
 	testParams.Model.EscalationStartFraction = sdk.OneDec()
 	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.HistoryLength = 0
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.HistoryLength = MaxHistoryLength
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.HistoryLength = MaxHistoryLength + 1
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.AcceptedFeeDenoms = []FeeDenom{
+		{Denom: "denom1", ExchangeRate: sdk.MustNewDecFromStr("0.5")},
+		{Denom: "denom2", ExchangeRate: sdk.NewDec(2)},
+	}
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.AcceptedFeeDenoms = []FeeDenom{
+		{Denom: "1", ExchangeRate: sdk.OneDec()},
+	}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.AcceptedFeeDenoms = []FeeDenom{
+		{Denom: "denom1", ExchangeRate: sdk.OneDec()},
+		{Denom: "denom1", ExchangeRate: sdk.NewDec(2)},
+	}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.AcceptedFeeDenoms = []FeeDenom{
+		{Denom: "denom1"},
+	}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.AcceptedFeeDenoms = []FeeDenom{
+		{Denom: "denom1", ExchangeRate: sdk.ZeroDec()},
+	}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.GasTrackingMode = GasTrackingMode_gas_used
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.GasTrackingMode = 2
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.TipBurnFraction = sdk.Dec{}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.TipBurnFraction = sdk.ZeroDec()
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.TipBurnFraction = sdk.OneDec()
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.TipBurnFraction = sdk.MustNewDecFromStr("-0.1")
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.TipBurnFraction = sdk.MustNewDecFromStr("1.1")
+	assert.Error(t, testParams.ValidateBasic())
+}
+
+func TestComputeMinGasPrices(t *testing.T) {
+	minGasPrices := ComputeMinGasPrices(sdk.NewDecCoin("native", sdk.NewInt(10)), []FeeDenom{
+		{Denom: "denom2", ExchangeRate: sdk.MustNewDecFromStr("0.5")},
+		{Denom: "denom1", ExchangeRate: sdk.NewDec(3)},
+		{Denom: "native", ExchangeRate: sdk.NewDec(5)},
+	})
+	assert.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoin("denom1", sdk.NewInt(30)),
+		sdk.NewDecCoin("denom2", sdk.NewInt(5)),
+		sdk.NewDecCoin("native", sdk.NewInt(10)),
+	}, minGasPrices)
 }
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ProposalTypeUpdateParams defines the type of the proposal updating the feemodel params.
+const ProposalTypeUpdateParams = "UpdateFeeModelParams"
+
+var _ govtypes.Content = &UpdateParamsProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
+}
+
+// NewUpdateParamsProposal returns a new instance of the UpdateParamsProposal.
+func NewUpdateParamsProposal(title, description string, params Params) *UpdateParamsProposal {
+	return &UpdateParamsProposal{
+		Title:       title,
+		Description: description,
+		Params:      params,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p *UpdateParamsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *UpdateParamsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (p *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (p *UpdateParamsProposal) ProposalType() string { return ProposalTypeUpdateParams }
+
+// ValidateBasic validates the proposal.
+func (p *UpdateParamsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := p.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// String returns the human-readable representation of the proposal.
+func (p UpdateParamsProposal) String() string {
+	return fmt.Sprintf(`Update Fee Model Params Proposal:
+  Title:       %s
+  Description: %s
+  Params:      %s
+`, p.Title, p.Description, p.Params.String())
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateParamsProposal_ValidateBasic(t *testing.T) {
+	invalidParams := params
+	invalidParams.Model.EscalationStartFraction = sdk.OneDec()
+
+	testCases := []struct {
+		name          string
+		proposal      *UpdateParamsProposal
+		expectedError error
+	}{
+		{
+			name:     "valid proposal",
+			proposal: NewUpdateParamsProposal("Update params", "-", params),
+		},
+		{
+			name:          "empty title",
+			proposal:      NewUpdateParamsProposal("", "-", params),
+			expectedError: govtypes.ErrInvalidProposalContent,
+		},
+		{
+			name:          "invalid params",
+			proposal:      NewUpdateParamsProposal("Update params", "-", invalidParams),
+			expectedError: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
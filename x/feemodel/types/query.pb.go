@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -71,6 +73,8 @@ var xxx_messageInfo_QueryMinGasPriceRequest proto.InternalMessageInfo
 type QueryMinGasPriceResponse struct {
 	// min_gas_price is the current minimum gas price required by the network.
 	MinGasPrice types.DecCoin `protobuf:"bytes,1,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+	// min_gas_prices are the current minimum gas prices required by the network in all the accepted fee denoms, including the native one.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices"`
 }
 
 func (m *QueryMinGasPriceResponse) Reset()         { *m = QueryMinGasPriceResponse{} }
@@ -113,6 +117,13 @@ func (m *QueryMinGasPriceResponse) GetMinGasPrice() types.DecCoin {
 	return types.DecCoin{}
 }
 
+func (m *QueryMinGasPriceResponse) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
 // QueryParamsRequest defines the request type for querying x/feemodel parameters.
 type QueryParamsRequest struct {
 }
@@ -195,42 +206,364 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryRecentGasPricesRequest defines the request type for querying the gas price records of the recent blocks.
+type QueryRecentGasPricesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecentGasPricesRequest) Reset()         { *m = QueryRecentGasPricesRequest{} }
+func (m *QueryRecentGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentGasPricesRequest) ProtoMessage()    {}
+func (*QueryRecentGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{4}
+}
+func (m *QueryRecentGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecentGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecentGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentGasPricesRequest.Merge(m, src)
+}
+func (m *QueryRecentGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecentGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecentGasPricesRequest proto.InternalMessageInfo
+
+func (m *QueryRecentGasPricesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRecentGasPricesResponse defines the response type for querying the gas price records of the recent blocks.
+type QueryRecentGasPricesResponse struct {
+	// records are the gas price records ordered by the block height.
+	Records []GasPriceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecentGasPricesResponse) Reset()         { *m = QueryRecentGasPricesResponse{} }
+func (m *QueryRecentGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentGasPricesResponse) ProtoMessage()    {}
+func (*QueryRecentGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{5}
+}
+func (m *QueryRecentGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecentGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecentGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentGasPricesResponse.Merge(m, src)
+}
+func (m *QueryRecentGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecentGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecentGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryRecentGasPricesResponse) GetRecords() []GasPriceRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryRecentGasPricesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryForecastMinGasPriceRequest is the request type for the Query/ForecastMinGasPrice RPC method.
+type QueryForecastMinGasPriceRequest struct {
+	// after_blocks is the number of the next blocks the forecast is computed for.
+	AfterBlocks uint32 `protobuf:"varint,1,opt,name=after_blocks,json=afterBlocks,proto3" json:"after_blocks,omitempty"`
+	// block_gas is the gas assumed to be used by each of the next blocks. If it is not set, max block gas is assumed.
+	BlockGas int64 `protobuf:"varint,2,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+}
+
+func (m *QueryForecastMinGasPriceRequest) Reset()         { *m = QueryForecastMinGasPriceRequest{} }
+func (m *QueryForecastMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForecastMinGasPriceRequest) ProtoMessage()    {}
+func (*QueryForecastMinGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{6}
+}
+func (m *QueryForecastMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForecastMinGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForecastMinGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForecastMinGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForecastMinGasPriceRequest.Merge(m, src)
+}
+func (m *QueryForecastMinGasPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForecastMinGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForecastMinGasPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForecastMinGasPriceRequest proto.InternalMessageInfo
+
+func (m *QueryForecastMinGasPriceRequest) GetAfterBlocks() uint32 {
+	if m != nil {
+		return m.AfterBlocks
+	}
+	return 0
+}
+
+func (m *QueryForecastMinGasPriceRequest) GetBlockGas() int64 {
+	if m != nil {
+		return m.BlockGas
+	}
+	return 0
+}
+
+// QueryForecastMinGasPriceResponse is the response type for the Query/ForecastMinGasPrice RPC method.
+type QueryForecastMinGasPriceResponse struct {
+	// min_gas_price is the highest minimum gas price required by the network within the given number of blocks.
+	MinGasPrice types.DecCoin `protobuf:"bytes,1,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+}
+
+func (m *QueryForecastMinGasPriceResponse) Reset()         { *m = QueryForecastMinGasPriceResponse{} }
+func (m *QueryForecastMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForecastMinGasPriceResponse) ProtoMessage()    {}
+func (*QueryForecastMinGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{7}
+}
+func (m *QueryForecastMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForecastMinGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForecastMinGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForecastMinGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForecastMinGasPriceResponse.Merge(m, src)
+}
+func (m *QueryForecastMinGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForecastMinGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForecastMinGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForecastMinGasPriceResponse proto.InternalMessageInfo
+
+func (m *QueryForecastMinGasPriceResponse) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+// QuerySimulateParamsRequest is the request type for the Query/SimulateParams RPC method.
+type QuerySimulateParamsRequest struct {
+	// model is the proposed fee model params.
+	Model ModelParams `protobuf:"bytes,1,opt,name=model,proto3" json:"model"`
+}
+
+func (m *QuerySimulateParamsRequest) Reset()         { *m = QuerySimulateParamsRequest{} }
+func (m *QuerySimulateParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateParamsRequest) ProtoMessage()    {}
+func (*QuerySimulateParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{8}
+}
+func (m *QuerySimulateParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateParamsRequest.Merge(m, src)
+}
+func (m *QuerySimulateParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateParamsRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateParamsRequest) GetModel() ModelParams {
+	if m != nil {
+		return m.Model
+	}
+	return ModelParams{}
+}
+
+// QuerySimulateParamsResponse is the response type for the Query/SimulateParams RPC method.
+type QuerySimulateParamsResponse struct {
+	// records are the gas price records which would have been produced by the proposed model params, ordered by the block height.
+	Records []GasPriceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QuerySimulateParamsResponse) Reset()         { *m = QuerySimulateParamsResponse{} }
+func (m *QuerySimulateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateParamsResponse) ProtoMessage()    {}
+func (*QuerySimulateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{9}
+}
+func (m *QuerySimulateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateParamsResponse.Merge(m, src)
+}
+func (m *QuerySimulateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateParamsResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateParamsResponse) GetRecords() []GasPriceRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryMinGasPriceRequest)(nil), "coreum.feemodel.v1.QueryMinGasPriceRequest")
 	proto.RegisterType((*QueryMinGasPriceResponse)(nil), "coreum.feemodel.v1.QueryMinGasPriceResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.feemodel.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.feemodel.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRecentGasPricesRequest)(nil), "coreum.feemodel.v1.QueryRecentGasPricesRequest")
+	proto.RegisterType((*QueryRecentGasPricesResponse)(nil), "coreum.feemodel.v1.QueryRecentGasPricesResponse")
+	proto.RegisterType((*QueryForecastMinGasPriceRequest)(nil), "coreum.feemodel.v1.QueryForecastMinGasPriceRequest")
+	proto.RegisterType((*QueryForecastMinGasPriceResponse)(nil), "coreum.feemodel.v1.QueryForecastMinGasPriceResponse")
+	proto.RegisterType((*QuerySimulateParamsRequest)(nil), "coreum.feemodel.v1.QuerySimulateParamsRequest")
+	proto.RegisterType((*QuerySimulateParamsResponse)(nil), "coreum.feemodel.v1.QuerySimulateParamsResponse")
 }
 
 func init() { proto.RegisterFile("coreum/feemodel/v1/query.proto", fileDescriptor_d2036651e57006ae) }
 
 var fileDescriptor_d2036651e57006ae = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xce, 0xd2, 0x40,
-	0x14, 0xc5, 0x5b, 0xa2, 0x2c, 0x86, 0xb8, 0x19, 0x49, 0xc4, 0x86, 0x0c, 0x5a, 0x13, 0xff, 0x44,
-	0x33, 0x93, 0xc2, 0xc6, 0x35, 0x18, 0x5c, 0x11, 0x91, 0xa5, 0x1b, 0x32, 0x2d, 0x63, 0x9d, 0x84,
-	0xce, 0x2d, 0x9d, 0x96, 0xc8, 0xc2, 0x8d, 0x4f, 0x60, 0xc2, 0xd6, 0x07, 0x62, 0x49, 0xe2, 0xc6,
-	0x95, 0x31, 0xe0, 0x83, 0x98, 0x4e, 0x87, 0xf0, 0x11, 0x20, 0xdf, 0xb7, 0x6b, 0x7a, 0xee, 0x3d,
-	0xe7, 0xd7, 0xd3, 0x8b, 0x48, 0x04, 0x99, 0x28, 0x12, 0xf6, 0x59, 0x88, 0x04, 0x66, 0x62, 0xce,
-	0x96, 0x01, 0x5b, 0x14, 0x22, 0x5b, 0xd1, 0x34, 0x83, 0x1c, 0x30, 0xae, 0x74, 0x7a, 0xd0, 0xe9,
-	0x32, 0xf0, 0x9a, 0x31, 0xc4, 0x60, 0x64, 0x56, 0x3e, 0x55, 0x93, 0x5e, 0x3b, 0x06, 0x88, 0xe7,
-	0x82, 0xf1, 0x54, 0x32, 0xae, 0x14, 0xe4, 0x3c, 0x97, 0xa0, 0xb4, 0x55, 0x49, 0x04, 0x3a, 0x01,
-	0xcd, 0x42, 0xae, 0x05, 0x5b, 0x06, 0xa1, 0xc8, 0x79, 0xc0, 0x22, 0x90, 0xca, 0xea, 0x9d, 0x0b,
-	0x1c, 0x29, 0xcf, 0x78, 0x62, 0x0d, 0xfc, 0xc7, 0xe8, 0xd1, 0xc7, 0x92, 0x6b, 0x24, 0xd5, 0x7b,
-	0xae, 0xc7, 0x99, 0x8c, 0xc4, 0x44, 0x2c, 0x0a, 0xa1, 0x73, 0x3f, 0x44, 0xad, 0x73, 0x49, 0xa7,
-	0xa0, 0xb4, 0xc0, 0x43, 0xf4, 0x20, 0x91, 0x6a, 0x1a, 0x73, 0x3d, 0x4d, 0x4b, 0xa1, 0xe5, 0x3e,
-	0x71, 0x5f, 0x36, 0xba, 0x6d, 0x5a, 0xf1, 0xd0, 0x92, 0x87, 0x5a, 0x1e, 0xfa, 0x4e, 0x44, 0x03,
-	0x90, 0xaa, 0x7f, 0x6f, 0xf3, 0xa7, 0xe3, 0x4c, 0x1a, 0xc9, 0xd1, 0xcf, 0x6f, 0x22, 0x6c, 0x32,
-	0xc6, 0x86, 0xe9, 0x90, 0xfc, 0x01, 0x3d, 0x3c, 0x79, 0x6b, 0x43, 0xdf, 0xa2, 0x7a, 0xc5, 0x6e,
-	0xd3, 0x3c, 0x7a, 0xde, 0x22, 0xad, 0x76, 0x6c, 0x96, 0x9d, 0xef, 0xfe, 0xac, 0xa1, 0xfb, 0xc6,
-	0x11, 0xaf, 0x5d, 0xd4, 0xb8, 0xf1, 0x41, 0xf8, 0xf5, 0x25, 0x8f, 0x2b, 0x8d, 0x78, 0x6f, 0xee,
-	0x36, 0x5c, 0xe1, 0xfa, 0xaf, 0xbe, 0xff, 0xfa, 0xb7, 0xae, 0x3d, 0xc3, 0x4f, 0xd9, 0x85, 0x9f,
-	0x70, 0xd2, 0x1e, 0xfe, 0x86, 0xea, 0x15, 0x37, 0x7e, 0x7e, 0x35, 0xe2, 0xa4, 0x22, 0xef, 0xc5,
-	0xad, 0x73, 0x96, 0xc2, 0x37, 0x14, 0x6d, 0xec, 0xb1, 0xab, 0xa7, 0xd0, 0x1f, 0x6d, 0x76, 0xc4,
-	0xdd, 0xee, 0x88, 0xfb, 0x77, 0x47, 0xdc, 0x1f, 0x7b, 0xe2, 0x6c, 0xf7, 0xc4, 0xf9, 0xbd, 0x27,
-	0xce, 0xa7, 0x5e, 0x2c, 0xf3, 0x2f, 0x45, 0x48, 0x23, 0x48, 0xd8, 0xc0, 0xec, 0x0f, 0xa1, 0x50,
-	0x33, 0x73, 0x83, 0x07, 0xc3, 0xaf, 0x47, 0xcb, 0x7c, 0x95, 0x0a, 0x1d, 0xd6, 0xcd, 0x69, 0xf5,
-	0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x74, 0x76, 0xcd, 0xe0, 0x05, 0x03, 0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4d, 0x4f, 0xd4, 0x40,
+	0x18, 0xc7, 0xb7, 0x20, 0xab, 0xce, 0x02, 0x26, 0x03, 0x89, 0x58, 0x36, 0xdd, 0xa5, 0x04, 0x50,
+	0x5e, 0x3a, 0x2e, 0xeb, 0xc1, 0xc4, 0xdb, 0x62, 0x96, 0x13, 0x11, 0xeb, 0x49, 0x2f, 0x9b, 0xd9,
+	0xee, 0x50, 0x2a, 0xdb, 0x4e, 0xe9, 0x74, 0x51, 0x0e, 0x5e, 0xfc, 0x04, 0x26, 0xdc, 0x3c, 0x78,
+	0xf1, 0xa6, 0x57, 0x3f, 0x04, 0x47, 0x12, 0x2f, 0x26, 0x26, 0x6a, 0xc0, 0x0f, 0x62, 0x3a, 0x33,
+	0x75, 0x5b, 0x99, 0x06, 0x4c, 0x38, 0xed, 0x66, 0x9e, 0x97, 0xff, 0xef, 0x79, 0x99, 0x29, 0x30,
+	0x1c, 0x1a, 0x91, 0x81, 0x8f, 0x76, 0x08, 0xf1, 0x69, 0x8f, 0xf4, 0xd1, 0x41, 0x03, 0xed, 0x0f,
+	0x48, 0x74, 0x68, 0x85, 0x11, 0x8d, 0x29, 0x84, 0xc2, 0x6e, 0xa5, 0x76, 0xeb, 0xa0, 0xa1, 0x4f,
+	0xbb, 0xd4, 0xa5, 0xdc, 0x8c, 0x92, 0x7f, 0xc2, 0x53, 0xaf, 0xba, 0x94, 0xba, 0x7d, 0x82, 0x70,
+	0xe8, 0x21, 0x1c, 0x04, 0x34, 0xc6, 0xb1, 0x47, 0x03, 0x26, 0xad, 0xcb, 0x0e, 0x65, 0x3e, 0x65,
+	0xa8, 0x8b, 0x19, 0x11, 0x02, 0xe8, 0xa0, 0xd1, 0x25, 0x31, 0x6e, 0xa0, 0x10, 0xbb, 0x5e, 0xc0,
+	0x9d, 0xa5, 0xaf, 0x91, 0xf5, 0x4d, 0xbd, 0x1c, 0xea, 0xa5, 0xf6, 0xba, 0x82, 0x79, 0xd7, 0x63,
+	0x31, 0x4d, 0xa9, 0xf5, 0x9a, 0xc2, 0x23, 0xc4, 0x11, 0xf6, 0x25, 0x8e, 0x79, 0x07, 0xdc, 0x7e,
+	0x9a, 0x40, 0x6c, 0x79, 0xc1, 0x26, 0x66, 0xdb, 0x91, 0xe7, 0x10, 0x9b, 0xec, 0x0f, 0x08, 0x8b,
+	0xcd, 0xef, 0x1a, 0x98, 0x39, 0x6f, 0x63, 0x21, 0x0d, 0x18, 0x81, 0x6d, 0x30, 0xe1, 0x7b, 0x41,
+	0xc7, 0xc5, 0xac, 0x13, 0x26, 0x86, 0x19, 0xad, 0xae, 0xdd, 0xad, 0xac, 0x57, 0x2d, 0x81, 0x6c,
+	0x25, 0xc8, 0x96, 0x44, 0xb6, 0x1e, 0x13, 0x67, 0x83, 0x7a, 0x41, 0xeb, 0xda, 0xf1, 0x8f, 0x5a,
+	0xc9, 0xae, 0xf8, 0xc3, 0x7c, 0xf0, 0x15, 0x98, 0xcc, 0xe5, 0x61, 0x33, 0x23, 0xf5, 0xd1, 0x0b,
+	0x13, 0x35, 0x93, 0x44, 0x9f, 0x7e, 0xd6, 0x56, 0x5c, 0x2f, 0xde, 0x1d, 0x74, 0x2d, 0x87, 0xfa,
+	0x48, 0xf6, 0x4a, 0xfc, 0xac, 0xb1, 0xde, 0x1e, 0x8a, 0x0f, 0x43, 0xc2, 0xd2, 0x18, 0x66, 0x8f,
+	0x67, 0x74, 0x99, 0x39, 0x0d, 0x20, 0x2f, 0x6e, 0x9b, 0x77, 0x23, 0xad, 0xf9, 0x09, 0x98, 0xca,
+	0x9d, 0xca, 0x6a, 0x1f, 0x82, 0xb2, 0xe8, 0x9a, 0x2c, 0x53, 0xb7, 0xce, 0x6f, 0x83, 0x25, 0x62,
+	0x64, 0x91, 0xd2, 0xdf, 0x24, 0x60, 0x96, 0x27, 0xb4, 0x89, 0x43, 0x82, 0xf8, 0xaf, 0xbc, 0xd4,
+	0x83, 0x6d, 0x00, 0x86, 0x53, 0x97, 0xc9, 0x17, 0x73, 0xa5, 0x8b, 0x1d, 0x4c, 0x1b, 0xb0, 0x8d,
+	0xdd, 0x74, 0x3e, 0x76, 0x26, 0xd2, 0xfc, 0xac, 0x81, 0xaa, 0x5a, 0x47, 0x56, 0xd0, 0x02, 0xd7,
+	0x23, 0xe2, 0xd0, 0xa8, 0x97, 0x94, 0x90, 0x34, 0xd8, 0x54, 0x95, 0x30, 0x1c, 0x73, 0xe2, 0x2a,
+	0x4b, 0x49, 0x03, 0xe1, 0x66, 0x0e, 0x76, 0x84, 0xc3, 0x2e, 0x5d, 0x08, 0x2b, 0x00, 0x72, 0xb4,
+	0x18, 0xd4, 0x38, 0x6c, 0x9b, 0x46, 0xc4, 0xc1, 0x2c, 0x3e, 0xbf, 0x7c, 0x70, 0x0e, 0x8c, 0xe3,
+	0x9d, 0x98, 0x44, 0x9d, 0x6e, 0x9f, 0x3a, 0x7b, 0xa2, 0xef, 0x13, 0x76, 0x85, 0x9f, 0xb5, 0xf8,
+	0x11, 0x9c, 0x05, 0x37, 0xb9, 0x31, 0x59, 0x1e, 0x4e, 0x33, 0x6a, 0xdf, 0xe0, 0x07, 0x9b, 0x98,
+	0x99, 0x2f, 0x41, 0xbd, 0x58, 0xe2, 0x6a, 0x77, 0xd8, 0x7c, 0x0e, 0x74, 0xae, 0xf5, 0xcc, 0xf3,
+	0x07, 0x7d, 0x1c, 0x93, 0xdc, 0x4a, 0xc1, 0x47, 0x60, 0x8c, 0xf7, 0x57, 0x66, 0xaf, 0xa9, 0xfa,
+	0xbe, 0x95, 0xfc, 0xc9, 0xed, 0x8f, 0x88, 0x31, 0x31, 0x98, 0x55, 0xa6, 0xbe, 0xba, 0xa9, 0xae,
+	0xbf, 0x2f, 0x83, 0x31, 0xae, 0x01, 0x8f, 0x34, 0x50, 0xc9, 0xf4, 0x09, 0xae, 0xa8, 0x92, 0x15,
+	0xbc, 0x16, 0xfa, 0xea, 0xe5, 0x9c, 0x05, 0xb8, 0x79, 0xef, 0xed, 0xd7, 0xdf, 0x47, 0x23, 0xf3,
+	0x70, 0x0e, 0x29, 0x1e, 0xa8, 0xdc, 0x50, 0xe0, 0x1b, 0x50, 0x16, 0x55, 0xc3, 0xc5, 0x42, 0x89,
+	0x5c, 0xc7, 0xf5, 0xa5, 0x0b, 0xfd, 0x24, 0x85, 0xc9, 0x29, 0xaa, 0x50, 0x47, 0x85, 0xcf, 0x24,
+	0xfc, 0xa8, 0x81, 0x5b, 0xff, 0x5c, 0x2a, 0x88, 0x0a, 0x05, 0xd4, 0xd7, 0x5c, 0xbf, 0x7f, 0xf9,
+	0x00, 0x89, 0xb6, 0xc6, 0xd1, 0x96, 0xe0, 0x82, 0x0a, 0x2d, 0xe2, 0x41, 0x99, 0x47, 0x13, 0x7e,
+	0xd1, 0xc0, 0x94, 0x62, 0xd5, 0x61, 0xb3, 0x50, 0xb8, 0xf8, 0xee, 0xe9, 0x0f, 0xfe, 0x2f, 0x48,
+	0x12, 0xaf, 0x73, 0xe2, 0x55, 0xb8, 0xac, 0x22, 0xde, 0x91, 0x81, 0x9d, 0xfc, 0x6c, 0x3f, 0x68,
+	0x60, 0x32, 0xbf, 0xda, 0xd0, 0x2a, 0x14, 0x57, 0x5e, 0x2f, 0x1d, 0x5d, 0xda, 0x5f, 0x72, 0xae,
+	0x70, 0xce, 0x05, 0x38, 0xaf, 0xe2, 0x64, 0x32, 0xa6, 0x23, 0xa6, 0xdf, 0xda, 0x3a, 0x3e, 0x35,
+	0xb4, 0x93, 0x53, 0x43, 0xfb, 0x75, 0x6a, 0x68, 0xef, 0xce, 0x8c, 0xd2, 0xc9, 0x99, 0x51, 0xfa,
+	0x76, 0x66, 0x94, 0x5e, 0x34, 0x33, 0x9f, 0x9e, 0x0d, 0x9e, 0xa8, 0x4d, 0x07, 0x41, 0x8f, 0x3f,
+	0x70, 0x69, 0xe6, 0xd7, 0xc3, 0xdc, 0xfc, 0x5b, 0xd4, 0x2d, 0xf3, 0x8f, 0x6e, 0xf3, 0xcf, 0x00,
+	0xc4, 0x6f, 0xb7, 0xe5, 0x6d, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -249,6 +582,12 @@ type QueryClient interface {
 	MinGasPrice(ctx context.Context, in *QueryMinGasPriceRequest, opts ...grpc.CallOption) (*QueryMinGasPriceResponse, error)
 	// Params queries the parameters of x/feemodel module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RecentGasPrices queries the gas price records of the recent blocks kept by the network.
+	RecentGasPrices(ctx context.Context, in *QueryRecentGasPricesRequest, opts ...grpc.CallOption) (*QueryRecentGasPricesResponse, error)
+	// ForecastMinGasPrice queries the highest minimum gas price which might be required by the network within the given number of blocks.
+	ForecastMinGasPrice(ctx context.Context, in *QueryForecastMinGasPriceRequest, opts ...grpc.CallOption) (*QueryForecastMinGasPriceResponse, error)
+	// SimulateParams replays the stored gas price history through the proposed model params and returns the gas prices they would have produced.
+	SimulateParams(ctx context.Context, in *QuerySimulateParamsRequest, opts ...grpc.CallOption) (*QuerySimulateParamsResponse, error)
 }
 
 type queryClient struct {
@@ -277,12 +616,45 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RecentGasPrices(ctx context.Context, in *QueryRecentGasPricesRequest, opts ...grpc.CallOption) (*QueryRecentGasPricesResponse, error) {
+	out := new(QueryRecentGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/RecentGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForecastMinGasPrice(ctx context.Context, in *QueryForecastMinGasPriceRequest, opts ...grpc.CallOption) (*QueryForecastMinGasPriceResponse, error) {
+	out := new(QueryForecastMinGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/ForecastMinGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateParams(ctx context.Context, in *QuerySimulateParamsRequest, opts ...grpc.CallOption) (*QuerySimulateParamsResponse, error) {
+	out := new(QuerySimulateParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/SimulateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// MinGasPrice queries the current minimum gas price required by the network.
 	MinGasPrice(context.Context, *QueryMinGasPriceRequest) (*QueryMinGasPriceResponse, error)
 	// Params queries the parameters of x/feemodel module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RecentGasPrices queries the gas price records of the recent blocks kept by the network.
+	RecentGasPrices(context.Context, *QueryRecentGasPricesRequest) (*QueryRecentGasPricesResponse, error)
+	// ForecastMinGasPrice queries the highest minimum gas price which might be required by the network within the given number of blocks.
+	ForecastMinGasPrice(context.Context, *QueryForecastMinGasPriceRequest) (*QueryForecastMinGasPriceResponse, error)
+	// SimulateParams replays the stored gas price history through the proposed model params and returns the gas prices they would have produced.
+	SimulateParams(context.Context, *QuerySimulateParamsRequest) (*QuerySimulateParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -295,6 +667,15 @@ func (*UnimplementedQueryServer) MinGasPrice(ctx context.Context, req *QueryMinG
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RecentGasPrices(ctx context.Context, req *QueryRecentGasPricesRequest) (*QueryRecentGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecentGasPrices not implemented")
+}
+func (*UnimplementedQueryServer) ForecastMinGasPrice(ctx context.Context, req *QueryForecastMinGasPriceRequest) (*QueryForecastMinGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForecastMinGasPrice not implemented")
+}
+func (*UnimplementedQueryServer) SimulateParams(ctx context.Context, req *QuerySimulateParamsRequest) (*QuerySimulateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateParams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -336,6 +717,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RecentGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecentGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecentGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/RecentGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecentGasPrices(ctx, req.(*QueryRecentGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForecastMinGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForecastMinGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForecastMinGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/ForecastMinGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForecastMinGasPrice(ctx, req.(*QueryForecastMinGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/SimulateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateParams(ctx, req.(*QuerySimulateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "coreum.feemodel.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -348,6 +783,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RecentGasPrices",
+			Handler:    _Query_RecentGasPrices_Handler,
+		},
+		{
+			MethodName: "ForecastMinGasPrice",
+			Handler:    _Query_ForecastMinGasPrice_Handler,
+		},
+		{
+			MethodName: "SimulateParams",
+			Handler:    _Query_SimulateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/feemodel/v1/query.proto",
@@ -396,6 +843,20 @@ func (m *QueryMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -465,18 +926,238 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRecentGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryMinGasPriceRequest) Size() (n int) {
+
+func (m *QueryRecentGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForecastMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForecastMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForecastMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AfterBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForecastMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForecastMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForecastMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Model.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMinGasPriceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -493,6 +1174,12 @@ func (m *QueryMinGasPriceResponse) Size() (n int) {
 	_ = l
 	l = m.MinGasPrice.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -516,6 +1203,90 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRecentGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecentGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForecastMinGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AfterBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.AfterBlocks))
+	}
+	if m.BlockGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockGas))
+	}
+	return n
+}
+
+func (m *QueryForecastMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Model.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -634,6 +1405,40 @@ func (m *QueryMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -788,6 +1593,550 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRecentGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecentGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecentGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecentGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecentGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecentGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, GasPriceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForecastMinGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForecastMinGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForecastMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterBlocks", wireType)
+			}
+			m.AfterBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGas", wireType)
+			}
+			m.BlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForecastMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForecastMinGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForecastMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Model.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, GasPriceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,6 +28,8 @@ func TestExpectedRegisteredProposals(t *testing.T) {
 		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal":         {},
 		"/cosmwasm.wasm.v1.PinCodesProposal":                      {},
 		"/cosmwasm.wasm.v1.UnpinCodesProposal":                    {},
+		"/coreum.deterministicgas.v1.UpdateGasTableProposal":      {},
+		"/coreum.feemodel.v1.UpdateParamsProposal":                {},
 
 		// proposals without tests
 
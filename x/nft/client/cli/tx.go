@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/CoreumFoundation/coreum/x/nft"
@@ -26,6 +31,7 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdSendBatch(),
 	)
 
 	return nftTxCmd
@@ -59,3 +65,80 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCmdSendBatch returns send batch NFT command.
+func NewCmdSendBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-batch [file] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "transfer ownership of multiple nfts listed in the JSON or CSV file",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			If any of the nfts can't be sent, none of them is sent.
+			The JSON file must contain the list of the nfts:
+			[{"class_id": "<class-id>", "id": "<nft-id>", "receiver": "<receiver>"}]
+			The CSV file must contain a row for each nft (the header row is optional):
+			class_id,id,receiver
+			<class-id>,<nft-id>,<receiver>
+
+			$ %s tx %s send-batch <file> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			items, err := readSendBatchFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgSendBatch{
+				Sender: clientCtx.GetFromAddress().String(),
+				Items:  items,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func readSendBatchFile(path string) ([]*nft.SendBatchItem, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't read file %q", path)
+	}
+
+	if !strings.EqualFold(filepath.Ext(path), ".csv") {
+		var items []*nft.SendBatchItem
+		if err := json.Unmarshal(content, &items); err != nil {
+			return nil, errors.Wrapf(err, "can't parse JSON file %q", path)
+		}
+		return items, nil
+	}
+
+	reader := csv.NewReader(strings.NewReader(string(content)))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't parse CSV file %q", path)
+	}
+	items := make([]*nft.SendBatchItem, 0, len(records))
+	for i, record := range records {
+		if i == 0 && len(record) > 0 && record[0] == "class_id" {
+			continue
+		}
+		if len(record) != 3 {
+			return nil, errors.Errorf("invalid row %d, the format must be class_id,id,receiver", i+1)
+		}
+		items = append(items, &nft.SendBatchItem{
+			ClassId:  record[0],
+			Id:       record[1],
+			Receiver: record[2],
+		})
+	}
+
+	return items, nil
+}
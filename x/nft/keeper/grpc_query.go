@@ -115,15 +115,13 @@ func (k Keeper) NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.Query
 			return nil, err
 		}
 	case len(r.ClassId) == 0 && len(r.Owner) > 0:
-		if pageRes, err = query.Paginate(k.prefixStoreNftOfClassByOwner(ctx, owner), r.Pagination, func(key, value []byte) error {
-			classID, nftID := parseNftOfClassByOwnerStoreKey(key)
-			if n, has := k.GetNFT(ctx, classID, nftID); has {
-				nfts = append(nfts, &n)
-			}
-			return nil
-		}); err != nil {
+		var ownerNFTs []nft.NFT
+		if ownerNFTs, pageRes, err = k.GetNFTsOfOwner(ctx, owner, r.Pagination); err != nil {
 			return nil, err
 		}
+		for i := range ownerNFTs {
+			nfts = append(nfts, &ownerNFTs[i])
+		}
 	default:
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("must provide at least one of classID or owner")
 	}
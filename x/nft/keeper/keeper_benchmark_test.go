@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/nft"
+	"github.com/CoreumFoundation/coreum/x/nft/keeper"
+)
+
+func BenchmarkGetBalance(b *testing.B) {
+	for _, numberOfNFTs := range []int{1, 10_000, 50_000} {
+		nftKeeper, ctx, owner := setupOwnerWithNFTs(b, numberOfNFTs)
+
+		b.Run(fmt.Sprintf("counter-%d-nfts", numberOfNFTs), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				require.EqualValues(b, numberOfNFTs, nftKeeper.GetBalance(ctx, testClassID, owner))
+			}
+		})
+
+		// the way the balance was computed before it was stored
+		b.Run(fmt.Sprintf("iteration-%d-nfts", numberOfNFTs), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				require.Len(b, nftKeeper.GetNFTsOfClassByOwner(ctx, testClassID, owner), numberOfNFTs)
+			}
+		})
+	}
+}
+
+func BenchmarkGetNFTsOfOwner(b *testing.B) {
+	const pageSize = 100
+
+	for _, numberOfNFTs := range []int{pageSize, 10_000, 50_000} {
+		nftKeeper, ctx, owner := setupOwnerWithNFTs(b, numberOfNFTs)
+
+		b.Run(fmt.Sprintf("page-of-%d-nfts", numberOfNFTs), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				nfts, _, err := nftKeeper.GetNFTsOfOwner(ctx, owner, &query.PageRequest{Limit: pageSize})
+				require.NoError(b, err)
+				require.Len(b, nfts, pageSize)
+			}
+		})
+	}
+}
+
+func setupOwnerWithNFTs(b *testing.B, numberOfNFTs int) (keeper.Keeper, sdk.Context, sdk.AccAddress) {
+	b.Helper()
+
+	db, err := sdk.NewLevelDB("nft", b.TempDir())
+	require.NoError(b, err)
+	b.Cleanup(func() {
+		db.Close()
+	})
+
+	testApp := simapp.New(simapp.WithCustomDB(db))
+	ctx := testApp.NewUncachedContext(false, tmproto.Header{})
+	nftKeeper := testApp.NFTKeeper.Keeper
+
+	require.NoError(b, nftKeeper.SaveClass(ctx, nft.Class{Id: testClassID}))
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	for i := 0; i < numberOfNFTs; i++ {
+		require.NoError(b, nftKeeper.Mint(ctx, nft.NFT{
+			ClassId: testClassID,
+			Id:      fmt.Sprintf("%s-%d", testID, i),
+			Uri:     testURI,
+		}, owner))
+	}
+
+	return nftKeeper, ctx, owner
+}
@@ -4,13 +4,17 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/CoreumFoundation/coreum/testutil/simapp"
 	"github.com/CoreumFoundation/coreum/x/nft"
+	"github.com/CoreumFoundation/coreum/x/nft/keeper"
 )
 
 const (
@@ -223,6 +227,34 @@ func (s *TestSuite) TestBurn() {
 	s.Require().EqualValues(uint64(0), supply)
 }
 
+func (s *TestSuite) TestBurnAndTransferWithoutBalance() {
+	err := s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID})
+	s.Require().NoError(err)
+
+	err = s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0])
+	s.Require().NoError(err)
+
+	// remove the balance the way it is missing before the store is migrated
+	balanceStore := prefix.NewStore(s.ctx.KVStore(s.app.GetKey(keeper.StoreKey)), keeper.OwnerClassBalanceKey)
+	iterator := balanceStore.Iterator(nil, nil)
+	s.Require().True(iterator.Valid())
+	balanceKey := iterator.Key()
+	s.Require().NoError(iterator.Close())
+	balanceStore.Delete(balanceKey)
+	s.Require().Zero(s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[0]))
+
+	err = s.app.NFTKeeper.Burn(s.ctx, testClassID, testID)
+	s.Require().ErrorIs(err, sdkerrors.ErrLogic)
+
+	err = s.app.NFTKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().ErrorIs(err, sdkerrors.ErrLogic)
+
+	// the nft is neither burnt nor transferred
+	s.Require().True(s.app.NFTKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().Equal(s.addrs[0], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+	s.Require().EqualValues(1, s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
 func (s *TestSuite) TestUpdate() {
 	class := nft.Class{
 		Id:          testClassID,
@@ -297,6 +329,93 @@ func (s *TestSuite) TestTransfer() {
 	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
 }
 
+func (s *TestSuite) TestSendBatch() {
+	class := nft.Class{
+		Id:          testClassID,
+		Name:        testClassName,
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+		Uri:         testClassURI,
+		UriHash:     testClassURIHash,
+	}
+	err := s.app.NFTKeeper.SaveClass(s.ctx, class)
+	s.Require().NoError(err)
+
+	nftIDs := []string{testID, testID + "-2", testID + "-3"}
+	for _, id := range nftIDs {
+		err = s.app.NFTKeeper.Mint(s.ctx, nft.NFT{
+			ClassId: testClassID,
+			Id:      id,
+			Uri:     testURI,
+		}, s.addrs[0])
+		s.Require().NoError(err)
+	}
+
+	// valid batch
+	_, err = s.app.NFTKeeper.SendBatch(sdk.WrapSDKContext(s.ctx), &nft.MsgSendBatch{
+		Sender: s.addrs[0].String(),
+		Items: []*nft.SendBatchItem{
+			{ClassId: testClassID, Id: nftIDs[0], Receiver: s.addrs[1].String()},
+			{ClassId: testClassID, Id: nftIDs[1], Receiver: s.addrs[2].String()},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, nftIDs[0]))
+	s.Require().Equal(s.addrs[2], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, nftIDs[1]))
+
+	// the batch containing nft not owned by the sender, nothing should be sent
+	_, err = s.app.NFTKeeper.SendBatch(sdk.WrapSDKContext(s.ctx), &nft.MsgSendBatch{
+		Sender: s.addrs[0].String(),
+		Items: []*nft.SendBatchItem{
+			{ClassId: testClassID, Id: nftIDs[2], Receiver: s.addrs[1].String()},
+			{ClassId: testClassID, Id: nftIDs[0], Receiver: s.addrs[2].String()},
+		},
+	})
+	s.Require().Error(err)
+	s.Require().Equal(s.addrs[0], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, nftIDs[2]))
+	s.Require().Equal(s.addrs[1], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, nftIDs[0]))
+}
+
+func (s *TestSuite) TestGetNFTsOfOwner() {
+	classIDs := []string{testClassID, testClassID + "2"}
+	var expNFTs []nft.NFT
+	for _, classID := range classIDs {
+		err := s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: classID})
+		s.Require().NoError(err)
+
+		for _, id := range []string{testID, testID + "2"} {
+			n := nft.NFT{
+				ClassId: classID,
+				Id:      id,
+				Uri:     testURI,
+			}
+			err = s.app.NFTKeeper.Mint(s.ctx, n, s.addrs[0])
+			s.Require().NoError(err)
+			expNFTs = append(expNFTs, n)
+		}
+	}
+	err := s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID + "3"}, s.addrs[1])
+	s.Require().NoError(err)
+
+	// first page
+	nfts, pageRes, err := s.app.NFTKeeper.GetNFTsOfOwner(s.ctx, s.addrs[0], &query.PageRequest{Limit: 3, CountTotal: true})
+	s.Require().NoError(err)
+	s.Require().Equal(expNFTs[:3], nfts)
+	s.Require().EqualValues(4, pageRes.Total)
+	s.Require().NotEmpty(pageRes.NextKey)
+
+	// next page
+	nfts, pageRes, err = s.app.NFTKeeper.GetNFTsOfOwner(s.ctx, s.addrs[0], &query.PageRequest{Key: pageRes.NextKey, Limit: 3})
+	s.Require().NoError(err)
+	s.Require().Equal(expNFTs[3:], nfts)
+	s.Require().Empty(pageRes.NextKey)
+
+	// balances are tracked per class
+	s.Require().EqualValues(2, s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[0]))
+	s.Require().EqualValues(2, s.app.NFTKeeper.GetBalance(s.ctx, testClassID+"2", s.addrs[0]))
+	s.Require().EqualValues(1, s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[1]))
+}
+
 func (s *TestSuite) TestExportGenesis() {
 	class := nft.Class{
 		Id:          testClassID,
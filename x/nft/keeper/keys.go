@@ -21,6 +21,8 @@ var (
 	OwnerKey = []byte{0x04}
 	// ClassTotalSupply is store prefix of the ClassTotalSupply.
 	ClassTotalSupply = []byte{0x05}
+	// OwnerClassBalanceKey is store prefix of the number of NFTs of the class held by the owner.
+	OwnerClassBalanceKey = []byte{0x06}
 
 	// Delimiter is store key Delimiter.
 	Delimiter = []byte{0x00}
@@ -97,6 +99,30 @@ func parseNftOfClassByOwnerStoreKey(key []byte) (classID, nftID string) {
 	return classID, nftID
 }
 
+// ownerClassBalanceStoreKey returns the byte representation of the number of NFTs of the class held by the owner
+// Items are stored with the following key: values
+// 0x06<owner><Delimiter(1 Byte)><classID>.
+func ownerClassBalanceStoreKey(owner sdk.AccAddress, classID string) []byte {
+	owner = address.MustLengthPrefix(owner)
+	classIDBz := store.UnsafeStrToBytes(classID)
+
+	key := make([]byte, len(OwnerClassBalanceKey)+len(owner)+len(Delimiter)+len(classIDBz))
+	copy(key, OwnerClassBalanceKey)
+	copy(key[len(OwnerClassBalanceKey):], owner)
+	copy(key[len(OwnerClassBalanceKey)+len(owner):], Delimiter)
+	copy(key[len(OwnerClassBalanceKey)+len(owner)+len(Delimiter):], classIDBz)
+	return key
+}
+
+// parseOwnerStoreKey returns the class ID and nft ID of the key created by the ownerStoreKey function.
+func parseOwnerStoreKey(key []byte) (classID, nftID string) {
+	ret := bytes.SplitN(key[len(OwnerKey):], Delimiter, 2)
+	if len(ret) != 2 {
+		panic("invalid ownerStoreKey")
+	}
+	return string(ret[0]), string(ret[1])
+}
+
 // ownerStoreKey returns the byte representation of the nft owner
 // Items are stored with the following key: values
 // 0x04<classID><Delimiter(1 Byte)><nftID>.
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// In version 2 the number of NFTs of the class held by the owner is stored, so it is computed for all the existing
+// NFTs.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	balances := map[string]uint64{}
+	iterator := sdk.KVStorePrefixIterator(store, OwnerKey)
+	for ; iterator.Valid(); iterator.Next() {
+		classID, _ := parseOwnerStoreKey(iterator.Key())
+		balances[string(ownerClassBalanceStoreKey(iterator.Value(), classID))]++
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(balances))
+	for key := range balances {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		store.Set([]byte(key), sdk.Uint64ToBigEndian(balances[key]))
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/nft"
+	"github.com/CoreumFoundation/coreum/x/nft/keeper"
+)
+
+func TestMigrator_Migrate1to2(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	nftKeeper := testApp.NFTKeeper.Keeper
+
+	owner1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	owner2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classIDs := []string{"class1", "class2"}
+	for _, classID := range classIDs {
+		requireT.NoError(nftKeeper.SaveClass(ctx, nft.Class{Id: classID}))
+	}
+
+	expectedBalances := map[string]map[string]uint64{
+		"class1": {owner1.String(): 3, owner2.String(): 1},
+		"class2": {owner1.String(): 2},
+	}
+	for classID, balances := range expectedBalances {
+		for owner, balance := range balances {
+			for i := uint64(0); i < balance; i++ {
+				requireT.NoError(nftKeeper.Mint(ctx, nft.NFT{
+					ClassId: classID,
+					Id:      fmt.Sprintf("id-%s-%d", owner, i),
+				}, sdk.MustAccAddressFromBech32(owner)))
+			}
+		}
+	}
+
+	// remove the balances to get the state the way it was stored before the balances were introduced
+	balanceStore := prefix.NewStore(ctx.KVStore(testApp.GetKey(keeper.StoreKey)), keeper.OwnerClassBalanceKey)
+	var balanceKeys [][]byte
+	iterator := balanceStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		balanceKeys = append(balanceKeys, iterator.Key())
+	}
+	requireT.NoError(iterator.Close())
+	requireT.Len(balanceKeys, 3)
+	for _, key := range balanceKeys {
+		balanceStore.Delete(key)
+	}
+	requireT.Zero(nftKeeper.GetBalance(ctx, "class1", owner1))
+
+	requireT.NoError(keeper.NewMigrator(nftKeeper).Migrate1to2(ctx))
+
+	for _, classID := range classIDs {
+		for _, owner := range []sdk.AccAddress{owner1, owner2} {
+			requireT.Equal(
+				expectedBalances[classID][owner.String()],
+				nftKeeper.GetBalance(ctx, classID, owner),
+				"class: %s, owner: %s", classID, owner,
+			)
+		}
+	}
+}
@@ -45,3 +45,23 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 
 	return &nft.MsgSendResponse{}, nil
 }
+
+// SendBatch implement SendBatch method of the types.MsgServer.
+func (k Keeper) SendBatch(goCtx context.Context, msg *nft.MsgSendBatch) (*nft.MsgSendBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, item := range msg.Items {
+		if _, err := k.Send(sdk.WrapSDKContext(cacheCtx), &nft.MsgSend{
+			ClassId:  item.ClassId,
+			Id:       item.Id,
+			Sender:   msg.Sender,
+			Receiver: item.Receiver,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return &nft.MsgSendBatchResponse{}, nil
+}
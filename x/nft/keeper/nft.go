@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/CoreumFoundation/coreum/x/nft"
 )
@@ -46,10 +47,12 @@ func (k Keeper) Burn(ctx sdk.Context, classID, nftID string) error {
 	}
 
 	owner := k.GetOwner(ctx, classID, nftID)
+	if err := k.deleteOwner(ctx, classID, nftID, owner); err != nil {
+		return err
+	}
+
 	nftStore := k.getNFTStore(ctx, classID)
 	nftStore.Delete([]byte(nftID))
-
-	k.deleteOwner(ctx, classID, nftID, owner)
 	k.decrTotalSupply(ctx, classID)
 
 	err := ctx.EventManager().EmitTypedEvent(&nft.EventBurn{
@@ -94,7 +97,9 @@ func (k Keeper) Transfer(ctx sdk.Context,
 	}
 
 	owner := k.GetOwner(ctx, classID, nftID)
-	k.deleteOwner(ctx, classID, nftID, owner)
+	if err := k.deleteOwner(ctx, classID, nftID, owner); err != nil {
+		return err
+	}
 	k.setOwner(ctx, classID, nftID, receiver)
 	return nil
 }
@@ -145,10 +150,29 @@ func (k Keeper) GetOwner(ctx sdk.Context, classID, nftID string) sdk.AccAddress
 	return sdk.AccAddress(bz)
 }
 
+// GetNFTsOfOwner returns the page of nft information of all the classes under the specified owner.
+func (k Keeper) GetNFTsOfOwner(
+	ctx sdk.Context, owner sdk.AccAddress, pagination *query.PageRequest,
+) ([]nft.NFT, *query.PageResponse, error) {
+	var nfts []nft.NFT
+	pageRes, err := query.Paginate(k.prefixStoreNftOfClassByOwner(ctx, owner), pagination, func(key, _ []byte) error {
+		classID, nftID := parseNftOfClassByOwnerStoreKey(key)
+		if n, has := k.GetNFT(ctx, classID, nftID); has {
+			nfts = append(nfts, n)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return nfts, pageRes, nil
+}
+
 // GetBalance returns the specified account, the number of all nfts under the specified classID.
 func (k Keeper) GetBalance(ctx sdk.Context, classID string, owner sdk.AccAddress) uint64 {
-	nfts := k.GetNFTsOfClassByOwner(ctx, classID, owner)
-	return uint64(len(nfts))
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(ownerClassBalanceStoreKey(owner, classID))
+	return sdk.BigEndianToUint64(bz)
 }
 
 // GetTotalSupply returns the number of all nfts under the specified classID.
@@ -176,14 +200,37 @@ func (k Keeper) setOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAd
 
 	ownerStore := k.getClassStoreByOwner(ctx, owner, classID)
 	ownerStore.Set([]byte(nftID), Placeholder)
+
+	k.updateBalance(ctx, classID, owner, k.GetBalance(ctx, classID, owner)+1)
 }
 
-func (k Keeper) deleteOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) {
+func (k Keeper) deleteOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
+	balance := k.GetBalance(ctx, classID, owner)
+	if balance == 0 {
+		// the balance is missing if the store hasn't been migrated to the version 2
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "the balance of the class %s held by the owner %s is zero", classID, owner,
+		)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(ownerStoreKey(classID, nftID))
 
 	ownerStore := k.getClassStoreByOwner(ctx, owner, classID)
 	ownerStore.Delete([]byte(nftID))
+
+	k.updateBalance(ctx, classID, owner, balance-1)
+	return nil
+}
+
+func (k Keeper) updateBalance(ctx sdk.Context, classID string, owner sdk.AccAddress, balance uint64) {
+	store := ctx.KVStore(k.storeKey)
+	balanceKey := ownerClassBalanceStoreKey(owner, classID)
+	if balance == 0 {
+		store.Delete(balanceKey)
+		return
+	}
+	store.Set(balanceKey, sdk.Uint64ToBigEndian(balance))
 }
 
 func (k Keeper) getNFTStore(ctx sdk.Context, classID string) prefix.Store {
@@ -8,9 +8,17 @@ import (
 const (
 	// TypeMsgSend nft message types.
 	TypeMsgSend = "send"
+	// TypeMsgSendBatch nft message types.
+	TypeMsgSendBatch = "send_batch"
+
+	// MaxSendBatchSize is the max number of nfts sent by a single MsgSendBatch.
+	MaxSendBatchSize = 500
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgSendBatch{}
+)
 
 // ValidateBasic implements the Msg.ValidateBasic method.
 func (m MsgSend) ValidateBasic() error {
@@ -39,3 +47,57 @@ func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgSendBatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", m.Sender)
+	}
+
+	if len(m.Items) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no nfts to send")
+	}
+
+	if len(m.Items) > MaxSendBatchSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "too many nfts to send, the max is %d", MaxSendBatchSize)
+	}
+
+	type nftKey struct {
+		classID string
+		id      string
+	}
+	sent := make(map[nftKey]struct{}, len(m.Items))
+	for _, item := range m.Items {
+		if item == nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty item")
+		}
+
+		if err := ValidateClassID(item.ClassId); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidID, "invalid class id (%s)", item.ClassId)
+		}
+
+		if err := ValidateNFTID(item.Id); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidID, "invalid nft id (%s)", item.Id)
+		}
+
+		_, err = sdk.AccAddressFromBech32(item.Receiver)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", item.Receiver)
+		}
+
+		key := nftKey{classID: item.ClassId, id: item.Id}
+		if _, ok := sent[key]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "nft %s of class %s is sent more than once", item.Id, item.ClassId)
+		}
+		sent[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgSendBatch) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
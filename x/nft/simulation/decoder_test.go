@@ -48,6 +48,9 @@ func TestDecodeStore(t *testing.T) {
 	totalSupply := 1
 	totalSupplyBz := sdk.Uint64ToBigEndian(1)
 
+	balance := 2
+	balanceBz := sdk.Uint64ToBigEndian(2)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: keeper.ClassKey, Value: classBz},
@@ -55,6 +58,7 @@ func TestDecodeStore(t *testing.T) {
 			{Key: keeper.NFTOfClassByOwnerKey, Value: nftOfClassByOwnerValue},
 			{Key: keeper.OwnerKey, Value: ownerAddr1},
 			{Key: keeper.ClassTotalSupply, Value: totalSupplyBz},
+			{Key: keeper.OwnerClassBalanceKey, Value: balanceBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -69,6 +73,7 @@ func TestDecodeStore(t *testing.T) {
 		{"NFTOfClassByOwnerKey", false, fmt.Sprintf("%v\n%v", nftOfClassByOwnerValue, nftOfClassByOwnerValue)},
 		{"OwnerKey", false, fmt.Sprintf("%v\n%v", ownerAddr1, ownerAddr1)},
 		{"ClassTotalSupply", false, fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"OwnerClassBalanceKey", false, fmt.Sprintf("%v\n%v", balance, balance)},
 		{"other", true, ""},
 	}
 
@@ -1,31 +1,30 @@
 package handler
 
 import (
+	"bytes"
 	"encoding/json"
+	"reflect"
+	"strings"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/pkg/errors"
 
-	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
-	nfttypes "github.com/CoreumFoundation/coreum/x/nft"
 )
 
-// assetFTMsg represents asset ft module messages integrated with the wasm handler.
-//
-//nolint:tagliatelle // we keep the name same as consume
-type assetFTMsg struct {
-	Issue               *assetfttypes.MsgIssue               `json:"Issue"`
-	Mint                *assetfttypes.MsgMint                `json:"Mint"`
-	Burn                *assetfttypes.MsgBurn                `json:"Burn"`
-	Freeze              *assetfttypes.MsgFreeze              `json:"Freeze"`
-	Unfreeze            *assetfttypes.MsgUnfreeze            `json:"Unfreeze"`
-	GloballyFreeze      *assetfttypes.MsgGloballyFreeze      `json:"GloballyFreeze"`
-	GloballyUnfreeze    *assetfttypes.MsgGloballyUnfreeze    `json:"GloballyUnfreeze"`
-	SetWhitelistedLimit *assetfttypes.MsgSetWhitelistedLimit `json:"SetWhitelistedLimit"`
-}
+const (
+	coreumPackagePrefix = "coreum"
+	msgNamePrefix       = "Msg"
+)
+
+// signerFields are the names of the message fields holding the address of the signer.
+// If the contract doesn't set them, they are set to the address of the contract.
+var signerFields = []string{"Sender", "Issuer", "Authority"}
 
 // assetNFTMsgIssueClass defines message for the IssueClass method with string represented data field.
 //
@@ -52,186 +51,252 @@ type assetNFTMsgMint struct {
 	Data    string `json:"data"`
 }
 
-// assetNFTMsg represents asset nft module messages integrated with the wasm handler.
-//
-//nolint:tagliatelle // we keep the name same as consume
-type assetNFTMsg struct {
-	IssueClass          *assetNFTMsgIssueClass                `json:"IssueClass"`
-	Mint                *assetNFTMsgMint                      `json:"Mint"`
-	Burn                *assetnfttypes.MsgBurn                `json:"Burn"`
-	Freeze              *assetnfttypes.MsgFreeze              `json:"Freeze"`
-	Unfreeze            *assetnfttypes.MsgUnfreeze            `json:"Unfreeze"`
-	AddToWhitelist      *assetnfttypes.MsgAddToWhitelist      `json:"AddToWhitelist"`
-	RemoveFromWhitelist *assetnfttypes.MsgRemoveFromWhitelist `json:"RemoveFromWhitelist"`
-}
+// msgDecoder decodes the message received from the smart contract.
+type msgDecoder func(data json.RawMessage) (sdk.Msg, error)
 
-// nftMsg represents nft module messages integrated with the wasm handler.
-//
-//nolint:tagliatelle // we keep the name same as consume
-type nftMsg struct {
-	Send *nfttypes.MsgSend `json:"Send"`
+// msgDecoders maps the lowercase module and message names to the decoders of the messages.
+type msgDecoders map[string]map[string]msgDecoder
+
+// customMsgDecoders defines the decoders of the messages having the format different from the proto one.
+var customMsgDecoders = map[string]msgDecoder{
+	sdk.MsgTypeURL(&assetnfttypes.MsgIssueClass{}): decodeAssetNFTMsgIssueClass,
+	sdk.MsgTypeURL(&assetnfttypes.MsgMint{}):       decodeAssetNFTMsgMint,
 }
 
-// coreumMsg represents all supported custom messages integrated with the wasm handler.
-//
-//nolint:tagliatelle // we keep the name same as consume
-type coreumMsg struct {
-	AssetFT  *assetFTMsg  `json:"AssetFT"`
-	AssetNFT *assetNFTMsg `json:"AssetNFT"`
-	NFT      *nftMsg      `json:"nft"`
+// unsupportedMsgs defines the messages which can't be sent by the smart contract.
+var unsupportedMsgs = map[string]struct{}{
+	// MsgSell must be signed by both the seller and the buyer, while the contract is the only signer of the messages
+	// it sends.
+	sdk.MsgTypeURL(&assetnfttypes.MsgSell{}): {},
 }
 
 // NewCoreumMsgHandler returns coreum handler that handles messages received from smart contracts.
 // The in the input sender is the address of smart contract.
-func NewCoreumMsgHandler() *wasmkeeper.MessageEncoders {
+//
+// All the coreum messages registered in the interface registry are supported. The contract sends the message
+// in the form of {"<Module>": {"<Message>": {...}}}, where the module is the proto package of the message
+// without the "coreum" prefix, dots and the version, and the message is the name of the message without the
+// "Msg" prefix, e.g. {"AssetFT": {"Mint": {...}}} is decoded to coreum.asset.ft.v1.MsgMint.
+// Both names are case-insensitive. The message is decoded from its proto JSON representation, so the enums
+// might be passed either by the name or by the number. The messages requiring more signers than the contract,
+// e.g. coreum.asset.nft.v1.MsgSell, are not supported.
+func NewCoreumMsgHandler(interfaceRegistry codectypes.InterfaceRegistry) *wasmkeeper.MessageEncoders {
+	decoders := newMsgDecoders(interfaceRegistry)
 	return &wasmkeeper.MessageEncoders{
 		Custom: func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
-			var coreumMsg coreumMsg
-			if err := json.Unmarshal(msg, &coreumMsg); err != nil {
-				return nil, errors.WithStack(err)
-			}
-
-			decodedMsg, err := decodeCoreumMessage(coreumMsg, sender)
+			decodedMsg, err := decoders.decode(msg, sender)
 			if err != nil {
 				return nil, err
 			}
-			if decodedMsg == nil {
-				return nil, nil
-			}
 
 			if err := decodedMsg.ValidateBasic(); err != nil {
 				return nil, errors.WithStack(err)
 			}
 
+			for _, signer := range decodedMsg.GetSigners() {
+				if !signer.Equals(sender) {
+					return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the contract", signer)
+				}
+			}
+
 			return []sdk.Msg{decodedMsg}, nil
 		},
 	}
 }
 
-func decodeCoreumMessage(coreumMessages coreumMsg, sender sdk.AccAddress) (sdk.Msg, error) {
-	if coreumMessages.AssetFT != nil {
-		return decodeAssetFTMessage(coreumMessages.AssetFT, sender.String())
-	}
-	if coreumMessages.AssetNFT != nil {
-		return decodeAssetNFTMessage(coreumMessages.AssetNFT, sender.String())
+func newMsgDecoders(interfaceRegistry codectypes.InterfaceRegistry) msgDecoders {
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	decoders := msgDecoders{}
+	for _, typeURL := range interfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		moduleName, msgName, ok := parseCoreumMsgTypeURL(typeURL)
+		if !ok {
+			continue
+		}
+		if _, ok := unsupportedMsgs[typeURL]; ok {
+			continue
+		}
+
+		decoder, ok := customMsgDecoders[typeURL]
+		if !ok {
+			decoder = newProtoMsgDecoder(cdc, interfaceRegistry, typeURL)
+		}
+
+		if decoders[moduleName] == nil {
+			decoders[moduleName] = map[string]msgDecoder{}
+		}
+		decoders[moduleName][msgName] = decoder
 	}
-	if coreumMessages.NFT != nil {
-		return decodeNFTMessage(coreumMessages.NFT, sender.String())
+	return decoders
+}
+
+// parseCoreumMsgTypeURL returns the lowercase module and message names of the coreum message type URL,
+// e.g. "assetft" and "mint" for "/coreum.asset.ft.v1.MsgMint".
+func parseCoreumMsgTypeURL(typeURL string) (string, string, bool) {
+	parts := strings.Split(strings.TrimPrefix(typeURL, "/"), ".")
+	// the type URL consists of the coreum prefix, module path, version and message name
+	if len(parts) < 4 || parts[0] != coreumPackagePrefix {
+		return "", "", false
 	}
 
-	return nil, nil
+	moduleName := strings.Join(parts[1:len(parts)-2], "")
+	msgName := strings.TrimPrefix(parts[len(parts)-1], msgNamePrefix)
+	return strings.ToLower(moduleName), strings.ToLower(msgName), true
 }
 
-func decodeAssetFTMessage(assetFTMsg *assetFTMsg, sender string) (sdk.Msg, error) {
-	if assetFTMsg.Issue != nil {
-		assetFTMsg.Issue.Issuer = sender
-		return assetFTMsg.Issue, nil
-	}
-	if assetFTMsg.Mint != nil {
-		assetFTMsg.Mint.Sender = sender
-		return assetFTMsg.Mint, nil
-	}
-	if assetFTMsg.Burn != nil {
-		assetFTMsg.Burn.Sender = sender
-		return assetFTMsg.Burn, nil
+// newProtoMsgDecoder returns the decoder of the message in the proto JSON format.
+func newProtoMsgDecoder(
+	cdc codec.JSONCodec,
+	interfaceRegistry codectypes.InterfaceRegistry,
+	typeURL string,
+) msgDecoder {
+	return func(data json.RawMessage) (sdk.Msg, error) {
+		protoMsg, err := interfaceRegistry.Resolve(typeURL)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		msg, ok := protoMsg.(sdk.Msg)
+		if !ok {
+			return nil, errors.Errorf("type %s is not a message", typeURL)
+		}
+		data, err = removeNullFields(data)
+		if err != nil {
+			return nil, err
+		}
+		if err := cdc.UnmarshalJSON(data, msg); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "decoding %s: %s", typeURL, err)
+		}
+		return msg, nil
 	}
-	if assetFTMsg.Freeze != nil {
-		assetFTMsg.Freeze.Sender = sender
-		return assetFTMsg.Freeze, nil
+}
+
+// removeNullFields removes the fields set to null, which are sent by the contracts for the unset optional fields.
+// In the proto JSON format null means the default value, but the custom types, e.g. sdk.Dec, fail to decode it.
+func removeNullFields(data json.RawMessage) (json.RawMessage, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// the numbers are kept as they are to not lose the precision of the large integers
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
-	if assetFTMsg.Unfreeze != nil {
-		assetFTMsg.Unfreeze.Sender = sender
-		return assetFTMsg.Unfreeze, nil
+
+	data, err := json.Marshal(removeNullValues(value))
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	if assetFTMsg.GloballyFreeze != nil {
-		assetFTMsg.GloballyFreeze.Sender = sender
-		return assetFTMsg.GloballyFreeze, nil
+	return data, nil
+}
+
+func removeNullValues(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range v {
+			if fieldValue == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = removeNullValues(fieldValue)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = removeNullValues(item)
+		}
 	}
-	if assetFTMsg.GloballyUnfreeze != nil {
-		assetFTMsg.GloballyUnfreeze.Sender = sender
-		return assetFTMsg.GloballyUnfreeze, nil
+	return value
+}
+
+func (d msgDecoders) decode(data json.RawMessage, sender sdk.AccAddress) (sdk.Msg, error) {
+	var coreumMsg map[string]map[string]json.RawMessage
+	if err := json.Unmarshal(data, &coreumMsg); err != nil {
+		return nil, errors.WithStack(err)
 	}
-	if assetFTMsg.SetWhitelistedLimit != nil {
-		assetFTMsg.SetWhitelistedLimit.Sender = sender
-		return assetFTMsg.SetWhitelistedLimit, nil
+	if len(coreumMsg) != 1 {
+		return nil, sdkerrors.Wrap(wasmtypes.ErrUnknownMsg, "exactly one module must be specified")
 	}
 
-	return nil, nil
-}
+	for moduleName, moduleMsg := range coreumMsg {
+		if len(moduleMsg) != 1 {
+			return nil, sdkerrors.Wrapf(wasmtypes.ErrUnknownMsg, "exactly one message of %s must be specified", moduleName)
+		}
+		for msgName, msgData := range moduleMsg {
+			decoder, ok := d[strings.ToLower(moduleName)][strings.ToLower(msgName)]
+			if !ok {
+				return nil, sdkerrors.Wrapf(wasmtypes.ErrUnknownMsg, "unsupported message %s of %s", msgName, moduleName)
+			}
 
-func decodeAssetNFTMessage(assetNFTMsg *assetNFTMsg, sender string) (sdk.Msg, error) {
-	if assetNFTMsg.IssueClass != nil {
-		var (
-			data *codectypes.Any
-			err  error
-		)
-		if assetNFTMsg.IssueClass.Data != "" {
-			data, err = convertStringToDataBytes(assetNFTMsg.IssueClass.Data)
+			msg, err := decoder(msgData)
 			if err != nil {
 				return nil, err
 			}
+			setSigners(msg, sender.String())
+			return msg, nil
 		}
-		return &assetnfttypes.MsgIssueClass{
-			Issuer:      sender,
-			Symbol:      assetNFTMsg.IssueClass.Symbol,
-			Name:        assetNFTMsg.IssueClass.Name,
-			Description: assetNFTMsg.IssueClass.Description,
-			URI:         assetNFTMsg.IssueClass.URI,
-			URIHash:     assetNFTMsg.IssueClass.URIHash,
-			Data:        data,
-			Features:    assetNFTMsg.IssueClass.Features,
-			RoyaltyRate: assetNFTMsg.IssueClass.RoyaltyRate,
-		}, nil
 	}
-	if assetNFTMsg.Mint != nil {
-		var (
-			data *codectypes.Any
-			err  error
-		)
-		if assetNFTMsg.Mint.Data != "" {
-			data, err = convertStringToDataBytes(assetNFTMsg.Mint.Data)
-			if err != nil {
-				return nil, err
-			}
+
+	return nil, sdkerrors.Wrap(wasmtypes.ErrUnknownMsg, "message is not specified")
+}
+
+// setSigners sets the signer fields of the message to the sender if they are not set.
+func setSigners(msg sdk.Msg, sender string) {
+	msgValue := reflect.ValueOf(msg).Elem()
+	for _, fieldName := range signerFields {
+		field := msgValue.FieldByName(fieldName)
+		if !field.IsValid() || field.Kind() != reflect.String || field.String() != "" {
+			continue
 		}
-		return &assetnfttypes.MsgMint{
-			Sender:  sender,
-			ClassID: assetNFTMsg.Mint.ClassID,
-			ID:      assetNFTMsg.Mint.ID,
-			URI:     assetNFTMsg.Mint.URI,
-			URIHash: assetNFTMsg.Mint.URIHash,
-			Data:    data,
-		}, nil
-	}
-	if assetNFTMsg.Burn != nil {
-		assetNFTMsg.Burn.Sender = sender
-		return assetNFTMsg.Burn, nil
-	}
-	if assetNFTMsg.Freeze != nil {
-		assetNFTMsg.Freeze.Sender = sender
-		return assetNFTMsg.Freeze, nil
+		field.SetString(sender)
 	}
-	if assetNFTMsg.Unfreeze != nil {
-		assetNFTMsg.Unfreeze.Sender = sender
-		return assetNFTMsg.Unfreeze, nil
-	}
-	if assetNFTMsg.AddToWhitelist != nil {
-		assetNFTMsg.AddToWhitelist.Sender = sender
-		return assetNFTMsg.AddToWhitelist, nil
-	}
-	if assetNFTMsg.RemoveFromWhitelist != nil {
-		assetNFTMsg.RemoveFromWhitelist.Sender = sender
-		return assetNFTMsg.RemoveFromWhitelist, nil
+}
+
+func decodeAssetNFTMsgIssueClass(data json.RawMessage) (sdk.Msg, error) {
+	var issueClass assetNFTMsgIssueClass
+	if err := json.Unmarshal(data, &issueClass); err != nil {
+		return nil, errors.WithStack(err)
 	}
 
-	return nil, nil
+	var (
+		dataValue *codectypes.Any
+		err       error
+	)
+	if issueClass.Data != "" {
+		dataValue, err = convertStringToDataBytes(issueClass.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &assetnfttypes.MsgIssueClass{
+		Symbol:      issueClass.Symbol,
+		Name:        issueClass.Name,
+		Description: issueClass.Description,
+		URI:         issueClass.URI,
+		URIHash:     issueClass.URIHash,
+		Data:        dataValue,
+		Features:    issueClass.Features,
+		RoyaltyRate: issueClass.RoyaltyRate,
+	}, nil
 }
 
-func decodeNFTMessage(nftMsg *nftMsg, sender string) (sdk.Msg, error) {
-	if nftMsg.Send != nil {
-		nftMsg.Send.Sender = sender
-		return nftMsg.Send, nil
+func decodeAssetNFTMsgMint(data json.RawMessage) (sdk.Msg, error) {
+	var mint assetNFTMsgMint
+	if err := json.Unmarshal(data, &mint); err != nil {
+		return nil, errors.WithStack(err)
 	}
 
-	return nil, nil
+	var (
+		dataValue *codectypes.Any
+		err       error
+	)
+	if mint.Data != "" {
+		dataValue, err = convertStringToDataBytes(mint.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &assetnfttypes.MsgMint{
+		ClassID: mint.ClassID,
+		ID:      mint.ID,
+		URI:     mint.URI,
+		URIHash: mint.URIHash,
+		Data:    dataValue,
+	}, nil
 }
@@ -0,0 +1,164 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/app"
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	nfttypes "github.com/CoreumFoundation/coreum/x/nft"
+	"github.com/CoreumFoundation/coreum/x/wasm/handler"
+)
+
+func TestCoreumMsgHandler(t *testing.T) {
+	interfaceRegistry := config.NewEncodingConfig(app.ModuleBasics).InterfaceRegistry
+	encode := handler.NewCoreumMsgHandler(interfaceRegistry).Custom
+
+	contract := sdk.AccAddress("contract-address-123")
+	recipient := sdk.AccAddress("recipient-address-12")
+	denom := assetfttypes.BuildDenom("uabc", contract)
+
+	tests := []struct {
+		name        string
+		msg         string
+		expectedMsg sdk.Msg
+		expectedErr error
+	}{
+		{
+			name: "asset_ft_mint",
+			msg:  fmt.Sprintf(`{"AssetFT":{"Mint":{"coin":{"denom":"%s","amount":"10"}}}}`, denom),
+			expectedMsg: &assetfttypes.MsgMint{
+				Sender: contract.String(),
+				Coin:   sdk.NewInt64Coin(denom, 10),
+			},
+		},
+		{
+			name: "asset_ft_transfer_admin",
+			msg:  fmt.Sprintf(`{"AssetFT":{"TransferAdmin":{"account":"%s","denom":"%s"}}}`, recipient, denom),
+			expectedMsg: &assetfttypes.MsgTransferAdmin{
+				Sender:  contract.String(),
+				Account: recipient.String(),
+				Denom:   denom,
+			},
+		},
+		{
+			name: "asset_ft_issue_with_proto_json_values",
+			msg: `{"AssetFT":{"Issue":{"symbol":"abc","subunit":"uabc","precision":"6","initial_amount":"100",` +
+				`"features":["minting",1],"burn_rate":null,"description":null}}}`,
+			expectedMsg: &assetfttypes.MsgIssue{
+				Issuer:        contract.String(),
+				Symbol:        "abc",
+				Subunit:       "uabc",
+				Precision:     6,
+				InitialAmount: sdk.NewInt(100),
+				Features:      []assetfttypes.Feature{assetfttypes.Feature_minting, assetfttypes.Feature_burning},
+			},
+		},
+		{
+			name: "asset_nft_issue_class",
+			msg:  `{"AssetNFT":{"IssueClass":{"symbol":"abc","name":"name","features":[0]}}}`,
+			expectedMsg: &assetnfttypes.MsgIssueClass{
+				Issuer:   contract.String(),
+				Symbol:   "abc",
+				Name:     "name",
+				Features: []assetnfttypes.ClassFeature{assetnfttypes.ClassFeature_burning},
+			},
+		},
+		{
+			name: "nft_send_batch",
+			msg: fmt.Sprintf(
+				`{"nft":{"SendBatch":{"items":[{"class_id":"abc-%s","id":"id1","receiver":"%s"}]}}}`,
+				contract, recipient,
+			),
+			expectedMsg: &nfttypes.MsgSendBatch{
+				Sender: contract.String(),
+				Items: []*nfttypes.SendBatchItem{
+					{
+						ClassId:  "abc-" + contract.String(),
+						Id:       "id1",
+						Receiver: recipient.String(),
+					},
+				},
+			},
+		},
+		{
+			name: "sender_is_not_contract",
+			msg: fmt.Sprintf(
+				`{"AssetFT":{"Mint":{"sender":"%s","coin":{"denom":"%s","amount":"10"}}}}`,
+				recipient, denom,
+			),
+			expectedErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:        "unknown_field",
+			msg:         fmt.Sprintf(`{"AssetFT":{"Mint":{"coin":{"denom":"%s","amount":"10"},"unknown":1}}}`, denom),
+			expectedErr: sdkerrors.ErrJSONUnmarshal,
+		},
+		{
+			name:        "asset_nft_sell_is_not_supported",
+			msg:         fmt.Sprintf(`{"AssetNFT":{"Sell":{"class_id":"abc-%s","id":"id1"}}}`, contract),
+			expectedErr: wasmtypes.ErrUnknownMsg,
+		},
+		{
+			name:        "unknown_message",
+			msg:         `{"AssetFT":{"Unknown":{}}}`,
+			expectedErr: wasmtypes.ErrUnknownMsg,
+		},
+		{
+			name:        "unknown_module",
+			msg:         `{"Unknown":{"Mint":{}}}`,
+			expectedErr: wasmtypes.ErrUnknownMsg,
+		},
+		{
+			name:        "multiple_messages",
+			msg:         `{"AssetFT":{"Mint":{},"Burn":{}}}`,
+			expectedErr: wasmtypes.ErrUnknownMsg,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := encode(contract, json.RawMessage(tc.msg))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.Msg{tc.expectedMsg}, msgs)
+		})
+	}
+}
+
+func TestCoreumMsgHandlerSupportsAllMessages(t *testing.T) {
+	interfaceRegistry := config.NewEncodingConfig(app.ModuleBasics).InterfaceRegistry
+	encode := handler.NewCoreumMsgHandler(interfaceRegistry).Custom
+
+	contract := sdk.AccAddress("contract-address-123")
+	var count int
+	for _, typeURL := range interfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		// MsgSell requires the signature of the buyer, so it can't be sent by the contract
+		if !strings.HasPrefix(typeURL, "/coreum.") || typeURL == sdk.MsgTypeURL(&assetnfttypes.MsgSell{}) {
+			continue
+		}
+		count++
+
+		parts := strings.Split(typeURL, ".")
+		moduleName := strings.Join(parts[1:len(parts)-2], "")
+		msgName := strings.TrimPrefix(parts[len(parts)-1], "Msg")
+
+		// the empty messages are invalid, but they must be routed to the message decoders
+		_, err := encode(contract, json.RawMessage(fmt.Sprintf(`{"%s":{"%s":{}}}`, moduleName, msgName)))
+		require.False(t, wasmtypes.ErrUnknownMsg.Is(err), "message %s is not supported", typeURL)
+	}
+	require.Positive(t, count)
+}
@@ -7,11 +7,13 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 
 	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
 	nfttypes "github.com/CoreumFoundation/coreum/x/nft"
 )
 
@@ -19,9 +21,19 @@ import (
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetFTQuery struct {
-	Token              *assetfttypes.QueryTokenRequest              `json:"Token"`
-	FrozenBalance      *assetfttypes.QueryFrozenBalanceRequest      `json:"FrozenBalance"`
-	WhitelistedBalance *assetfttypes.QueryWhitelistedBalanceRequest `json:"WhitelistedBalance"`
+	Params              *assetfttypes.QueryParamsRequest              `json:"Params"`
+	Token               *assetfttypes.QueryTokenRequest               `json:"Token"`
+	Tokens              *assetfttypes.QueryTokensRequest              `json:"Tokens"`
+	FrozenBalance       *assetfttypes.QueryFrozenBalanceRequest       `json:"FrozenBalance"`
+	FrozenBalances      *assetfttypes.QueryFrozenBalancesRequest      `json:"FrozenBalances"`
+	WhitelistedBalance  *assetfttypes.QueryWhitelistedBalanceRequest  `json:"WhitelistedBalance"`
+	WhitelistedBalances *assetfttypes.QueryWhitelistedBalancesRequest `json:"WhitelistedBalances"`
+	Blacklisted         *assetfttypes.QueryBlacklistedRequest         `json:"Blacklisted"`
+	BlacklistedAccounts *assetfttypes.QueryBlacklistedAccountsRequest `json:"BlacklistedAccounts"`
+	MintAllowance       *assetfttypes.QueryMintAllowanceRequest       `json:"MintAllowance"`
+	MintAllowances      *assetfttypes.QueryMintAllowancesRequest      `json:"MintAllowances"`
+	RateExempt          *assetfttypes.QueryRateExemptRequest          `json:"RateExempt"`
+	RateExemptAccounts  *assetfttypes.QueryRateExemptAccountsRequest  `json:"RateExemptAccounts"`
 }
 
 // assetNFTClass is the asset nft Class with string data.
@@ -49,9 +61,11 @@ type assetNFTClassResponse struct {
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetNFTQuery struct {
-	Class       *assetnfttypes.QueryClassRequest       `json:"Class"`
-	Frozen      *assetnfttypes.QueryFrozenRequest      `json:"Frozen"`
-	Whitelisted *assetnfttypes.QueryWhitelistedRequest `json:"Whitelisted"`
+	Params                    *assetnfttypes.QueryParamsRequest                    `json:"Params"`
+	Class                     *assetnfttypes.QueryClassRequest                     `json:"Class"`
+	Frozen                    *assetnfttypes.QueryFrozenRequest                    `json:"Frozen"`
+	Whitelisted               *assetnfttypes.QueryWhitelistedRequest               `json:"Whitelisted"`
+	WhitelistedAccountsForNFT *assetnfttypes.QueryWhitelistedAccountsForNFTRequest `json:"WhitelistedAccountsForNFT"`
 }
 
 // nft is the nft with string data.
@@ -70,6 +84,36 @@ type NFTResponse struct {
 	NFT nft `json:"nft"`
 }
 
+// nftsResponse is the nfts response with string data.
+type nftsResponse struct {
+	NFTs       []nft               `json:"nfts"`
+	Pagination *query.PageResponse `json:"pagination"`
+}
+
+// nftClass is the nft Class with string data.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type nftClass struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	Description string `json:"description"`
+	URI         string `json:"uri"`
+	URIHash     string `json:"uri_hash"`
+	Data        string `json:"data"`
+}
+
+// nftClassResponse is the nft Class response with string data.
+type nftClassResponse struct {
+	Class nftClass `json:"class"`
+}
+
+// nftClassesResponse is the nft Classes response with string data.
+type nftClassesResponse struct {
+	Classes    []nftClass          `json:"classes"`
+	Pagination *query.PageResponse `json:"pagination"`
+}
+
 // nftQuery represents nft module queries integrated with the wasm handler.
 //
 //nolint:tagliatelle // we keep the name same as consume
@@ -78,6 +122,17 @@ type nftQuery struct {
 	Owner   *nfttypes.QueryOwnerRequest   `json:"Owner"`
 	Supply  *nfttypes.QuerySupplyRequest  `json:"Supply"`
 	NFT     *nfttypes.QueryNFTRequest     `json:"nft"`
+	NFTs    *nfttypes.QueryNFTsRequest    `json:"nfts"`
+	Class   *nfttypes.QueryClassRequest   `json:"Class"`
+	Classes *nfttypes.QueryClassesRequest `json:"Classes"`
+}
+
+// feeModelQuery represents fee model module queries integrated with the wasm handler.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type feeModelQuery struct {
+	MinGasPrice *feemodeltypes.QueryMinGasPriceRequest `json:"MinGasPrice"`
+	Params      *feemodeltypes.QueryParamsRequest      `json:"Params"`
 }
 
 // coreumQuery represents all coreum module queries integrated with the wasm handler.
@@ -87,6 +142,7 @@ type coreumQuery struct {
 	AssetFT  *assetFTQuery  `json:"AssetFT"`
 	AssetNFT *assetNFTQuery `json:"AssetNFT"`
 	NFT      *nftQuery      `json:"nft"`
+	FeeModel *feeModelQuery `json:"FeeModel"`
 }
 
 // NewCoreumQueryHandler returns the coreum handler which handles queries from smart contracts.
@@ -94,6 +150,7 @@ func NewCoreumQueryHandler(
 	assetFTQueryServer assetfttypes.QueryServer,
 	assetNFTQueryServer assetnfttypes.QueryServer,
 	nftQueryServer nfttypes.QueryServer,
+	feeModelQueryServer feemodeltypes.QueryServer,
 ) *wasmkeeper.QueryPlugins {
 	return &wasmkeeper.QueryPlugins{
 		Custom: func(ctx sdk.Context, query json.RawMessage) ([]byte, error) {
@@ -102,7 +159,7 @@ func NewCoreumQueryHandler(
 				return nil, errors.WithStack(err)
 			}
 
-			return processCoreumQuery(ctx, coreumQuery, assetFTQueryServer, assetNFTQueryServer, nftQueryServer)
+			return processCoreumQuery(ctx, coreumQuery, assetFTQueryServer, assetNFTQueryServer, nftQueryServer, feeModelQueryServer)
 		},
 	}
 }
@@ -121,6 +178,7 @@ func processCoreumQuery(
 	assetFTQueryServer assetfttypes.QueryServer,
 	assetNFTQueryServer assetnfttypes.QueryServer,
 	nftQueryServer nfttypes.QueryServer,
+	feeModelQueryServer feemodeltypes.QueryServer,
 ) ([]byte, error) {
 	if queries.AssetFT != nil {
 		return processAssetFTQuery(ctx, queries.AssetFT, assetFTQueryServer)
@@ -131,31 +189,90 @@ func processCoreumQuery(
 	if queries.NFT != nil {
 		return processNFTQuery(ctx, queries.NFT, nftQueryServer)
 	}
+	if queries.FeeModel != nil {
+		return processFeeModelQuery(ctx, queries.FeeModel, feeModelQueryServer)
+	}
 
 	return nil, nil
 }
 
+//nolint:funlen // the function routes all the queries of the module
 func processAssetFTQuery(ctx sdk.Context, assetFTQuery *assetFTQuery, assetFTQueryServer assetfttypes.QueryServer) ([]byte, error) {
+	if assetFTQuery.Params != nil {
+		return executeQuery(ctx, assetFTQuery.Params, func(ctx context.Context, req *assetfttypes.QueryParamsRequest) (*assetfttypes.QueryParamsResponse, error) {
+			return assetFTQueryServer.Params(ctx, req)
+		})
+	}
 	if assetFTQuery.Token != nil {
 		return executeQuery(ctx, assetFTQuery.Token, func(ctx context.Context, req *assetfttypes.QueryTokenRequest) (*assetfttypes.QueryTokenResponse, error) {
 			return assetFTQueryServer.Token(ctx, req)
 		})
 	}
+	if assetFTQuery.Tokens != nil {
+		return executeQuery(ctx, assetFTQuery.Tokens, func(ctx context.Context, req *assetfttypes.QueryTokensRequest) (*assetfttypes.QueryTokensResponse, error) {
+			return assetFTQueryServer.Tokens(ctx, req)
+		})
+	}
 	if assetFTQuery.FrozenBalance != nil {
 		return executeQuery(ctx, assetFTQuery.FrozenBalance, func(ctx context.Context, req *assetfttypes.QueryFrozenBalanceRequest) (*assetfttypes.QueryFrozenBalanceResponse, error) {
 			return assetFTQueryServer.FrozenBalance(ctx, req)
 		})
 	}
+	if assetFTQuery.FrozenBalances != nil {
+		return executeQuery(ctx, assetFTQuery.FrozenBalances, func(ctx context.Context, req *assetfttypes.QueryFrozenBalancesRequest) (*assetfttypes.QueryFrozenBalancesResponse, error) {
+			return assetFTQueryServer.FrozenBalances(ctx, req)
+		})
+	}
 	if assetFTQuery.WhitelistedBalance != nil {
 		return executeQuery(ctx, assetFTQuery.WhitelistedBalance, func(ctx context.Context, req *assetfttypes.QueryWhitelistedBalanceRequest) (*assetfttypes.QueryWhitelistedBalanceResponse, error) {
 			return assetFTQueryServer.WhitelistedBalance(ctx, req)
 		})
 	}
+	if assetFTQuery.WhitelistedBalances != nil {
+		return executeQuery(ctx, assetFTQuery.WhitelistedBalances, func(ctx context.Context, req *assetfttypes.QueryWhitelistedBalancesRequest) (*assetfttypes.QueryWhitelistedBalancesResponse, error) {
+			return assetFTQueryServer.WhitelistedBalances(ctx, req)
+		})
+	}
+	if assetFTQuery.Blacklisted != nil {
+		return executeQuery(ctx, assetFTQuery.Blacklisted, func(ctx context.Context, req *assetfttypes.QueryBlacklistedRequest) (*assetfttypes.QueryBlacklistedResponse, error) {
+			return assetFTQueryServer.Blacklisted(ctx, req)
+		})
+	}
+	if assetFTQuery.BlacklistedAccounts != nil {
+		return executeQuery(ctx, assetFTQuery.BlacklistedAccounts, func(ctx context.Context, req *assetfttypes.QueryBlacklistedAccountsRequest) (*assetfttypes.QueryBlacklistedAccountsResponse, error) {
+			return assetFTQueryServer.BlacklistedAccounts(ctx, req)
+		})
+	}
+	if assetFTQuery.MintAllowance != nil {
+		return executeQuery(ctx, assetFTQuery.MintAllowance, func(ctx context.Context, req *assetfttypes.QueryMintAllowanceRequest) (*assetfttypes.QueryMintAllowanceResponse, error) {
+			return assetFTQueryServer.MintAllowance(ctx, req)
+		})
+	}
+	if assetFTQuery.MintAllowances != nil {
+		return executeQuery(ctx, assetFTQuery.MintAllowances, func(ctx context.Context, req *assetfttypes.QueryMintAllowancesRequest) (*assetfttypes.QueryMintAllowancesResponse, error) {
+			return assetFTQueryServer.MintAllowances(ctx, req)
+		})
+	}
+	if assetFTQuery.RateExempt != nil {
+		return executeQuery(ctx, assetFTQuery.RateExempt, func(ctx context.Context, req *assetfttypes.QueryRateExemptRequest) (*assetfttypes.QueryRateExemptResponse, error) {
+			return assetFTQueryServer.RateExempt(ctx, req)
+		})
+	}
+	if assetFTQuery.RateExemptAccounts != nil {
+		return executeQuery(ctx, assetFTQuery.RateExemptAccounts, func(ctx context.Context, req *assetfttypes.QueryRateExemptAccountsRequest) (*assetfttypes.QueryRateExemptAccountsResponse, error) {
+			return assetFTQueryServer.RateExemptAccounts(ctx, req)
+		})
+	}
 
 	return nil, nil
 }
 
 func processAssetNFTQuery(ctx sdk.Context, assetNFTQuery *assetNFTQuery, assetNFTQueryServer assetnfttypes.QueryServer) ([]byte, error) {
+	if assetNFTQuery.Params != nil {
+		return executeQuery(ctx, assetNFTQuery.Params, func(ctx context.Context, req *assetnfttypes.QueryParamsRequest) (*assetnfttypes.QueryParamsResponse, error) {
+			return assetNFTQueryServer.Params(ctx, req)
+		})
+	}
 	if assetNFTQuery.Class != nil {
 		return executeQuery(ctx, assetNFTQuery.Class, func(ctx context.Context, req *assetnfttypes.QueryClassRequest) (*assetNFTClassResponse, error) {
 			classRes, err := assetNFTQueryServer.Class(ctx, req)
@@ -196,6 +313,11 @@ func processAssetNFTQuery(ctx sdk.Context, assetNFTQuery *assetNFTQuery, assetNF
 			return assetNFTQueryServer.Whitelisted(ctx, req)
 		})
 	}
+	if assetNFTQuery.WhitelistedAccountsForNFT != nil {
+		return executeQuery(ctx, assetNFTQuery.WhitelistedAccountsForNFT, func(ctx context.Context, req *assetnfttypes.QueryWhitelistedAccountsForNFTRequest) (*assetnfttypes.QueryWhitelistedAccountsForNFTResponse, error) {
+			return assetNFTQueryServer.WhitelistedAccountsForNFT(ctx, req)
+		})
+	}
 
 	return nil, nil
 }
@@ -216,7 +338,7 @@ func processNFTQuery(ctx sdk.Context, nftQuery *nftQuery, nftQueryServer nfttype
 			return nftQueryServer.Supply(ctx, req)
 		})
 	}
-	if nftQuery.NFT != nil { //nolint:nestif // the ifs are for the error checks mostly
+	if nftQuery.NFT != nil {
 		return executeQuery(ctx, nftQuery.NFT, func(ctx context.Context, req *nfttypes.QueryNFTRequest) (*NFTResponse, error) {
 			nftRes, err := nftQueryServer.NFT(ctx, req)
 			if err != nil {
@@ -227,28 +349,134 @@ func processNFTQuery(ctx sdk.Context, nftQuery *nftQuery, nftQueryServer nfttype
 				return &NFTResponse{}, nil
 			}
 
-			var dataString string
-			if nftRes.Nft.Data != nil {
-				dataString, err = unmarshalDataBytes(nftRes.Nft.Data)
+			nftWithStringData, err := convertNFT(nftRes.Nft)
+			if err != nil {
+				return nil, err
+			}
+			return &NFTResponse{
+				NFT: nftWithStringData,
+			}, nil
+		})
+	}
+	if nftQuery.NFTs != nil {
+		return executeQuery(ctx, nftQuery.NFTs, func(ctx context.Context, req *nfttypes.QueryNFTsRequest) (*nftsResponse, error) {
+			nftsRes, err := nftQueryServer.NFTs(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			nfts := make([]nft, 0, len(nftsRes.Nfts))
+			for _, nftItem := range nftsRes.Nfts {
+				nftWithStringData, err := convertNFT(nftItem)
 				if err != nil {
 					return nil, err
 				}
+				nfts = append(nfts, nftWithStringData)
 			}
-			return &NFTResponse{
-				NFT: nft{
-					ClassID: nftRes.Nft.ClassId,
-					ID:      nftRes.Nft.Id,
-					URI:     nftRes.Nft.Uri,
-					URIHash: nftRes.Nft.UriHash,
-					Data:    dataString,
-				},
+			return &nftsResponse{
+				NFTs:       nfts,
+				Pagination: nftsRes.Pagination,
 			}, nil
 		})
 	}
+	if nftQuery.Class != nil {
+		return executeQuery(ctx, nftQuery.Class, func(ctx context.Context, req *nfttypes.QueryClassRequest) (*nftClassResponse, error) {
+			classRes, err := nftQueryServer.Class(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			if classRes.Class == nil {
+				return &nftClassResponse{}, nil
+			}
+
+			class, err := convertNFTClass(classRes.Class)
+			if err != nil {
+				return nil, err
+			}
+			return &nftClassResponse{
+				Class: class,
+			}, nil
+		})
+	}
+	if nftQuery.Classes != nil {
+		return executeQuery(ctx, nftQuery.Classes, func(ctx context.Context, req *nfttypes.QueryClassesRequest) (*nftClassesResponse, error) {
+			classesRes, err := nftQueryServer.Classes(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			classes := make([]nftClass, 0, len(classesRes.Classes))
+			for _, classItem := range classesRes.Classes {
+				class, err := convertNFTClass(classItem)
+				if err != nil {
+					return nil, err
+				}
+				classes = append(classes, class)
+			}
+			return &nftClassesResponse{
+				Classes:    classes,
+				Pagination: classesRes.Pagination,
+			}, nil
+		})
+	}
+
+	return nil, nil
+}
+
+func processFeeModelQuery(ctx sdk.Context, feeModelQuery *feeModelQuery, feeModelQueryServer feemodeltypes.QueryServer) ([]byte, error) {
+	if feeModelQuery.MinGasPrice != nil {
+		return executeQuery(ctx, feeModelQuery.MinGasPrice, func(ctx context.Context, req *feemodeltypes.QueryMinGasPriceRequest) (*feemodeltypes.QueryMinGasPriceResponse, error) {
+			return feeModelQueryServer.MinGasPrice(ctx, req)
+		})
+	}
+	if feeModelQuery.Params != nil {
+		return executeQuery(ctx, feeModelQuery.Params, func(ctx context.Context, req *feemodeltypes.QueryParamsRequest) (*feemodeltypes.QueryParamsResponse, error) {
+			return feeModelQueryServer.Params(ctx, req)
+		})
+	}
 
 	return nil, nil
 }
 
+func convertNFT(nftItem *nfttypes.NFT) (nft, error) {
+	var dataString string
+	if nftItem.Data != nil {
+		var err error
+		dataString, err = unmarshalDataBytes(nftItem.Data)
+		if err != nil {
+			return nft{}, err
+		}
+	}
+	return nft{
+		ClassID: nftItem.ClassId,
+		ID:      nftItem.Id,
+		URI:     nftItem.Uri,
+		URIHash: nftItem.UriHash,
+		Data:    dataString,
+	}, nil
+}
+
+func convertNFTClass(class *nfttypes.Class) (nftClass, error) {
+	var dataString string
+	if class.Data != nil {
+		var err error
+		dataString, err = unmarshalDataBytes(class.Data)
+		if err != nil {
+			return nftClass{}, err
+		}
+	}
+	return nftClass{
+		ID:          class.Id,
+		Name:        class.Name,
+		Symbol:      class.Symbol,
+		Description: class.Description,
+		URI:         class.Uri,
+		URIHash:     class.UriHash,
+		Data:        dataString,
+	}, nil
+}
+
 func executeQuery[T, K any](
 	ctx sdk.Context,
 	reqStruct T,
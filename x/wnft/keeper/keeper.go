@@ -61,6 +61,27 @@ func (wk Wrapper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRes
 	return &nft.MsgSendResponse{}, nil
 }
 
+// SendBatch overwrites SendBatch method of the original keeper to apply the Send method of the wrapper
+// to each sent nft. If any of the nfts can't be sent, none of them is sent.
+func (wk Wrapper) SendBatch(goCtx context.Context, msg *nft.MsgSendBatch) (*nft.MsgSendBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, item := range msg.Items {
+		if _, err := wk.Send(sdk.WrapSDKContext(cacheCtx), &nft.MsgSend{
+			ClassId:  item.ClassId,
+			Id:       item.Id,
+			Sender:   msg.Sender,
+			Receiver: item.Receiver,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return &nft.MsgSendBatchResponse{}, nil
+}
+
 // Transfer overwrites the original transfer function to include our custom interceptor.
 func (wk Wrapper) Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
 	if err := wk.nonFungibleTokenProvider.BeforeTransfer(ctx, classID, nftID, receiver); err != nil {
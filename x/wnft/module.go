@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/CoreumFoundation/coreum/x/nft"
+	nftkeeper "github.com/CoreumFoundation/coreum/x/nft/keeper"
 	nftmodule "github.com/CoreumFoundation/coreum/x/nft/module"
 	"github.com/CoreumFoundation/coreum/x/wnft/keeper"
 )
@@ -34,4 +35,9 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Wrapper, ak nft.AccountKeeper,
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	nft.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	nft.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := nftkeeper.NewMigrator(am.keeper.Keeper)
+	if err := cfg.RegisterMigration(nft.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
 }
@@ -12,5 +12,5 @@ import (
 // if this tests fails, it means that we need to register the new migration handlers of the original nft module.
 func TestNFTModuleConsensusVersion(t *testing.T) {
 	nftModule := nft.AppModule{}
-	require.EqualValues(t, 1, nftModule.ConsensusVersion())
+	require.EqualValues(t, 2, nftModule.ConsensusVersion())
 }